@@ -1,11 +1,14 @@
 package main
 
 import (
+	"restaurant/internal/adapter/clock"
 	"restaurant/internal/adapter/config"
 	"restaurant/internal/adapter/handler"
 	"restaurant/internal/adapter/handler/http"
 	"restaurant/internal/adapter/handler/http/validation"
 	"restaurant/internal/adapter/handler/websocket"
+	"restaurant/internal/adapter/imaging"
+	"restaurant/internal/adapter/job"
 	"restaurant/internal/adapter/logger"
 	"restaurant/internal/adapter/storage"
 	"restaurant/internal/core/service"
@@ -17,11 +20,14 @@ func main() {
 	fx.New(
 		config.Module,
 		logger.Module,
+		clock.Module,
 		storage.Module,
+		imaging.Module,
 		service.Module,
 		validation.Module,
 		http.Module,
 		websocket.Module,
 		handler.Module,
+		job.Module,
 	).Run()
 }
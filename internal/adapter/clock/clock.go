@@ -0,0 +1,19 @@
+package clock
+
+import "time"
+
+// Clock implements port.Clock with the system time in the timezone of the restaurant.
+type Clock struct {
+	location *time.Location
+}
+
+// NewClock creates a new Clock instance.
+func NewClock(location *time.Location) *Clock {
+	return &Clock{
+		location: location,
+	}
+}
+
+func (c *Clock) Now() time.Time {
+	return time.Now().In(c.location)
+}
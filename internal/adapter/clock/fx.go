@@ -0,0 +1,17 @@
+package clock
+
+import (
+	"restaurant/internal/core/port"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module(
+	"clock",
+	fx.Provide(
+		fx.Annotate(
+			NewClock,
+			fx.As(new(port.Clock)),
+		),
+	),
+)
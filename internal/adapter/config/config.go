@@ -5,6 +5,10 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+	// Embeds the timezone database so TIMEZONE works on hosts without one.
+	_ "time/tzdata"
 	"unicode/utf8"
 
 	"github.com/joho/godotenv"
@@ -32,29 +36,64 @@ func getEnvInt(key string, fallback int) int {
 	return fallback
 }
 
+// getEnvDuration is a helper function for getting environment variable parsed as duration like "30s",
+// if the variable doesn't exist or is not a valid positive duration, fallback is returned.
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if value, ok := os.LookupEnv(key); ok {
+		parsedValue, err := time.ParseDuration(value)
+		if err != nil || parsedValue <= 0 {
+			return fallback
+		}
+		return parsedValue
+	}
+	return fallback
+}
+
 type (
 	// Environment for different app environments.
 	Environment string
 
+	// ImageStorage for different product image storages.
+	ImageStorage string
+
 	// Container holds all environment variables.
 	Container struct {
 		AppConfig  AppConfig
 		DbConfig   StorageConfig
 		AuthConfig AuthConfig
+		JobConfig  JobConfig
 	}
 
 	// AppConfig holds all environment variable for the application.
 	AppConfig struct {
 		Environment Environment
 		Port        string
+		Location    *time.Location
 	}
 
-	// StorageConfig holds all environment variable for the database.
+	// StorageConfig holds all environment variable for the database and the image storage.
 	StorageConfig struct {
 		DbUrl                string
 		DbMaxIdleConnections int
 		DbMaxOpenConnections int
+		ImagesStorage        ImageStorage
 		ImagesApiKey         string
+		// ImagesMaxSize is the largest accepted image upload in bytes.
+		ImagesMaxSize int
+		// ImagesDir is the directory the filesystem storage keeps images in.
+		ImagesDir string
+		// ImagesPublicUrl is the scheme and host put in front of the image urls of the filesystem
+		// storage. Without it the urls are relative to the API.
+		ImagesPublicUrl string
+		// ImagesS3Endpoint is the scheme and host of the S3 compatible API, objects are addressed path-style.
+		ImagesS3Endpoint  string
+		ImagesS3Region    string
+		ImagesS3Bucket    string
+		ImagesS3AccessKey string
+		ImagesS3SecretKey string
+		// ImagesS3PublicUrl is the url images are read from, followed by the object key.
+		// It defaults to the bucket url, which must then allow public reads.
+		ImagesS3PublicUrl string
 	}
 
 	// AuthConfig holds all environment variable for the authentication.
@@ -62,6 +101,16 @@ type (
 		Username string
 		Password string
 	}
+
+	// JobConfig holds all environment variable for the background jobs.
+	JobConfig struct {
+		// ImageOperationsInterval is how often due image operations are processed.
+		ImageOperationsInterval time.Duration
+		// ImageReconciliationInterval is how often the image storage is searched for unused images.
+		ImageReconciliationInterval time.Duration
+		// RecommendationsInterval is how often the recommendations are recomputed from the order history.
+		RecommendationsInterval time.Duration
+	}
 )
 
 const (
@@ -69,6 +118,18 @@ const (
 	Production  Environment = "production"
 )
 
+const (
+	// ImgbbImageStorage uploads images to the imgbb API.
+	ImgbbImageStorage ImageStorage = "imgbb"
+	// FilesystemImageStorage keeps images in a local directory served by the API.
+	FilesystemImageStorage ImageStorage = "filesystem"
+	// S3ImageStorage uploads images to a bucket of an S3 compatible object storage.
+	S3ImageStorage ImageStorage = "s3"
+)
+
+// ImagesRoute is the path the images of the filesystem storage are served under.
+const ImagesRoute = "/images"
+
 func newAppConfig() (AppConfig, error) {
 	port := getEnv("PORT", ":8080")
 	environment := Environment(getEnv("ENVIRONMENT", "development"))
@@ -76,9 +137,15 @@ func newAppConfig() (AppConfig, error) {
 		return AppConfig{}, fmt.Errorf("invalid environment: %s", environment)
 	}
 
+	location, err := time.LoadLocation(getEnv("TIMEZONE", "UTC"))
+	if err != nil {
+		return AppConfig{}, fmt.Errorf("invalid timezone: %w", err)
+	}
+
 	return AppConfig{
 		Port:        port,
 		Environment: environment,
+		Location:    location,
 	}, nil
 }
 
@@ -97,16 +164,47 @@ func newStorageConfig() (StorageConfig, error) {
 		return StorageConfig{}, fmt.Errorf("max open connections must be greater than zero: %d", maxOpenConnections)
 	}
 
-	localFilesPath := os.Getenv("IMAGES_API_KEY")
-	if localFilesPath == "" {
-		return StorageConfig{}, fmt.Errorf("images api key environment variable not set")
+	imagesStorage := ImageStorage(getEnv("IMAGES_STORAGE", string(ImgbbImageStorage)))
+	imagesApiKey := os.Getenv("IMAGES_API_KEY")
+	switch imagesStorage {
+	case ImgbbImageStorage:
+		if imagesApiKey == "" {
+			return StorageConfig{}, fmt.Errorf("images api key environment variable not set")
+		}
+	case FilesystemImageStorage:
+	case S3ImageStorage:
+		for _, key := range []string{"IMAGES_S3_ENDPOINT", "IMAGES_S3_BUCKET", "IMAGES_S3_ACCESS_KEY", "IMAGES_S3_SECRET_KEY"} {
+			if os.Getenv(key) == "" {
+				return StorageConfig{}, fmt.Errorf("%s environment variable not set", key)
+			}
+		}
+	default:
+		return StorageConfig{}, fmt.Errorf("invalid images storage: %s", imagesStorage)
 	}
 
+	imagesMaxSizeMb := getEnvInt("IMAGES_MAX_SIZE_MB", 4)
+	if imagesMaxSizeMb <= 0 {
+		return StorageConfig{}, fmt.Errorf("images max size must be greater than zero: %d", imagesMaxSizeMb)
+	}
+
+	s3Endpoint := strings.TrimSuffix(os.Getenv("IMAGES_S3_ENDPOINT"), "/")
+	s3Bucket := os.Getenv("IMAGES_S3_BUCKET")
+
 	return StorageConfig{
 		DbUrl:                dbURL,
 		DbMaxIdleConnections: maxIdleConnections,
 		DbMaxOpenConnections: maxOpenConnections,
-		ImagesApiKey:         localFilesPath,
+		ImagesStorage:        imagesStorage,
+		ImagesApiKey:         imagesApiKey,
+		ImagesMaxSize:        imagesMaxSizeMb << 20,
+		ImagesDir:            getEnv("IMAGES_DIR", "uploads/images"),
+		ImagesPublicUrl:      strings.TrimSuffix(os.Getenv("IMAGES_PUBLIC_URL"), "/"),
+		ImagesS3Endpoint:     s3Endpoint,
+		ImagesS3Region:       getEnv("IMAGES_S3_REGION", "us-east-1"),
+		ImagesS3Bucket:       s3Bucket,
+		ImagesS3AccessKey:    os.Getenv("IMAGES_S3_ACCESS_KEY"),
+		ImagesS3SecretKey:    os.Getenv("IMAGES_S3_SECRET_KEY"),
+		ImagesS3PublicUrl:    strings.TrimSuffix(getEnv("IMAGES_S3_PUBLIC_URL", s3Endpoint+"/"+s3Bucket), "/"),
 	}, nil
 }
 
@@ -126,6 +224,14 @@ func newAuthConfig() (AuthConfig, error) {
 	}, nil
 }
 
+func newJobConfig() JobConfig {
+	return JobConfig{
+		ImageOperationsInterval:     getEnvDuration("JOBS_IMAGE_OPERATIONS_INTERVAL", 30*time.Second),
+		ImageReconciliationInterval: getEnvDuration("JOBS_IMAGE_RECONCILIATION_INTERVAL", 24*time.Hour),
+		RecommendationsInterval:     getEnvDuration("JOBS_RECOMMENDATIONS_INTERVAL", time.Hour),
+	}
+}
+
 func New() (*Container, error) {
 	if err := godotenv.Load(); err != nil {
 		log.Println("Error loading .env file")
@@ -150,5 +256,6 @@ func New() (*Container, error) {
 		AppConfig:  appConfig,
 		DbConfig:   storageConfig,
 		AuthConfig: authConfig,
+		JobConfig:  newJobConfig(),
 	}, nil
 }
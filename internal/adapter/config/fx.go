@@ -1,6 +1,10 @@
 package config
 
-import "go.uber.org/fx"
+import (
+	"time"
+
+	"go.uber.org/fx"
+)
 
 var Module = fx.Module(
 	"config",
@@ -14,4 +18,10 @@ var Module = fx.Module(
 	fx.Provide(func(container *Container) *AuthConfig {
 		return &container.AuthConfig
 	}),
+	fx.Provide(func(container *Container) *JobConfig {
+		return &container.JobConfig
+	}),
+	fx.Provide(func(container *Container) *time.Location {
+		return container.AppConfig.Location
+	}),
 )
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"net/http"
+	"restaurant/internal/adapter/handler/http/request"
+	"restaurant/internal/adapter/handler/http/response"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// BundleHandler handles bundle-related HTTP requests.
+type BundleHandler struct {
+	bundleService port.BundleService
+	validator     *validator.Validate
+}
+
+// NewBundleHandler creates a new BundleHandler instance.
+func NewBundleHandler(bundleService port.BundleService, validator *validator.Validate) *BundleHandler {
+	return &BundleHandler{
+		bundleService: bundleService,
+		validator:     validator,
+	}
+}
+
+func (h *BundleHandler) GetBundles(c *fiber.Ctx) error {
+	bundles, err := h.bundleService.GetBundles(c.Context())
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.BundleResponse, 0, len(bundles))
+	for _, bundle := range bundles {
+		res = append(res, response.NewBundleResponse(&bundle))
+	}
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *BundleHandler) AddBundle(c *fiber.Ctx) error {
+	var req request.AddBundleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	slots := make([]domain.AddBundleSlotDTO, 0, len(req.Slots))
+	for _, slot := range req.Slots {
+		slots = append(slots, *domain.NewAddBundleSlotDTO(slot.Name, slot.CategoryId, slot.ProductIds))
+	}
+
+	bundle, err := h.bundleService.AddBundle(
+		c.Context(),
+		domain.NewAddBundleDTO(req.Name, req.Description, req.Price, slots),
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.NewBundleResponse(bundle))
+}
+
+func (h *BundleHandler) DeleteBundle(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	if err = h.bundleService.DeleteBundle(c.Context(), id); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *BundleHandler) SetBundleAvailability(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	var req request.SetBundleAvailabilityRequest
+	if err = c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	if err = h.bundleService.SetBundleAvailability(c.Context(), id, *req.Available); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
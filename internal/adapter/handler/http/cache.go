@@ -0,0 +1,58 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// sendCacheable sends the body as JSON with ETag and Last-Modified headers, so clients can
+// revalidate it with conditional requests. It responds with 304 Not Modified when the client's
+// copy is still current.
+func sendCacheable(c *fiber.Ctx, body any, modifiedAt time.Time) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	hash := fnv.New64a()
+	_, _ = hash.Write(data)
+	etag := fmt.Sprintf(`"%x"`, hash.Sum64())
+
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(fiber.HeaderLastModified, modifiedAt.UTC().Format(http.TimeFormat))
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Vary(fiber.HeaderAcceptLanguage)
+
+	if isNotModified(c, etag, modifiedAt) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Status(fiber.StatusOK).Send(data)
+}
+
+// isNotModified evaluates the conditional request headers. If-None-Match takes precedence
+// over If-Modified-Since, which has only a one second resolution.
+func isNotModified(c *fiber.Ctx, etag string, modifiedAt time.Time) bool {
+	if noneMatch := c.Get(fiber.HeaderIfNoneMatch); noneMatch != "" {
+		for _, candidate := range strings.Split(noneMatch, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	if modifiedSince := c.Get(fiber.HeaderIfModifiedSince); modifiedSince != "" {
+		since, err := http.ParseTime(modifiedSince)
+		return err == nil && !modifiedAt.Truncate(time.Second).After(since)
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendCacheable(t *testing.T) {
+	modifiedAt := time.Date(2024, 1, 1, 12, 0, 0, 500_000_000, time.UTC)
+	body := map[string]string{"name": "Cola"}
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return sendCacheable(c, body, modifiedAt)
+	})
+
+	send := func(headers map[string]string) *http.Response {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		res, err := app.Test(req)
+		require.NoError(t, err)
+		return res
+	}
+
+	first := send(nil)
+	etag := first.Header.Get(fiber.HeaderETag)
+	require.NotEmpty(t, etag)
+
+	tests := []struct {
+		name           string
+		headers        map[string]string
+		expectedStatus int
+	}{
+		{
+			name:           "unconditional",
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name:           "matching etag",
+			headers:        map[string]string{fiber.HeaderIfNoneMatch: etag},
+			expectedStatus: fiber.StatusNotModified,
+		},
+		{
+			name:           "weak etag in a list",
+			headers:        map[string]string{fiber.HeaderIfNoneMatch: `"other", W/` + etag},
+			expectedStatus: fiber.StatusNotModified,
+		},
+		{
+			name:           "any etag",
+			headers:        map[string]string{fiber.HeaderIfNoneMatch: "*"},
+			expectedStatus: fiber.StatusNotModified,
+		},
+		{
+			name: "other etag takes precedence over a current date",
+			headers: map[string]string{
+				fiber.HeaderIfNoneMatch:     `"other"`,
+				fiber.HeaderIfModifiedSince: modifiedAt.Format(http.TimeFormat),
+			},
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name:           "modified within the second of the date",
+			headers:        map[string]string{fiber.HeaderIfModifiedSince: modifiedAt.Format(http.TimeFormat)},
+			expectedStatus: fiber.StatusNotModified,
+		},
+		{
+			name:           "modified after the date",
+			headers:        map[string]string{fiber.HeaderIfModifiedSince: modifiedAt.Add(-time.Second).Format(http.TimeFormat)},
+			expectedStatus: fiber.StatusOK,
+		},
+		{
+			name:           "malformed date",
+			headers:        map[string]string{fiber.HeaderIfModifiedSince: "yesterday"},
+			expectedStatus: fiber.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := send(tt.headers)
+			require.Equal(t, tt.expectedStatus, res.StatusCode)
+			require.Equal(t, etag, res.Header.Get(fiber.HeaderETag))
+			require.Equal(t, "Mon, 01 Jan 2024 12:00:00 GMT", res.Header.Get(fiber.HeaderLastModified))
+			require.Equal(t, "no-cache", res.Header.Get(fiber.HeaderCacheControl))
+			require.Equal(t, fiber.HeaderAcceptLanguage, res.Header.Get(fiber.HeaderVary))
+
+			data, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			if tt.expectedStatus == fiber.StatusOK {
+				require.Equal(t, fiber.MIMEApplicationJSON, res.Header.Get(fiber.HeaderContentType))
+				require.JSONEq(t, `{"name":"Cola"}`, string(data))
+			} else {
+				require.Empty(t, data)
+			}
+		})
+	}
+}
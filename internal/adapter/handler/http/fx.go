@@ -8,4 +8,6 @@ var Module = fx.Module(
 	"http",
 	fx.Provide(NewProductHandler),
 	fx.Provide(NewOrderHandler),
+	fx.Provide(NewInventoryHandler),
+	fx.Provide(NewBundleHandler),
 )
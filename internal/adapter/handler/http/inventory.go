@@ -0,0 +1,154 @@
+package http
+
+import (
+	"net/http"
+	"restaurant/internal/adapter/handler/http/request"
+	"restaurant/internal/adapter/handler/http/response"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// InventoryHandler handles inventory-related HTTP requests.
+type InventoryHandler struct {
+	inventoryService port.InventoryService
+	validator        *validator.Validate
+}
+
+// NewInventoryHandler creates a new InventoryHandler instance.
+func NewInventoryHandler(inventoryService port.InventoryService, validator *validator.Validate) *InventoryHandler {
+	return &InventoryHandler{
+		inventoryService: inventoryService,
+		validator:        validator,
+	}
+}
+
+func (h *InventoryHandler) AddIngredient(c *fiber.Ctx) error {
+	var req request.AddIngredientRequest
+	if err := c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	ingredient, err := h.inventoryService.AddIngredient(c.Context(), domain.NewAddIngredientDTO(req.Name, req.Unit, req.OnHand))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.NewIngredientResponse(ingredient))
+}
+
+func (h *InventoryHandler) GetIngredients(c *fiber.Ctx) error {
+	ingredients, err := h.inventoryService.GetIngredients(c.Context())
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.IngredientResponse, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		res = append(res, response.NewIngredientResponse(&ingredient))
+	}
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *InventoryHandler) DeleteIngredient(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	if err = h.inventoryService.DeleteIngredient(c.Context(), id); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *InventoryHandler) AdjustStock(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	var req request.StockAdjustmentRequest
+	if err = c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	ingredient, err := h.inventoryService.AdjustStock(c.Context(), domain.NewStockAdjustmentDTO(id, req.Delta, req.Reason))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(response.NewIngredientResponse(ingredient))
+}
+
+func (h *InventoryHandler) CountStock(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	var req request.StockCountRequest
+	if err = c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	ingredient, err := h.inventoryService.CountStock(c.Context(), domain.NewStockCountDTO(id, req.Counted, req.Reason))
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(response.NewIngredientResponse(ingredient))
+}
+
+func (h *InventoryHandler) SetRecipe(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	var req request.SetRecipeRequest
+	if err = c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	items := make([]domain.RecipeItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, *domain.NewRecipeItem(productId, item.IngredientId, item.Amount))
+	}
+
+	if err = h.inventoryService.SetRecipe(c.Context(), productId, items); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *InventoryHandler) GetRecipe(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	items, err := h.inventoryService.GetRecipe(c.Context(), productId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(response.NewRecipeItemResponses(items))
+}
@@ -0,0 +1,80 @@
+package http
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// maxPreferredLocales limits how many locales from the Accept-Language header are considered.
+const maxPreferredLocales = 10
+
+// preferredLocales returns the locales the client prefers, in order of preference.
+// The lang query parameter takes priority over the Accept-Language header. Region specific
+// locales are followed by their primary language, so "de-CH" falls back to "de".
+func preferredLocales(c *fiber.Ctx) []string {
+	if lang := strings.TrimSpace(c.Query("lang")); lang != "" {
+		return withPrimaryLanguages([]string{lang})
+	}
+
+	type weightedLocale struct {
+		locale string
+		weight float64
+	}
+
+	var weighted []weightedLocale
+	for _, part := range strings.Split(c.Get(fiber.HeaderAcceptLanguage), ",") {
+		locale, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		locale = strings.TrimSpace(locale)
+		if locale == "" || locale == "*" {
+			continue
+		}
+
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil || parsed <= 0 {
+				continue
+			}
+			weight = parsed
+		}
+		weighted = append(weighted, weightedLocale{locale: locale, weight: weight})
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].weight > weighted[j].weight
+	})
+	if len(weighted) > maxPreferredLocales {
+		weighted = weighted[:maxPreferredLocales]
+	}
+
+	locales := make([]string, 0, len(weighted))
+	for _, w := range weighted {
+		locales = append(locales, w.locale)
+	}
+	return withPrimaryLanguages(locales)
+}
+
+// withPrimaryLanguages adds the primary language after each region specific locale and removes duplicates.
+func withPrimaryLanguages(locales []string) []string {
+	seen := make(map[string]struct{}, len(locales))
+	result := make([]string, 0, len(locales)*2)
+	add := func(locale string) {
+		locale = strings.ToLower(locale)
+		if _, ok := seen[locale]; ok {
+			return
+		}
+		seen[locale] = struct{}{}
+		result = append(result, locale)
+	}
+
+	for _, locale := range locales {
+		add(locale)
+		if primary, _, ok := strings.Cut(locale, "-"); ok {
+			add(primary)
+		}
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreferredLocales(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		acceptLanguage string
+		expected       []string
+	}{
+		{
+			name:     "no preference",
+			expected: []string{},
+		}, {
+			name:           "ordered by quality",
+			acceptLanguage: "fr;q=0.5, de-CH, en;q=0.9",
+			expected:       []string{"de-ch", "de", "en", "fr"},
+		}, {
+			name:           "equal quality keeps the header order",
+			acceptLanguage: "es;q=0.7, it;q=0.7",
+			expected:       []string{"es", "it"},
+		}, {
+			name:           "region falls back to its primary language once",
+			acceptLanguage: "en-GB, en-US;q=0.8, EN;q=0.5",
+			expected:       []string{"en-gb", "en", "en-us"},
+		}, {
+			name:           "unknown locales are passed through",
+			acceptLanguage: "xx-YY",
+			expected:       []string{"xx-yy", "xx"},
+		}, {
+			name:           "wildcards, rejected and malformed qualities are ignored",
+			acceptLanguage: "*, de;q=0, fr;q=abc, , pl;q=0.1",
+			expected:       []string{"pl"},
+		}, {
+			name:           "lang query parameter takes priority",
+			query:          "?lang=pt-BR",
+			acceptLanguage: "de, en",
+			expected:       []string{"pt-br", "pt"},
+		}, {
+			name:           "only the preferred locales are considered",
+			acceptLanguage: "a1, a2, a3, a4, a5, a6, a7, a8, a9, a10, a11;q=0.5",
+			expected:       []string{"a1", "a2", "a3", "a4", "a5", "a6", "a7", "a8", "a9", "a10"},
+		},
+	}
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.JSON(preferredLocales(c))
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(fiber.MethodGet, "/"+tt.query, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set(fiber.HeaderAcceptLanguage, tt.acceptLanguage)
+			}
+
+			res, err := app.Test(req)
+			require.NoError(t, err)
+
+			var locales []string
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&locales))
+			require.Equal(t, tt.expected, locales)
+		})
+	}
+}
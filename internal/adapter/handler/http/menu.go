@@ -0,0 +1,181 @@
+package http
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"restaurant/internal/adapter/handler/http/request"
+	"restaurant/internal/core/domain"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// menuCSVHeader lists the columns of an exported or imported CSV menu, in order.
+var menuCSVHeader = []string{
+	"category_id",
+	"category_name",
+	"product_id",
+	"product_name",
+	"description",
+	"price",
+	"available",
+	"image_url",
+}
+
+// readMenuRows reads menu rows from a JSON or CSV request body, depending on its content type.
+// Values of a CSV body that can't be parsed are returned as row errors.
+func readMenuRows(c *fiber.Ctx) ([]domain.MenuRow, []domain.MenuRowError, error) {
+	switch {
+	case c.Is("json"):
+		var req []request.MenuRowRequest
+		if err := c.BodyParser(&req); err != nil {
+			return nil, nil, err
+		}
+
+		rows := make([]domain.MenuRow, 0, len(req))
+		for _, row := range req {
+			rows = append(rows, *domain.NewMenuRow(
+				row.CategoryId,
+				strings.TrimSpace(row.CategoryName),
+				row.ProductId,
+				strings.TrimSpace(row.ProductName),
+				strings.TrimSpace(row.Description),
+				row.Price,
+				row.Available == nil || *row.Available,
+				row.ImageUrl,
+			))
+		}
+		return rows, nil, nil
+	case c.Is("csv"):
+		return readMenuCSV(bytes.NewReader(c.Body()))
+	default:
+		return nil, nil, domain.ErrInvalidMenuFormat
+	}
+}
+
+// writeMenuCSV writes the menu rows as CSV with a header line.
+func writeMenuCSV(w io.Writer, rows []domain.MenuRow) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(menuCSVHeader); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		record := make([]string, len(menuCSVHeader))
+		if row.CategoryId != nil {
+			record[0] = row.CategoryId.String()
+		}
+		record[1] = row.CategoryName
+		if row.HasProduct() {
+			if row.ProductId != nil {
+				record[2] = row.ProductId.String()
+			}
+			record[3] = row.ProductName
+			record[4] = row.Description
+			record[5] = row.Price.StringFixed(2)
+			record[6] = strconv.FormatBool(row.Available)
+			if row.ImageUrl != nil {
+				record[7] = *row.ImageUrl
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// readMenuCSV reads menu rows from CSV with the exported header line. Values that can't be
+// parsed are returned as row errors, while a malformed file is an ErrInvalidMenuFormat.
+func readMenuCSV(r io.Reader) ([]domain.MenuRow, []domain.MenuRowError, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, domain.ErrInvalidMenuFormat
+	}
+	if len(header) > 0 {
+		// Spreadsheet applications often prepend a byte order mark.
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	if !slices.Equal(header, menuCSVHeader) {
+		return nil, nil, domain.ErrInvalidMenuFormat
+	}
+
+	var rows []domain.MenuRow
+	var rowErrs []domain.MenuRowError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, domain.ErrInvalidMenuFormat
+		}
+		for i := range record {
+			record[i] = strings.TrimSpace(record[i])
+		}
+
+		row, messages := parseMenuRecord(record)
+		rows = append(rows, row)
+		if len(messages) > 0 {
+			rowErrs = append(rowErrs, *domain.NewMenuRowError(len(rows), messages...))
+		}
+	}
+
+	return rows, rowErrs, nil
+}
+
+// parseMenuRecord converts a CSV record into a menu row and reports the values that can't be parsed.
+func parseMenuRecord(record []string) (domain.MenuRow, []string) {
+	var messages []string
+	parseId := func(value, column string) *uuid.UUID {
+		if value == "" {
+			return nil
+		}
+		id, err := uuid.Parse(value)
+		if err != nil {
+			messages = append(messages, column+" must be a valid uuid.")
+			return nil
+		}
+		return &id
+	}
+
+	row := domain.MenuRow{
+		CategoryId:   parseId(record[0], "category_id"),
+		CategoryName: record[1],
+		ProductId:    parseId(record[2], "product_id"),
+		ProductName:  record[3],
+		Description:  record[4],
+		Available:    true,
+	}
+
+	if record[5] != "" {
+		price, err := decimal.NewFromString(record[5])
+		if err != nil {
+			messages = append(messages, "price must be a decimal number.")
+		}
+		row.Price = price
+	}
+	if record[6] != "" {
+		available, err := strconv.ParseBool(record[6])
+		if err != nil {
+			messages = append(messages, "available must be true or false.")
+		}
+		row.Available = available
+	}
+	if record[7] != "" {
+		row.ImageUrl = &record[7]
+	}
+
+	return row, messages
+}
@@ -2,9 +2,11 @@ package http
 
 import (
 	"net/http"
+	"restaurant/internal/adapter/handler/http/request"
 	"restaurant/internal/adapter/handler/http/response"
 	"restaurant/internal/core/domain"
 	"restaurant/internal/core/port"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -25,18 +27,70 @@ func NewOrderHandler(orderService port.OrderService, validator *validator.Valida
 	}
 }
 
+// parseTimeRange parses the optional RFC 3339 bounds of a created-between filter.
+func parseTimeRange(after, before string) (*time.Time, *time.Time, error) {
+	var createdAfter, createdBefore *time.Time
+	if after != "" {
+		t, err := time.Parse(time.RFC3339, after)
+		if err != nil {
+			return nil, nil, err
+		}
+		createdAfter = &t
+	}
+	if before != "" {
+		t, err := time.Parse(time.RFC3339, before)
+		if err != nil {
+			return nil, nil, err
+		}
+		createdBefore = &t
+	}
+	return createdAfter, createdBefore, nil
+}
+
+// parseCursor parses an optional pagination cursor.
+func parseCursor(token string) (*domain.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	return domain.ParseCursor(token)
+}
+
 func (h *OrderHandler) GetSessions(c *fiber.Ctx) error {
-	sessions, err := h.orderService.GetSessions(c.Context())
+	var req request.GetSessionsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return err
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	createdAfter, createdBefore, err := parseTimeRange(req.CreatedAfter, req.CreatedBefore)
 	if err != nil {
 		return err
 	}
+	cursor, err := parseCursor(req.Cursor)
+	if err != nil {
+		return err
+	}
+
+	var status *domain.OrderSessionStatus
+	if req.Status != "" {
+		status = &req.Status
+	}
+	var tableNumber *int
+	if req.TableNumber != 0 {
+		tableNumber = &req.TableNumber
+	}
 
-	res := make([]response.OrderSessionResponse, 0, len(sessions))
-	for _, session := range sessions {
-		res = append(res, response.NewOrderSessionResponse(&session))
+	page, err := h.orderService.GetSessions(
+		c.Context(),
+		domain.NewGetSessionsDTO(status, tableNumber, createdAfter, createdBefore, cursor, req.Limit),
+	)
+	if err != nil {
+		return err
 	}
 
-	return c.Status(http.StatusOK).JSON(res)
+	return c.Status(http.StatusOK).JSON(response.NewOrderSessionPageResponse(page))
 }
 
 func (h *OrderHandler) CreateSession(c *fiber.Ctx) error {
@@ -63,15 +117,49 @@ func (h *OrderHandler) DeleteSession(c *fiber.Ctx) error {
 }
 
 func (h *OrderHandler) GetOrderedProducts(c *fiber.Ctx) error {
-	products, err := h.orderService.GetOrderedProducts(c.Context())
+	var req request.GetOrderedProductsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return err
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	createdAfter, createdBefore, err := parseTimeRange(req.CreatedAfter, req.CreatedBefore)
+	if err != nil {
+		return err
+	}
+	cursor, err := parseCursor(req.Cursor)
 	if err != nil {
 		return err
 	}
-	res := make([]response.OrderedProductResponse, 0, len(products))
-	for _, product := range products {
-		res = append(res, response.NewOrderedProductResponse(&product))
+
+	var status *domain.OrderedProductStatus
+	if req.Status != "" {
+		status = &req.Status
+	}
+	var sessionId *uuid.UUID
+	if req.SessionId != "" {
+		id, err := uuid.Parse(req.SessionId)
+		if err != nil {
+			return domain.ErrInvalidUUID
+		}
+		sessionId = &id
 	}
-	return c.Status(http.StatusOK).JSON(res)
+	var tableNumber *int
+	if req.TableNumber != 0 {
+		tableNumber = &req.TableNumber
+	}
+
+	page, err := h.orderService.GetOrderedProducts(
+		c.Context(),
+		domain.NewGetOrderedProductsDTO(status, sessionId, tableNumber, createdAfter, createdBefore, cursor, req.Limit),
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(response.NewOrderedProductPageResponse(page))
 }
 
 func (h *OrderHandler) GetBill(c *fiber.Ctx) error {
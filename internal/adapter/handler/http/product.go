@@ -7,24 +7,36 @@ import (
 	"restaurant/internal/adapter/handler/http/response"
 	"restaurant/internal/core/domain"
 	"restaurant/internal/core/port"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 // ProductHandler handler product-related HTTP requests.
 type ProductHandler struct {
-	productService port.ProductService
-	validator      *validator.Validate
+	productService        port.ProductService
+	recommendationService port.RecommendationService
+	menuCache             port.MenuCacheRepository
+	validator             *validator.Validate
 }
 
 // NewProductHandler creates a new ProductHandler instance.
-func NewProductHandler(productService port.ProductService, validator *validator.Validate) *ProductHandler {
+func NewProductHandler(
+	productService port.ProductService,
+	recommendationService port.RecommendationService,
+	menuCache port.MenuCacheRepository,
+	validator *validator.Validate,
+) *ProductHandler {
 	return &ProductHandler{
-		productService: productService,
-		validator:      validator,
+		productService:        productService,
+		recommendationService: recommendationService,
+		menuCache:             menuCache,
+		validator:             validator,
 	}
 }
 
@@ -81,7 +93,9 @@ func (h *ProductHandler) DeleteCategory(c *fiber.Ctx) error {
 }
 
 func (h *ProductHandler) GetProductCategories(c *fiber.Ctx) error {
-	categories, err := h.productService.GetProductCategories(c.Context())
+	// The modification time is taken before loading, so a change made meanwhile isn't hidden behind it.
+	modifiedAt := h.menuCache.ModifiedAt()
+	categories, err := h.productService.GetProductCategories(c.Context(), preferredLocales(c))
 	if err != nil {
 		return err
 	}
@@ -90,9 +104,48 @@ func (h *ProductHandler) GetProductCategories(c *fiber.Ctx) error {
 	for _, category := range categories {
 		res = append(res, response.NewProductCategoryResponse(category.Id, category.Name))
 	}
+	return sendCacheable(c, res, modifiedAt)
+}
+
+func (h *ProductHandler) GetArchivedCategories(c *fiber.Ctx) error {
+	categories, err := h.productService.GetArchivedCategories(c.Context())
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.ProductCategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		res = append(res, response.NewArchivedProductCategoryResponse(&category))
+	}
 	return c.Status(http.StatusOK).JSON(res)
 }
 
+func (h *ProductHandler) ArchiveCategory(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	if err = h.productService.ArchiveCategory(c.Context(), id); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) RestoreCategory(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	if err = h.productService.RestoreCategory(c.Context(), id); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
 func (h *ProductHandler) AddProduct(c *fiber.Ctx) error {
 	var req request.AddProductRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -109,6 +162,7 @@ func (h *ProductHandler) AddProduct(c *fiber.Ctx) error {
 			req.Description,
 			req.Category,
 			req.Price,
+			newNutrition(req.Nutrition),
 		),
 	)
 	if err != nil {
@@ -139,7 +193,8 @@ func (h *ProductHandler) UpdateProduct(c *fiber.Ctx) error {
 				req.NewName,
 				req.NewDescription,
 				req.NewCategory,
-				req.NewPrice),
+				req.NewPrice,
+				newNutritionUpdate(req.NewNutrition)),
 		); err != nil {
 		return err
 	}
@@ -152,17 +207,92 @@ func (h *ProductHandler) ReplaceProductImage(c *fiber.Ctx) error {
 		return domain.ErrInvalidUUID
 	}
 
-	content := http.DetectContentType(c.Body())
-	if content != "image/jpeg" && content != "image/png" {
+	data, err := imageUpload(c)
+	if err != nil {
+		return err
+	}
+
+	images, err := h.productService.ReplaceProductImage(c.Context(), id, data)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.NewUpdateImageResponse(images))
+}
+
+func (h *ProductHandler) AddProductImage(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	var req request.AddProductImageRequest
+	if err = c.QueryParser(&req); err != nil {
+		return err
+	}
+	// Multipart uploads may send the alt text as a form field next to the image.
+	if isMultipart(c) {
+		if err = c.BodyParser(&req); err != nil {
+			return err
+		}
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	data, err := imageUpload(c)
+	if err != nil {
+		return err
+	}
+
+	image, err := h.productService.AddProductImage(
+		c.Context(),
+		domain.NewAddProductImageDTO(productId, strings.TrimSpace(req.AltText), data),
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.NewProductImageResponse(image))
+}
+
+func (h *ProductHandler) ReorderProductImages(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	var req request.ReorderProductImagesRequest
+	if err = c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	if err = h.productService.ReorderProductImages(c.Context(), productId, req.ImageIds); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
 
+func (h *ProductHandler) DeleteProductImage(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
 	}
 
-	url, err := h.productService.ReplaceProductImage(c.Context(), id, bytes.NewReader(c.Body()))
+	imageId, err := uuid.Parse(c.Params("imageId"))
 	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	if err = h.productService.DeleteProductImage(c.Context(), productId, imageId); err != nil {
 		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(response.NewUpdateImageResponse(url))
+	return c.SendStatus(fiber.StatusOK)
 }
 
 func (h *ProductHandler) DeleteProduct(c *fiber.Ctx) error {
@@ -223,7 +353,101 @@ func (h *ProductHandler) GetProducts(c *fiber.Ctx) error {
 		categoryID = &id
 	}
 
-	products, err := h.productService.GetProducts(c.Context(), domain.NewGetProductsDTO(categoryID))
+	var descending bool
+	switch c.Query("order", "asc") {
+	case "asc":
+	case "desc":
+		descending = true
+	default:
+		return domain.ErrInvalidProductSort
+	}
+
+	limit, err := parseIntQuery(c, "limit")
+	if err != nil {
+		return err
+	}
+	offset, err := parseIntQuery(c, "offset")
+	if err != nil {
+		return err
+	}
+
+	var maxCalories *int
+	if raw := strings.TrimSpace(c.Query("max_calories")); raw != "" {
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			return domain.ErrInvalidMaxCalories
+		}
+		maxCalories = &value
+	}
+
+	modifiedAt := h.menuCache.ModifiedAt()
+	page, err := h.productService.GetProducts(
+		c.Context(),
+		domain.NewGetProductsDTO(
+			categoryID,
+			parseListQuery(c, "include_tags"),
+			parseListQuery(c, "exclude_tags"),
+			preferredLocales(c),
+			c.Query("search"),
+			maxCalories,
+			domain.ProductSort(c.Query("sort")),
+			descending,
+			limit,
+			offset,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.ProductResponse, 0, len(page.Products))
+	for _, product := range page.Products {
+		res = append(res, response.NewProductResponse(&product))
+	}
+	c.Set(totalCountHeader, strconv.Itoa(page.Total))
+	return sendCacheable(c, res, modifiedAt)
+}
+
+func (h *ProductHandler) GetProductRecommendations(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	recommendations, err := h.recommendationService.GetRecommendations(c.Context(), id, preferredLocales(c))
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.RecommendationResponse, 0, len(recommendations))
+	for _, recommendation := range recommendations {
+		res = append(res, response.NewRecommendationResponse(&recommendation))
+	}
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *ProductHandler) SetProductAvailability(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	var req request.SetProductAvailabilityRequest
+	if err = c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	if err = h.productService.SetProductAvailability(c.Context(), id, *req.Available); err != nil {
+		return err
+	}
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) GetArchivedProducts(c *fiber.Ctx) error {
+	products, err := h.productService.GetArchivedProducts(c.Context())
 	if err != nil {
 		return err
 	}
@@ -234,3 +458,590 @@ func (h *ProductHandler) GetProducts(c *fiber.Ctx) error {
 	}
 	return c.Status(http.StatusOK).JSON(res)
 }
+
+func (h *ProductHandler) ArchiveProduct(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	if err = h.productService.ArchiveProduct(c.Context(), id); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) RestoreProduct(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	if err = h.productService.RestoreProduct(c.Context(), id); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) AddModifierGroup(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	var req request.AddModifierGroupRequest
+	if err = c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	options := make([]domain.AddModifierOptionDTO, 0, len(req.Options))
+	for _, option := range req.Options {
+		options = append(options, *domain.NewAddModifierOptionDTO(option.Name, option.PriceDelta))
+	}
+
+	group, err := h.productService.AddModifierGroup(
+		c.Context(),
+		domain.NewAddModifierGroupDTO(
+			productId,
+			req.Name,
+			req.MinSelected,
+			req.MaxSelected,
+			options,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.NewModifierGroupResponse(group))
+}
+
+func (h *ProductHandler) DeleteModifierGroup(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	if err = h.productService.DeleteModifierGroup(c.Context(), id); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// totalCountHeader is the response header carrying the number of all items of a paginated listing.
+const totalCountHeader = "X-Total-Count"
+
+// parseIntQuery parses an integer query parameter, returning zero when it's missing.
+func parseIntQuery(c *fiber.Ctx, key string) (int, error) {
+	raw := strings.TrimSpace(c.Query(key))
+	if raw == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, domain.ErrInvalidPagination
+	}
+	return value, nil
+}
+
+// newNutrition converts the nutrition facts of a request, which are all unknown when they are missing.
+func newNutrition(req *request.NutritionRequest) domain.Nutrition {
+	if req == nil {
+		return domain.Nutrition{}
+	}
+	return *domain.NewNutrition(req.Calories, req.Protein, req.Fat, req.Carbohydrates, req.Sugar, req.Salt, req.PortionSize)
+}
+
+// newNutritionUpdate converts the nutrition facts of an update request, which are nil when they shouldn't change.
+func newNutritionUpdate(req *request.NutritionRequest) *domain.Nutrition {
+	if req == nil {
+		return nil
+	}
+	nutrition := newNutrition(req)
+	return &nutrition
+}
+
+// parseListQuery parses a comma separated query parameter into a list of values.
+func parseListQuery(c *fiber.Ctx, key string) []string {
+	raw := strings.TrimSpace(c.Query(key))
+	if raw == "" {
+		return nil
+	}
+	return strings.Split(raw, ",")
+}
+
+func (h *ProductHandler) GetTags(c *fiber.Ctx) error {
+	tags, err := h.productService.GetTags(c.Context())
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.TagResponse, 0, len(tags))
+	for _, tag := range tags {
+		res = append(res, response.NewTagResponse(&tag))
+	}
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *ProductHandler) AddTag(c *fiber.Ctx) error {
+	var req request.AddTagRequest
+	if err := c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	tag, err := h.productService.AddTag(c.Context(), req.Name)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.NewTagResponse(tag))
+}
+
+func (h *ProductHandler) DeleteTag(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	if err = h.productService.DeleteTag(c.Context(), id); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) SetProductTags(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	var req request.SetProductTagsRequest
+	if err = c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	if err = h.productService.SetProductTags(c.Context(), productId, req.TagIds); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// parseLocale parses and validates the locale route parameter.
+func (h *ProductHandler) parseLocale(c *fiber.Ctx) (string, error) {
+	locale := c.Params("locale")
+	if err := h.validator.Var(locale, "bcp47_language_tag"); err != nil {
+		return "", domain.ErrInvalidLocale
+	}
+	return locale, nil
+}
+
+func (h *ProductHandler) GetProductTranslations(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	translations, err := h.productService.GetProductTranslations(c.Context(), productId)
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.ProductTranslationResponse, 0, len(translations))
+	for _, translation := range translations {
+		res = append(res, response.NewProductTranslationResponse(&translation))
+	}
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *ProductHandler) SetProductTranslation(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	locale, err := h.parseLocale(c)
+	if err != nil {
+		return err
+	}
+
+	var req request.SetProductTranslationRequest
+	if err = c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	if err = h.productService.SetProductTranslation(
+		c.Context(),
+		domain.NewProductTranslation(productId, locale, req.Name, req.Description),
+	); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) DeleteProductTranslation(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	locale, err := h.parseLocale(c)
+	if err != nil {
+		return err
+	}
+
+	if err = h.productService.DeleteProductTranslation(c.Context(), productId, locale); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) GetCategoryTranslations(c *fiber.Ctx) error {
+	categoryId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	translations, err := h.productService.GetCategoryTranslations(c.Context(), categoryId)
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.CategoryTranslationResponse, 0, len(translations))
+	for _, translation := range translations {
+		res = append(res, response.NewCategoryTranslationResponse(&translation))
+	}
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *ProductHandler) SetCategoryTranslation(c *fiber.Ctx) error {
+	categoryId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	locale, err := h.parseLocale(c)
+	if err != nil {
+		return err
+	}
+
+	var req request.SetCategoryTranslationRequest
+	if err = c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err = h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	if err = h.productService.SetCategoryTranslation(
+		c.Context(),
+		domain.NewCategoryTranslation(categoryId, locale, req.Name),
+	); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) DeleteCategoryTranslation(c *fiber.Ctx) error {
+	categoryId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	locale, err := h.parseLocale(c)
+	if err != nil {
+		return err
+	}
+
+	if err = h.productService.DeleteCategoryTranslation(c.Context(), categoryId, locale); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// parseScheduleRequest parses and validates set schedule request body.
+func (h *ProductHandler) parseScheduleRequest(c *fiber.Ctx) ([]domain.ScheduleWindow, error) {
+	var req request.SetScheduleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return nil, err
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return nil, err
+	}
+
+	windows := make([]domain.ScheduleWindow, 0, len(req.Windows))
+	for _, window := range req.Windows {
+		windows = append(windows, *domain.NewScheduleWindow(time.Weekday(*window.Weekday), window.Start, window.End))
+	}
+	return windows, nil
+}
+
+func (h *ProductHandler) GetProductSchedule(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	windows, err := h.productService.GetProductSchedule(c.Context(), productId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(response.NewScheduleWindowResponses(windows))
+}
+
+func (h *ProductHandler) SetProductSchedule(c *fiber.Ctx) error {
+	productId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	windows, err := h.parseScheduleRequest(c)
+	if err != nil {
+		return err
+	}
+
+	if err = h.productService.SetProductSchedule(c.Context(), productId, windows); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) GetCategorySchedule(c *fiber.Ctx) error {
+	categoryId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	windows, err := h.productService.GetCategorySchedule(c.Context(), categoryId)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(response.NewScheduleWindowResponses(windows))
+}
+
+func (h *ProductHandler) SetCategorySchedule(c *fiber.Ctx) error {
+	categoryId, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	windows, err := h.parseScheduleRequest(c)
+	if err != nil {
+		return err
+	}
+
+	if err = h.productService.SetCategorySchedule(c.Context(), categoryId, windows); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) GetPricingRules(c *fiber.Ctx) error {
+	rules, err := h.productService.GetPricingRules(c.Context())
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.PricingRuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, response.NewPricingRuleResponse(&rule))
+	}
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *ProductHandler) AddPricingRule(c *fiber.Ctx) error {
+	var req request.AddPricingRuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return err
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return err
+	}
+
+	windows := make([]domain.ScheduleWindow, 0, len(req.Windows))
+	for _, window := range req.Windows {
+		windows = append(windows, *domain.NewScheduleWindow(time.Weekday(*window.Weekday), window.Start, window.End))
+	}
+
+	rule, err := h.productService.AddPricingRule(
+		c.Context(),
+		domain.NewAddPricingRuleDTO(req.Name, req.ProductId, req.CategoryId, req.Kind, req.Value, windows),
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.NewPricingRuleResponse(rule))
+}
+
+func (h *ProductHandler) DeletePricingRule(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return domain.ErrInvalidUUID
+	}
+
+	if err = h.productService.DeletePricingRule(c.Context(), id); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) ExportMenu(c *fiber.Ctx) error {
+	format := c.Query("format", "json")
+	if format != "json" && format != "csv" {
+		return domain.ErrInvalidMenuFormat
+	}
+
+	rows, err := h.productService.ExportMenu(c.Context())
+	if err != nil {
+		return err
+	}
+
+	if format == "csv" {
+		var buf bytes.Buffer
+		if err = writeMenuCSV(&buf, rows); err != nil {
+			zap.L().Error("error writing menu csv", zap.Error(err))
+			return domain.ErrInternal
+		}
+		c.Attachment("menu.csv")
+		return c.Status(http.StatusOK).Send(buf.Bytes())
+	}
+
+	res := make([]response.MenuRowResponse, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, response.NewMenuRowResponse(&row))
+	}
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *ProductHandler) ImportMenu(c *fiber.Ctx) error {
+	dryRun := c.QueryBool("dryRun")
+
+	rows, rowErrs, err := readMenuRows(c)
+	if err != nil {
+		return err
+	}
+	if len(rowErrs) > 0 {
+		report := domain.NewMenuImportReport(dryRun)
+		report.Errors = rowErrs
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(response.NewMenuImportReportResponse(report))
+	}
+
+	report, err := h.productService.ImportMenu(c.Context(), domain.NewImportMenuDTO(rows, dryRun))
+	if err != nil {
+		return err
+	}
+
+	status := fiber.StatusOK
+	if len(report.Errors) > 0 {
+		status = fiber.StatusUnprocessableEntity
+	}
+	return c.Status(status).JSON(response.NewMenuImportReportResponse(report))
+}
+
+func (h *ProductHandler) GetMenuDraft(c *fiber.Ctx) error {
+	draft, err := h.productService.GetMenuDraft(c.Context())
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(response.NewMenuDraftResponse(draft))
+}
+
+func (h *ProductHandler) SaveMenuDraft(c *fiber.Ctx) error {
+	rows, rowErrs, err := readMenuRows(c)
+	if err != nil {
+		return err
+	}
+	if len(rowErrs) > 0 {
+		report := domain.NewMenuImportReport(true)
+		report.Errors = rowErrs
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(response.NewMenuImportReportResponse(report))
+	}
+
+	draft, err := h.productService.SaveMenuDraft(c.Context(), rows)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(response.NewMenuDraftResponse(draft))
+}
+
+func (h *ProductHandler) DiscardMenuDraft(c *fiber.Ctx) error {
+	if err := h.productService.DiscardMenuDraft(c.Context()); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (h *ProductHandler) PreviewMenuDraft(c *fiber.Ctx) error {
+	preview, err := h.productService.PreviewMenuDraft(c.Context())
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(response.NewMenuPreviewResponse(preview))
+}
+
+func (h *ProductHandler) PublishMenuDraft(c *fiber.Ctx) error {
+	version, err := h.productService.PublishMenuDraft(c.Context())
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.NewMenuVersionResponse(version))
+}
+
+func (h *ProductHandler) GetMenuVersions(c *fiber.Ctx) error {
+	versions, err := h.productService.GetMenuVersions(c.Context())
+	if err != nil {
+		return err
+	}
+
+	res := make([]response.MenuVersionResponse, 0, len(versions))
+	for _, version := range versions {
+		res = append(res, response.NewMenuVersionResponse(&version))
+	}
+	return c.Status(http.StatusOK).JSON(res)
+}
+
+func (h *ProductHandler) RollbackMenu(c *fiber.Ctx) error {
+	number, err := c.ParamsInt("number")
+	if err != nil || number < 1 {
+		return domain.ErrMenuVersionNotFound
+	}
+
+	version, err := h.productService.RollbackMenu(c.Context(), number)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusCreated).JSON(response.NewMenuVersionResponse(version))
+}
@@ -0,0 +1,26 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// AddBundleSlotRequest represents a slot inside add bundle request body.
+type AddBundleSlotRequest struct {
+	Name       string      `json:"name" validate:"required,min=1,max=100"`
+	CategoryId *uuid.UUID  `json:"categoryId" validate:"required_without=ProductIds,excluded_with=ProductIds"`
+	ProductIds []uuid.UUID `json:"productIds" validate:"omitempty,unique"`
+}
+
+// AddBundleRequest represents add bundle request body.
+type AddBundleRequest struct {
+	Name        string                 `json:"name" validate:"required,min=3,max=100"`
+	Description string                 `json:"description" validate:"required,min=15"`
+	Price       decimal.Decimal        `json:"price" validate:"required,gtZero"`
+	Slots       []AddBundleSlotRequest `json:"slots" validate:"required,min=1,unique=Name,dive"`
+}
+
+// SetBundleAvailabilityRequest represents set bundle availability request body.
+type SetBundleAvailabilityRequest struct {
+	Available *bool `json:"available" validate:"required"`
+}
@@ -0,0 +1,36 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// AddIngredientRequest represents add ingredient request body.
+type AddIngredientRequest struct {
+	Name   string          `json:"name" validate:"required,min=2,max=100"`
+	Unit   string          `json:"unit" validate:"required,oneof=g kg ml l pcs"`
+	OnHand decimal.Decimal `json:"onHand" validate:"gteZero"`
+}
+
+// StockAdjustmentRequest represents stock adjustment request body.
+type StockAdjustmentRequest struct {
+	Delta  decimal.Decimal `json:"delta"`
+	Reason *string         `json:"reason" validate:"omitempty,max=200"`
+}
+
+// StockCountRequest represents stock count request body.
+type StockCountRequest struct {
+	Counted decimal.Decimal `json:"counted" validate:"gteZero"`
+	Reason  *string         `json:"reason" validate:"omitempty,max=200"`
+}
+
+// RecipeItemRequest represents an ingredient inside set recipe request body.
+type RecipeItemRequest struct {
+	IngredientId uuid.UUID       `json:"ingredientId" validate:"required"`
+	Amount       decimal.Decimal `json:"amount" validate:"gtZero"`
+}
+
+// SetRecipeRequest represents set recipe request body.
+type SetRecipeRequest struct {
+	Items []RecipeItemRequest `json:"items" validate:"unique=IngredientId,dive"`
+}
@@ -0,0 +1,19 @@
+package request
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// MenuRowRequest represents a row of import menu request body.
+// Products are available unless stated otherwise.
+type MenuRowRequest struct {
+	CategoryId   *uuid.UUID      `json:"categoryId"`
+	CategoryName string          `json:"categoryName"`
+	ProductId    *uuid.UUID      `json:"productId"`
+	ProductName  string          `json:"productName"`
+	Description  string          `json:"description"`
+	Price        decimal.Decimal `json:"price"`
+	Available    *bool           `json:"available"`
+	ImageUrl     *string         `json:"imageUrl"`
+}
@@ -0,0 +1,24 @@
+package request
+
+import "restaurant/internal/core/domain"
+
+// GetSessionsRequest represents get sessions query parameters.
+type GetSessionsRequest struct {
+	Status        domain.OrderSessionStatus `query:"status" validate:"omitempty,orderStatus"`
+	TableNumber   int                       `query:"table_number" validate:"omitempty,min=1"`
+	CreatedAfter  string                    `query:"created_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CreatedBefore string                    `query:"created_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Cursor        string                    `query:"cursor"`
+	Limit         int                       `query:"limit"`
+}
+
+// GetOrderedProductsRequest represents get ordered products query parameters.
+type GetOrderedProductsRequest struct {
+	Status        domain.OrderedProductStatus `query:"status" validate:"omitempty,orderedProductStatus"`
+	SessionId     string                      `query:"session_id" validate:"omitempty,uuid"`
+	TableNumber   int                         `query:"table_number" validate:"omitempty,min=1"`
+	CreatedAfter  string                      `query:"created_after" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CreatedBefore string                      `query:"created_before" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Cursor        string                      `query:"cursor"`
+	Limit         int                         `query:"limit"`
+}
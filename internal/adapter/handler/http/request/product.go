@@ -1,6 +1,8 @@
 package request
 
 import (
+	"restaurant/internal/core/domain"
+
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -15,18 +17,106 @@ type UpdateCategoryRequest struct {
 	NewName *string `json:"newName" validate:"omitempty,min=4,max=100"`
 }
 
+// NutritionRequest represents the nutrition facts of a portion inside product request bodies.
+// Calories are in kcal, the nutrients in grams.
+type NutritionRequest struct {
+	Calories      *int             `json:"calories" validate:"omitempty,min=0,max=10000"`
+	Protein       *decimal.Decimal `json:"protein" validate:"omitempty,gteZero"`
+	Fat           *decimal.Decimal `json:"fat" validate:"omitempty,gteZero"`
+	Carbohydrates *decimal.Decimal `json:"carbohydrates" validate:"omitempty,gteZero"`
+	Sugar         *decimal.Decimal `json:"sugar" validate:"omitempty,gteZero"`
+	Salt          *decimal.Decimal `json:"salt" validate:"omitempty,gteZero"`
+	PortionSize   *string          `json:"portionSize" validate:"omitempty,min=1,max=50"`
+}
+
 // AddProductRequest represents add product request body.
 type AddProductRequest struct {
-	Name        string          `json:"name" validate:"required,min=3,max=100"`
-	Description string          `json:"description" validate:"required,min=15"`
-	Category    uuid.UUID       `json:"category" validate:"required"`
-	Price       decimal.Decimal `json:"price" validate:"required,gtZero"`
+	Name        string            `json:"name" validate:"required,min=3,max=100"`
+	Description string            `json:"description" validate:"required,min=15"`
+	Category    uuid.UUID         `json:"category" validate:"required"`
+	Price       decimal.Decimal   `json:"price" validate:"required,gtZero"`
+	Nutrition   *NutritionRequest `json:"nutrition"`
 }
 
-// UpdateProductRequest represents update product request body.
+// UpdateProductRequest represents update product request body. NewNutrition replaces
+// all nutrition facts, facts missing from it are cleared.
 type UpdateProductRequest struct {
-	NewName        *string          `json:"newName" validate:"omitempty,min=3,max=100"`
-	NewDescription *string          `json:"newDescription" validate:"omitempty,min=15"`
-	NewCategory    *uuid.UUID       `json:"newCategory" validate:"omitempty"`
-	NewPrice       *decimal.Decimal `json:"newPrice" validate:"omitempty,gtZero"`
+	NewName        *string           `json:"newName" validate:"omitempty,min=3,max=100"`
+	NewDescription *string           `json:"newDescription" validate:"omitempty,min=15"`
+	NewCategory    *uuid.UUID        `json:"newCategory" validate:"omitempty"`
+	NewPrice       *decimal.Decimal  `json:"newPrice" validate:"omitempty,gtZero"`
+	NewNutrition   *NutritionRequest `json:"newNutrition"`
+}
+
+// AddModifierOptionRequest represents a modifier option inside add modifier group request body.
+type AddModifierOptionRequest struct {
+	Name       string          `json:"name" validate:"required,min=1,max=100"`
+	PriceDelta decimal.Decimal `json:"priceDelta" validate:"gteZero"`
+}
+
+// AddModifierGroupRequest represents add modifier group request body.
+type AddModifierGroupRequest struct {
+	Name        string                     `json:"name" validate:"required,min=3,max=100"`
+	MinSelected int                        `json:"minSelected" validate:"min=0"`
+	MaxSelected int                        `json:"maxSelected" validate:"min=1,gtefield=MinSelected"`
+	Options     []AddModifierOptionRequest `json:"options" validate:"required,min=1,unique=Name,dive"`
+}
+
+// SetProductAvailabilityRequest represents set product availability request body.
+type SetProductAvailabilityRequest struct {
+	Available *bool `json:"available" validate:"required"`
+}
+
+// AddTagRequest represents add tag request body.
+type AddTagRequest struct {
+	Name string `json:"name" validate:"required,min=2,max=50"`
+}
+
+// SetProductTagsRequest represents set product tags request body.
+type SetProductTagsRequest struct {
+	TagIds []uuid.UUID `json:"tagIds" validate:"unique"`
+}
+
+// AddProductImageRequest represents add product image request query or multipart form fields,
+// the image itself is the request body or the image field of the form.
+type AddProductImageRequest struct {
+	AltText string `query:"alt_text" form:"alt_text" validate:"max=200"`
+}
+
+// ReorderProductImagesRequest represents reorder product images request body.
+type ReorderProductImagesRequest struct {
+	ImageIds []uuid.UUID `json:"imageIds" validate:"required,unique"`
+}
+
+// SetProductTranslationRequest represents set product translation request body.
+type SetProductTranslationRequest struct {
+	Name        string `json:"name" validate:"required,min=3,max=100"`
+	Description string `json:"description" validate:"required,min=15"`
+}
+
+// SetCategoryTranslationRequest represents set category translation request body.
+type SetCategoryTranslationRequest struct {
+	Name string `json:"name" validate:"required,min=4,max=100"`
+}
+
+// ScheduleWindowRequest represents a window inside set schedule request body.
+type ScheduleWindowRequest struct {
+	Weekday *int   `json:"weekday" validate:"required,min=0,max=6"`
+	Start   string `json:"start" validate:"required,datetime=15:04"`
+	End     string `json:"end" validate:"required,datetime=15:04"`
+}
+
+// SetScheduleRequest represents set schedule request body.
+type SetScheduleRequest struct {
+	Windows []ScheduleWindowRequest `json:"windows" validate:"dive"`
+}
+
+// AddPricingRuleRequest represents add pricing rule request body.
+type AddPricingRuleRequest struct {
+	Name       string                     `json:"name" validate:"required,min=3,max=100"`
+	ProductId  *uuid.UUID                 `json:"productId" validate:"required_without=CategoryId,excluded_with=CategoryId"`
+	CategoryId *uuid.UUID                 `json:"categoryId"`
+	Kind       domain.PriceAdjustmentKind `json:"kind" validate:"required,oneof=percentage fixed_price"`
+	Value      decimal.Decimal            `json:"value" validate:"gtZero"`
+	Windows    []ScheduleWindowRequest    `json:"windows" validate:"dive"`
 }
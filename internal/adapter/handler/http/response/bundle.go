@@ -0,0 +1,77 @@
+package response
+
+import (
+	"restaurant/internal/core/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BundleSlotResponse represents a bundle slot response.
+type BundleSlotResponse struct {
+	Id         uuid.UUID   `json:"id"`
+	Name       string      `json:"name"`
+	CategoryId *uuid.UUID  `json:"categoryId"`
+	ProductIds []uuid.UUID `json:"productIds"`
+}
+
+// BundleResponse represents a bundle response.
+type BundleResponse struct {
+	Id          uuid.UUID            `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Price       decimal.Decimal      `json:"price"`
+	Available   bool                 `json:"available"`
+	Slots       []BundleSlotResponse `json:"slots"`
+}
+
+// NewBundleResponse creates a new BundleResponse instance.
+func NewBundleResponse(bundle *domain.Bundle) BundleResponse {
+	slots := make([]BundleSlotResponse, 0, len(bundle.Slots))
+	for _, slot := range bundle.Slots {
+		productIds := slot.ProductIds
+		if productIds == nil {
+			productIds = []uuid.UUID{}
+		}
+
+		slots = append(slots, BundleSlotResponse{
+			Id:         slot.Id,
+			Name:       slot.Name,
+			CategoryId: slot.CategoryId,
+			ProductIds: productIds,
+		})
+	}
+
+	return BundleResponse{
+		Id:          bundle.Id,
+		Name:        bundle.Name,
+		Description: bundle.Description,
+		Price:       bundle.Price,
+		Available:   bundle.Available,
+		Slots:       slots,
+	}
+}
+
+// BillBundleItemResponse represents an ordered bundle on a bill response.
+type BillBundleItemResponse struct {
+	Name       string          `json:"name"`
+	Price      decimal.Decimal `json:"price"`
+	Quantity   int             `json:"quantity"`
+	Components []string        `json:"components"`
+	TotalPrice decimal.Decimal `json:"totalPrice"`
+}
+
+// NewBillBundleItemResponses creates BillBundleItemResponse instances from bill bundle items.
+func NewBillBundleItemResponses(items []domain.BillBundleItem) []BillBundleItemResponse {
+	response := make([]BillBundleItemResponse, 0, len(items))
+	for _, item := range items {
+		response = append(response, BillBundleItemResponse{
+			Name:       item.Name,
+			Price:      item.Price,
+			Quantity:   item.Quantity,
+			Components: item.Components,
+			TotalPrice: item.TotalPrice,
+		})
+	}
+	return response
+}
@@ -116,7 +116,22 @@ var domainErrorsMap = map[error]ErrorResponse{
 		Code:       "invalid_image_format",
 		Messages: []string{
 			"Invalid image format.",
-			"Supported formats are jpeg and png.",
+			"Supported formats are jpeg, png and webp.",
+		},
+	},
+	domain.ErrImageRequired: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "image_required",
+		Messages: []string{
+			"Image is required.",
+			"Send the image as the request body or as the image field of a multipart form.",
+		},
+	},
+	domain.ErrCorruptImage: {
+		StatusCode: fiber.StatusUnprocessableEntity,
+		Code:       "corrupt_image",
+		Messages: []string{
+			"Image can't be decoded.",
 		},
 	},
 	domain.ErrProductCategoryNameAlreadyInUse: {
@@ -147,6 +162,275 @@ var domainErrorsMap = map[error]ErrorResponse{
 			"Product not found.",
 		},
 	},
+	domain.ErrProductArchived: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "product_archived",
+		Messages: []string{
+			"Product is archived and can't be ordered.",
+		},
+	},
+	domain.ErrProductUnavailable: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "product_unavailable",
+		Messages: []string{
+			"Product is currently unavailable.",
+		},
+	},
+	domain.ErrProductOutOfSchedule: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "product_out_of_schedule",
+		Messages: []string{
+			"Product is not available at this time.",
+		},
+	},
+	domain.ErrDuplicateScheduleWindow: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "duplicate_schedule_window",
+		Messages: []string{
+			"Schedule windows must not start on the same weekday and time.",
+		},
+	},
+	domain.ErrInvalidScheduleWindow: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_schedule_window",
+		Messages: []string{
+			"Schedule windows must have a weekday from 0 to 6 and start and end times formatted as HH:MM.",
+		},
+	},
+	domain.ErrPricingRuleNotFound: {
+		StatusCode: fiber.StatusNotFound,
+		Code:       "pricing_rule_not_found",
+		Messages: []string{
+			"Pricing rule was not found.",
+		},
+	},
+	domain.ErrPricingRuleNameAlreadyInUse: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "pricing_rule_name_already_in_use",
+		Messages: []string{
+			"Pricing rule name is already in use.",
+		},
+	},
+	domain.ErrInvalidPricingRule: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_pricing_rule",
+		Messages: []string{
+			"Pricing rule must target either a product or a category.",
+			"Percentage discounts can't exceed 100.",
+		},
+	},
+	domain.ErrBundleNotFound: {
+		StatusCode: fiber.StatusNotFound,
+		Code:       "bundle_not_found",
+		Messages: []string{
+			"Bundle was not found.",
+		},
+	},
+	domain.ErrBundleNameAlreadyInUse: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "bundle_name_already_in_use",
+		Messages: []string{
+			"Bundle name is already in use.",
+		},
+	},
+	domain.ErrInvalidBundle: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_bundle",
+		Messages: []string{
+			"Bundle must have at least one slot and slot names must be unique.",
+			"Every slot must reference either a category or specific products, each product only once.",
+		},
+	},
+	domain.ErrProductImageNotFound: {
+		StatusCode: fiber.StatusNotFound,
+		Code:       "product_image_not_found",
+		Messages: []string{
+			"Product image not found.",
+		},
+	},
+	domain.ErrProductGalleryFull: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "product_gallery_full",
+		Messages: []string{
+			fmt.Sprintf("Product can have at most %d images.", domain.MaxProductImages),
+			"Remove an image before adding another one.",
+		},
+	},
+	domain.ErrInvalidProductImageOrder: {
+		StatusCode: fiber.StatusUnprocessableEntity,
+		Code:       "invalid_product_image_order",
+		Messages: []string{
+			"Image order must list every image of the product exactly once.",
+		},
+	},
+	domain.ErrInvalidNutrition: {
+		StatusCode: fiber.StatusUnprocessableEntity,
+		Code:       "invalid_nutrition",
+		Messages: []string{
+			fmt.Sprintf("Calories must be between 0 and %d.", domain.MaxCalories),
+			"Nutrients must be between 0 and 9999.99 grams and sugar can't exceed carbohydrates.",
+			fmt.Sprintf("Portion size must be between 1 and %d characters.", domain.MaxPortionSizeLength),
+		},
+	},
+	domain.ErrInvalidMaxCalories: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_max_calories",
+		Messages: []string{
+			"max_calories must be a non-negative whole number.",
+		},
+	},
+	domain.ErrProductHasOrderHistory: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "product_has_order_history",
+		Messages: []string{
+			"Product was already ordered and can't be deleted.",
+			"Archive the product instead.",
+		},
+	},
+	domain.ErrTagNotFound: {
+		StatusCode: fiber.StatusNotFound,
+		Code:       "tag_not_found",
+		Messages: []string{
+			"Tag not found.",
+		},
+	},
+	domain.ErrTagNameAlreadyInUse: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "tag_name_already_exists",
+		Messages: []string{
+			"Tag name is already in use.",
+		},
+	},
+	domain.ErrAllergenTagNotDeletable: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "allergen_tag_not_deletable",
+		Messages: []string{
+			"Allergen tags are predefined and can't be deleted.",
+		},
+	},
+	domain.ErrInvalidLocale: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_locale",
+		Messages: []string{
+			"Invalid locale.",
+			"Locales must be BCP 47 language tags such as en or de-CH.",
+		},
+	},
+	domain.ErrInvalidProductSort: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_product_sort",
+		Messages: []string{
+			"Sort must be one of name, price, category or relevance and order one of asc or desc.",
+			"Sorting by relevance requires a search.",
+		},
+	},
+	domain.ErrInvalidPagination: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_pagination",
+		Messages: []string{
+			"Limit must be between 1 and 100 and offset must not be negative.",
+		},
+	},
+	domain.ErrInvalidMenuFormat: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_menu_format",
+		Messages: []string{
+			"Menu must be sent and requested as JSON or CSV with the exported columns.",
+		},
+	},
+	domain.ErrMenuDraftNotFound: {
+		StatusCode: fiber.StatusNotFound,
+		Code:       "menu_draft_not_found",
+		Messages: []string{
+			"There are no staged menu changes.",
+		},
+	},
+	domain.ErrInvalidMenuDraft: {
+		StatusCode: fiber.StatusUnprocessableEntity,
+		Code:       "invalid_menu_draft",
+		Messages: []string{
+			"The draft has invalid rows, preview it to see them.",
+		},
+	},
+	domain.ErrMenuDraftPending: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "menu_draft_pending",
+		Messages: []string{
+			"Categories and products can't be changed directly while a menu draft is staged.",
+			"Change them in the draft, or publish or discard it first.",
+		},
+	},
+	domain.ErrMenuDraftChanged: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "menu_draft_changed",
+		Messages: []string{
+			"The draft was changed while it was being published, preview it and publish it again.",
+		},
+	},
+	domain.ErrMenuVersionNotFound: {
+		StatusCode: fiber.StatusNotFound,
+		Code:       "menu_version_not_found",
+		Messages: []string{
+			"Menu version not found.",
+		},
+	},
+	domain.ErrMenuVersionConflict: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "menu_version_conflict",
+		Messages: []string{
+			"The version uses names that now belong to other categories or products.",
+		},
+	},
+
+	domain.ErrInvalidCursor: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_cursor",
+		Messages: []string{
+			"Cursor must be the next cursor of a previous page.",
+		},
+	},
+	domain.ErrTranslationNotFound: {
+		StatusCode: fiber.StatusNotFound,
+		Code:       "translation_not_found",
+		Messages: []string{
+			"Translation not found.",
+		},
+	},
+	domain.ErrIngredientNotFound: {
+		StatusCode: fiber.StatusNotFound,
+		Code:       "ingredient_not_found",
+		Messages: []string{
+			"Ingredient not found.",
+		},
+	},
+	domain.ErrIngredientNameAlreadyInUse: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "ingredient_name_already_exists",
+		Messages: []string{
+			"Ingredient name is already in use.",
+		},
+	},
+	domain.ErrIngredientInUse: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "ingredient_in_use",
+		Messages: []string{
+			"Ingredient is used by recipes and can't be deleted.",
+		},
+	},
+	domain.ErrInvalidStockCount: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_stock_count",
+		Messages: []string{
+			"Stock count can't be negative.",
+		},
+	},
+	domain.ErrInsufficientStock: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "insufficient_stock",
+		Messages: []string{
+			"There is not enough stock of an ingredient.",
+		},
+	},
 	domain.ErrCategoryHasLinkedProducts: {
 		StatusCode: fiber.StatusBadRequest,
 		Code:       "category_has_linked_products",
@@ -169,6 +453,41 @@ var domainErrorsMap = map[error]ErrorResponse{
 			"Order session is not open.",
 		},
 	},
+	domain.ErrModifierGroupNotFound: {
+		StatusCode: fiber.StatusNotFound,
+		Code:       "modifier_group_not_found",
+		Messages: []string{
+			"Modifier group not found.",
+		},
+	},
+	domain.ErrModifierGroupNameAlreadyInUse: {
+		StatusCode: fiber.StatusConflict,
+		Code:       "modifier_group_name_already_exists",
+		Messages: []string{
+			"Modifier group name is already in use for this product.",
+		},
+	},
+	domain.ErrModifierGroupInUse: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "modifier_group_in_use",
+		Messages: []string{
+			"Modifier group has options that were already ordered.",
+		},
+	},
+	domain.ErrInvalidModifierSelection: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_modifier_selection",
+		Messages: []string{
+			"Selected options don't match the modifier groups of the product.",
+		},
+	},
+	domain.ErrInvalidQuantity: {
+		StatusCode: fiber.StatusBadRequest,
+		Code:       "invalid_quantity",
+		Messages: []string{
+			"Quantity must be a positive number.",
+		},
+	},
 	domain.ErrProductsAreIncomplete: {
 		StatusCode: fiber.StatusBadRequest,
 		Code:       "products_are_incomplete",
@@ -195,8 +514,31 @@ func mapDomainError(err error) ErrorResponse {
 	return response
 }
 
-// ErrorHandler is a handler used to handle all returned errors.
-func ErrorHandler(c *fiber.Ctx, err error) error {
+// mapImageTooLargeError maps domain.ErrImageTooLarge into ErrorResponse with the configured upload limit.
+func mapImageTooLargeError(imagesMaxSize int) ErrorResponse {
+	return ErrorResponse{
+		StatusCode: fiber.StatusRequestEntityTooLarge,
+		Code:       "image_too_large",
+		Messages: []string{
+			fmt.Sprintf("Image must be at most %d MB.", imagesMaxSize>>20),
+			fmt.Sprintf("Image must be at most %d by %d pixels.", domain.MaxImageDimension, domain.MaxImageDimension),
+		},
+	}
+}
+
+// NewErrorHandler creates a handler used to handle all returned errors.
+func NewErrorHandler(imagesMaxSize int) fiber.ErrorHandler {
+	imageTooLarge := mapImageTooLargeError(imagesMaxSize)
+	return func(c *fiber.Ctx, err error) error {
+		if errors.Is(err, domain.ErrImageTooLarge) {
+			return c.Status(imageTooLarge.StatusCode).JSON(imageTooLarge)
+		}
+		return handleError(c, err)
+	}
+}
+
+// handleError handles all returned errors that don't depend on the configuration.
+func handleError(c *fiber.Ctx, err error) error {
 	var validatorErr validator.ValidationErrors
 	var fiberErr *fiber.Error
 	var jsonErr *json.UnmarshalTypeError
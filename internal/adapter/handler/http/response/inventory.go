@@ -0,0 +1,44 @@
+package response
+
+import (
+	"restaurant/internal/core/domain"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// IngredientResponse represents an ingredient response.
+type IngredientResponse struct {
+	Id     uuid.UUID       `json:"id"`
+	Name   string          `json:"name"`
+	Unit   string          `json:"unit"`
+	OnHand decimal.Decimal `json:"onHand"`
+}
+
+// NewIngredientResponse creates a new IngredientResponse instance.
+func NewIngredientResponse(ingredient *domain.Ingredient) IngredientResponse {
+	return IngredientResponse{
+		Id:     ingredient.Id,
+		Name:   ingredient.Name,
+		Unit:   ingredient.Unit,
+		OnHand: ingredient.OnHand,
+	}
+}
+
+// RecipeItemResponse represents a recipe item response.
+type RecipeItemResponse struct {
+	IngredientId uuid.UUID       `json:"ingredientId"`
+	Amount       decimal.Decimal `json:"amount"`
+}
+
+// NewRecipeItemResponses creates RecipeItemResponse instances from recipe items.
+func NewRecipeItemResponses(items []domain.RecipeItem) []RecipeItemResponse {
+	res := make([]RecipeItemResponse, 0, len(items))
+	for _, item := range items {
+		res = append(res, RecipeItemResponse{
+			IngredientId: item.IngredientId,
+			Amount:       item.Amount,
+		})
+	}
+	return res
+}
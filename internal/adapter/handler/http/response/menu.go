@@ -0,0 +1,138 @@
+package response
+
+import (
+	"restaurant/internal/core/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// MenuRowResponse represents an exported menu row response.
+// The product fields are left out for a category without products.
+type MenuRowResponse struct {
+	CategoryId   *uuid.UUID       `json:"categoryId"`
+	CategoryName string           `json:"categoryName"`
+	ProductId    *uuid.UUID       `json:"productId,omitempty"`
+	ProductName  string           `json:"productName,omitempty"`
+	Description  string           `json:"description,omitempty"`
+	Price        *decimal.Decimal `json:"price,omitempty"`
+	Available    *bool            `json:"available,omitempty"`
+	ImageUrl     *string          `json:"imageUrl,omitempty"`
+}
+
+// NewMenuRowResponse creates a new MenuRowResponse instance.
+func NewMenuRowResponse(row *domain.MenuRow) MenuRowResponse {
+	res := MenuRowResponse{
+		CategoryId:   row.CategoryId,
+		CategoryName: row.CategoryName,
+	}
+	if row.HasProduct() {
+		res.ProductId = row.ProductId
+		res.ProductName = row.ProductName
+		res.Description = row.Description
+		res.Price = &row.Price
+		res.Available = &row.Available
+		res.ImageUrl = row.ImageUrl
+	}
+	return res
+}
+
+// MenuRowErrorResponse represents the validation errors of an imported menu row.
+type MenuRowErrorResponse struct {
+	Row      int      `json:"row"`
+	Messages []string `json:"messages"`
+}
+
+// MenuImportReportResponse represents a menu import report response.
+type MenuImportReportResponse struct {
+	DryRun            bool                   `json:"dryRun"`
+	Applied           bool                   `json:"applied"`
+	CategoriesCreated int                    `json:"categoriesCreated"`
+	CategoriesUpdated int                    `json:"categoriesUpdated"`
+	ProductsCreated   int                    `json:"productsCreated"`
+	ProductsUpdated   int                    `json:"productsUpdated"`
+	Errors            []MenuRowErrorResponse `json:"errors"`
+}
+
+// NewMenuImportReportResponse creates a new MenuImportReportResponse instance.
+func NewMenuImportReportResponse(report *domain.MenuImportReport) MenuImportReportResponse {
+	errs := make([]MenuRowErrorResponse, 0, len(report.Errors))
+	for _, rowErr := range report.Errors {
+		errs = append(errs, MenuRowErrorResponse{
+			Row:      rowErr.Row,
+			Messages: rowErr.Messages,
+		})
+	}
+
+	return MenuImportReportResponse{
+		DryRun:            report.DryRun,
+		Applied:           report.Applied,
+		CategoriesCreated: report.CategoriesCreated,
+		CategoriesUpdated: report.CategoriesUpdated,
+		ProductsCreated:   report.ProductsCreated,
+		ProductsUpdated:   report.ProductsUpdated,
+		Errors:            errs,
+	}
+}
+
+// MenuDraftResponse represents a menu draft response.
+// UpdatedAt is null when nothing is staged and the rows are the live menu.
+type MenuDraftResponse struct {
+	Rows      []MenuRowResponse `json:"rows"`
+	UpdatedAt *time.Time        `json:"updatedAt"`
+}
+
+// NewMenuDraftResponse creates a new MenuDraftResponse instance.
+func NewMenuDraftResponse(draft *domain.MenuDraft) MenuDraftResponse {
+	res := MenuDraftResponse{
+		Rows: newMenuRowResponses(draft.Rows),
+	}
+	if !draft.UpdatedAt.IsZero() {
+		res.UpdatedAt = &draft.UpdatedAt
+	}
+	return res
+}
+
+// MenuPreviewResponse represents a menu draft preview response.
+type MenuPreviewResponse struct {
+	Rows               []MenuRowResponse        `json:"rows"`
+	Report             MenuImportReportResponse `json:"report"`
+	CategoriesArchived int                      `json:"categoriesArchived"`
+	ProductsArchived   int                      `json:"productsArchived"`
+}
+
+// NewMenuPreviewResponse creates a new MenuPreviewResponse instance.
+func NewMenuPreviewResponse(preview *domain.MenuPreview) MenuPreviewResponse {
+	return MenuPreviewResponse{
+		Rows:               newMenuRowResponses(preview.Rows),
+		Report:             NewMenuImportReportResponse(preview.Report),
+		CategoriesArchived: preview.CategoriesArchived,
+		ProductsArchived:   preview.ProductsArchived,
+	}
+}
+
+// MenuVersionResponse represents a published menu version response.
+type MenuVersionResponse struct {
+	Number       int       `json:"number"`
+	RestoredFrom *int      `json:"restoredFrom"`
+	PublishedAt  time.Time `json:"publishedAt"`
+}
+
+// NewMenuVersionResponse creates a new MenuVersionResponse instance.
+func NewMenuVersionResponse(version *domain.MenuVersion) MenuVersionResponse {
+	return MenuVersionResponse{
+		Number:       version.Number,
+		RestoredFrom: version.RestoredFrom,
+		PublishedAt:  version.PublishedAt,
+	}
+}
+
+// newMenuRowResponses converts menu rows into responses.
+func newMenuRowResponses(rows []domain.MenuRow) []MenuRowResponse {
+	res := make([]MenuRowResponse, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, NewMenuRowResponse(&row))
+	}
+	return res
+}
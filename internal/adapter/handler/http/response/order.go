@@ -2,6 +2,7 @@ package response
 
 import (
 	"restaurant/internal/core/domain"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -12,6 +13,7 @@ type OrderSessionResponse struct {
 	Id          uuid.UUID                 `json:"id"`
 	TableNumber int                       `json:"tableNumber"`
 	Status      domain.OrderSessionStatus `json:"status"`
+	CreatedAt   time.Time                 `json:"createdAt"`
 }
 
 // NewOrderSessionResponse creates a new OrderSessionResponse instance.
@@ -20,29 +22,55 @@ func NewOrderSessionResponse(order *domain.OrderSession) OrderSessionResponse {
 		Id:          order.Id,
 		TableNumber: order.TableNumber,
 		Status:      order.Status,
+		CreatedAt:   order.CreatedAt,
+	}
+}
+
+// nextCursor encodes the cursor of the next page, if there is one.
+func nextCursor(cursor *domain.Cursor) *string {
+	if cursor == nil {
+		return nil
+	}
+	token := cursor.String()
+	return &token
+}
+
+// OrderSessionPageResponse represents a page of order sessions response.
+type OrderSessionPageResponse struct {
+	Sessions   []OrderSessionResponse `json:"sessions"`
+	NextCursor *string                `json:"nextCursor"`
+}
+
+// NewOrderSessionPageResponse creates a new OrderSessionPageResponse instance.
+func NewOrderSessionPageResponse(page *domain.OrderSessionPage) OrderSessionPageResponse {
+	sessions := make([]OrderSessionResponse, 0, len(page.Sessions))
+	for _, session := range page.Sessions {
+		sessions = append(sessions, NewOrderSessionResponse(&session))
+	}
+
+	return OrderSessionPageResponse{
+		Sessions:   sessions,
+		NextCursor: nextCursor(page.NextCursor),
 	}
 }
 
 type BillItemResponse struct {
-	Product    ProductResponse `json:"product"`
-	Quantity   int             `json:"quantity"`
-	TotalPrice decimal.Decimal `json:"totalPrice"`
+	Product     ProductResponse          `json:"product"`
+	Options     []ModifierOptionResponse `json:"options"`
+	Quantity    int                      `json:"quantity"`
+	TotalPrice  decimal.Decimal          `json:"totalPrice"`
+	PricingRule *string                  `json:"pricingRule,omitempty"`
 }
 
 func NewBillItemResponse(items []domain.BillItem) []BillItemResponse {
 	response := make([]BillItemResponse, 0, len(items))
 	for _, item := range items {
 		response = append(response, BillItemResponse{
-			Product: ProductResponse{
-				Id:          item.Product.Id,
-				Name:        item.Product.Name,
-				Description: item.Product.Description,
-				ImageUrl:    item.Product.ImageUrl,
-				Category:    item.Product.Category,
-				Price:       item.Product.Price,
-			},
-			Quantity:   item.Quantity,
-			TotalPrice: item.TotalPrice,
+			Product:     NewProductResponse(&item.Product),
+			Options:     NewModifierOptionResponses(item.Options),
+			Quantity:    item.Quantity,
+			TotalPrice:  item.TotalPrice,
+			PricingRule: item.PricingRuleName,
 		})
 	}
 
@@ -51,32 +79,69 @@ func NewBillItemResponse(items []domain.BillItem) []BillItemResponse {
 
 // BillResponse represent a bill response.
 type BillResponse struct {
-	Products   []BillItemResponse `json:"products"`
-	TotalPrice decimal.Decimal    `json:"totalPrice"`
+	Products   []BillItemResponse       `json:"products"`
+	Bundles    []BillBundleItemResponse `json:"bundles"`
+	TotalPrice decimal.Decimal          `json:"totalPrice"`
 }
 
 // NewBillResponse creates a new BillResponse instance.
 func NewBillResponse(bill *domain.Bill) *BillResponse {
 	return &BillResponse{
 		Products:   NewBillItemResponse(bill.Items),
+		Bundles:    NewBillBundleItemResponses(bill.Bundles),
 		TotalPrice: bill.FullPrice,
 	}
 }
 
 // OrderedProductResponse represents an ordered product response.
 type OrderedProductResponse struct {
-	Id             uuid.UUID                   `json:"id"`
-	ProductId      uuid.UUID                   `json:"productId"`
-	Status         domain.OrderedProductStatus `json:"status"`
-	OrderSessionId uuid.UUID                   `json:"orderSessionId"`
+	Id              uuid.UUID                   `json:"id"`
+	ProductId       uuid.UUID                   `json:"productId"`
+	Status          domain.OrderedProductStatus `json:"status"`
+	OrderSessionId  uuid.UUID                   `json:"orderSessionId"`
+	Options         []ModifierOptionResponse    `json:"options"`
+	Quantity        int                         `json:"quantity"`
+	Note            *string                     `json:"note"`
+	ProductName     string                      `json:"productName"`
+	UnitPrice       decimal.Decimal             `json:"unitPrice"`
+	PricingRule     *string                     `json:"pricingRule,omitempty"`
+	OrderedBundleId *uuid.UUID                  `json:"orderedBundleId,omitempty"`
+	CreatedAt       time.Time                   `json:"createdAt"`
 }
 
 // NewOrderedProductResponse creates a new OrderedProductResponse instance.
 func NewOrderedProductResponse(product *domain.OrderedProduct) OrderedProductResponse {
 	return OrderedProductResponse{
-		Id:             product.Id,
-		ProductId:      product.ProductId,
-		Status:         product.Status,
-		OrderSessionId: product.OrderSessionID,
+		Id:              product.Id,
+		ProductId:       product.ProductId,
+		Status:          product.Status,
+		OrderSessionId:  product.OrderSessionID,
+		Options:         NewModifierOptionResponses(product.Options),
+		Quantity:        product.Quantity,
+		Note:            product.Note,
+		ProductName:     product.ProductName,
+		UnitPrice:       product.UnitPrice,
+		PricingRule:     product.PricingRuleName,
+		OrderedBundleId: product.OrderedBundleId,
+		CreatedAt:       product.CreatedAt,
+	}
+}
+
+// OrderedProductPageResponse represents a page of ordered products response.
+type OrderedProductPageResponse struct {
+	OrderedProducts []OrderedProductResponse `json:"orderedProducts"`
+	NextCursor      *string                  `json:"nextCursor"`
+}
+
+// NewOrderedProductPageResponse creates a new OrderedProductPageResponse instance.
+func NewOrderedProductPageResponse(page *domain.OrderedProductPage) OrderedProductPageResponse {
+	products := make([]OrderedProductResponse, 0, len(page.OrderedProducts))
+	for _, product := range page.OrderedProducts {
+		products = append(products, NewOrderedProductResponse(&product))
+	}
+
+	return OrderedProductPageResponse{
+		OrderedProducts: products,
+		NextCursor:      nextCursor(page.NextCursor),
 	}
 }
@@ -2,6 +2,7 @@ package response
 
 import (
 	"restaurant/internal/core/domain"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -9,8 +10,9 @@ import (
 
 // ProductCategoryResponse represents a product category response.
 type ProductCategoryResponse struct {
-	Id   uuid.UUID `json:"id"`
-	Name string    `json:"name"`
+	Id         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
 }
 
 // NewProductCategoryResponse creates a new ProductCategoryResponse instance.
@@ -21,36 +23,309 @@ func NewProductCategoryResponse(id uuid.UUID, name string) ProductCategoryRespon
 	}
 }
 
+// NewArchivedProductCategoryResponse creates a new ProductCategoryResponse instance for an archived category.
+func NewArchivedProductCategoryResponse(category *domain.ProductCategory) ProductCategoryResponse {
+	return ProductCategoryResponse{
+		Id:         category.Id,
+		Name:       category.Name,
+		ArchivedAt: category.ArchivedAt,
+	}
+}
+
+// ModifierOptionResponse represents a modifier option response.
+type ModifierOptionResponse struct {
+	Id         uuid.UUID       `json:"id"`
+	Name       string          `json:"name"`
+	PriceDelta decimal.Decimal `json:"priceDelta"`
+}
+
+// NewModifierOptionResponses creates ModifierOptionResponse instances from modifier options.
+func NewModifierOptionResponses(options []domain.ModifierOption) []ModifierOptionResponse {
+	response := make([]ModifierOptionResponse, 0, len(options))
+	for _, option := range options {
+		response = append(response, ModifierOptionResponse{
+			Id:         option.Id,
+			Name:       option.Name,
+			PriceDelta: option.PriceDelta,
+		})
+	}
+	return response
+}
+
+// ModifierGroupResponse represents a modifier group response.
+type ModifierGroupResponse struct {
+	Id          uuid.UUID                `json:"id"`
+	Name        string                   `json:"name"`
+	MinSelected int                      `json:"minSelected"`
+	MaxSelected int                      `json:"maxSelected"`
+	Options     []ModifierOptionResponse `json:"options"`
+}
+
+// NewModifierGroupResponse creates a new ModifierGroupResponse instance.
+func NewModifierGroupResponse(group *domain.ModifierGroup) ModifierGroupResponse {
+	return ModifierGroupResponse{
+		Id:          group.Id,
+		Name:        group.Name,
+		MinSelected: group.MinSelected,
+		MaxSelected: group.MaxSelected,
+		Options:     NewModifierOptionResponses(group.Options),
+	}
+}
+
+// TagResponse represents a tag response.
+type TagResponse struct {
+	Id   uuid.UUID      `json:"id"`
+	Name string         `json:"name"`
+	Kind domain.TagKind `json:"kind"`
+}
+
+// NewTagResponse creates a new TagResponse instance.
+func NewTagResponse(tag *domain.Tag) TagResponse {
+	return TagResponse{
+		Id:   tag.Id,
+		Name: tag.Name,
+		Kind: tag.Kind,
+	}
+}
+
 // ProductResponse represents a product category response.
 type ProductResponse struct {
-	Id          uuid.UUID       `json:"id"`
-	Name        string          `json:"name"`
-	Description string          `json:"description"`
-	ImageUrl    *string         `json:"imageUrl"`
-	Category    uuid.UUID       `json:"category"`
-	Price       decimal.Decimal `json:"price"`
+	Id             uuid.UUID               `json:"id"`
+	Name           string                  `json:"name"`
+	Description    string                  `json:"description"`
+	ImageUrl       *string                 `json:"imageUrl"`
+	ImageVariants  ImageVariantsResponse   `json:"imageVariants,omitempty"`
+	Gallery        []ProductImageResponse  `json:"gallery"`
+	Category       uuid.UUID               `json:"category"`
+	Price          decimal.Decimal         `json:"price"`
+	CurrentPrice   decimal.Decimal         `json:"currentPrice"`
+	PricingRule    *string                 `json:"pricingRule,omitempty"`
+	Available      bool                    `json:"available"`
+	Nutrition      *NutritionResponse      `json:"nutrition,omitempty"`
+	ModifierGroups []ModifierGroupResponse `json:"modifierGroups"`
+	Tags           []TagResponse           `json:"tags"`
+	ArchivedAt     *time.Time              `json:"archivedAt,omitempty"`
+	// CategoryArchivedAt is only set in the archived products listing.
+	CategoryArchivedAt *time.Time `json:"categoryArchivedAt,omitempty"`
 }
 
 // NewProductResponse creates a new ProductResponse instance.
 func NewProductResponse(product *domain.Product) ProductResponse {
+	modifierGroups := make([]ModifierGroupResponse, 0, len(product.ModifierGroups))
+	for _, group := range product.ModifierGroups {
+		modifierGroups = append(modifierGroups, NewModifierGroupResponse(&group))
+	}
+
+	tags := make([]TagResponse, 0, len(product.Tags))
+	for _, tag := range product.Tags {
+		tags = append(tags, NewTagResponse(&tag))
+	}
+
+	gallery := make([]ProductImageResponse, 0, len(product.Gallery))
+	for _, image := range product.Gallery {
+		gallery = append(gallery, NewProductImageResponse(&image))
+	}
+
+	var pricingRule *string
+	if product.ActivePricingRule != nil {
+		pricingRule = &product.ActivePricingRule.Name
+	}
+
 	return ProductResponse{
-		Id:          product.Id,
-		Name:        product.Name,
-		Description: product.Description,
-		Category:    product.Category,
-		Price:       product.Price,
-		ImageUrl:    product.ImageUrl,
+		Id:                 product.Id,
+		Name:               product.Name,
+		Description:        product.Description,
+		Category:           product.Category,
+		Price:              product.Price,
+		CurrentPrice:       product.CurrentPrice(),
+		PricingRule:        pricingRule,
+		ImageUrl:           product.ImageUrl,
+		ImageVariants:      NewImageVariantsResponse(product.ImageVariants),
+		Gallery:            gallery,
+		Available:          product.Available,
+		Nutrition:          NewNutritionResponse(&product.Nutrition),
+		ModifierGroups:     modifierGroups,
+		Tags:               tags,
+		ArchivedAt:         product.ArchivedAt,
+		CategoryArchivedAt: product.CategoryArchivedAt,
+	}
+}
+
+// RecommendationResponse represents a product recommended together with another product.
+type RecommendationResponse struct {
+	Id           uuid.UUID       `json:"id"`
+	Name         string          `json:"name"`
+	ImageUrl     *string         `json:"imageUrl"`
+	Price        decimal.Decimal `json:"price"`
+	CurrentPrice decimal.Decimal `json:"currentPrice"`
+	PricingRule  *string         `json:"pricingRule,omitempty"`
+	Sessions     int             `json:"sessions"`
+}
+
+// NewRecommendationResponse creates a new RecommendationResponse instance.
+func NewRecommendationResponse(recommendation *domain.RecommendedProduct) RecommendationResponse {
+	var pricingRule *string
+	if recommendation.ActivePricingRule != nil {
+		pricingRule = &recommendation.ActivePricingRule.Name
+	}
+
+	return RecommendationResponse{
+		Id:           recommendation.Id,
+		Name:         recommendation.Name,
+		ImageUrl:     recommendation.ImageUrl,
+		Price:        recommendation.Price,
+		CurrentPrice: recommendation.CurrentPrice(),
+		PricingRule:  pricingRule,
+		Sessions:     recommendation.Sessions,
+	}
+}
+
+// NutritionResponse represents the nutrition facts of a portion of a product. Unknown facts are left out.
+type NutritionResponse struct {
+	Calories      *int             `json:"calories,omitempty"`
+	Protein       *decimal.Decimal `json:"protein,omitempty"`
+	Fat           *decimal.Decimal `json:"fat,omitempty"`
+	Carbohydrates *decimal.Decimal `json:"carbohydrates,omitempty"`
+	Sugar         *decimal.Decimal `json:"sugar,omitempty"`
+	Salt          *decimal.Decimal `json:"salt,omitempty"`
+	PortionSize   *string          `json:"portionSize,omitempty"`
+}
+
+// NewNutritionResponse creates a new NutritionResponse instance. It is nil when no fact is known.
+func NewNutritionResponse(nutrition *domain.Nutrition) *NutritionResponse {
+	if nutrition.IsEmpty() {
+		return nil
+	}
+	return &NutritionResponse{
+		Calories:      nutrition.Calories,
+		Protein:       nutrition.Protein,
+		Fat:           nutrition.Fat,
+		Carbohydrates: nutrition.Carbohydrates,
+		Sugar:         nutrition.Sugar,
+		Salt:          nutrition.Salt,
+		PortionSize:   nutrition.PortionSize,
+	}
+}
+
+// ImageVariantsResponse represents the urls of the image variants by variant name.
+type ImageVariantsResponse map[domain.ImageVariant]string
+
+// NewImageVariantsResponse creates a new ImageVariantsResponse instance. It is nil for images without variants.
+func NewImageVariantsResponse(images domain.ImageSet) ImageVariantsResponse {
+	if len(images) == 0 {
+		return nil
+	}
+
+	res := make(ImageVariantsResponse, len(images))
+	for variant, image := range images {
+		res[variant] = image.Url
+	}
+	return res
+}
+
+// ProductImageResponse represents a product gallery image response.
+type ProductImageResponse struct {
+	Id       uuid.UUID             `json:"id"`
+	Url      string                `json:"url"`
+	AltText  string                `json:"altText"`
+	Variants ImageVariantsResponse `json:"variants,omitempty"`
+}
+
+// NewProductImageResponse creates a new ProductImageResponse instance.
+func NewProductImageResponse(image *domain.ProductImage) ProductImageResponse {
+	return ProductImageResponse{
+		Id:       image.Id,
+		Url:      image.Url,
+		AltText:  image.AltText,
+		Variants: NewImageVariantsResponse(image.Variants),
 	}
 }
 
 // UpdateImageResponse represents a response when updating the image of a product.
 type UpdateImageResponse struct {
-	Url string `json:"url"`
+	Url      string                `json:"url"`
+	Variants ImageVariantsResponse `json:"variants"`
 }
 
 // NewUpdateImageResponse creates a new UpdateImageResponse instance.
-func NewUpdateImageResponse(url string) UpdateImageResponse {
+func NewUpdateImageResponse(images domain.ImageSet) UpdateImageResponse {
+	image, _ := images.Main()
 	return UpdateImageResponse{
-		Url: url,
+		Url:      image.Url,
+		Variants: NewImageVariantsResponse(images),
+	}
+}
+
+// ProductTranslationResponse represents a product translation response.
+type ProductTranslationResponse struct {
+	Locale      string `json:"locale"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// NewProductTranslationResponse creates a new ProductTranslationResponse instance.
+func NewProductTranslationResponse(translation *domain.ProductTranslation) ProductTranslationResponse {
+	return ProductTranslationResponse{
+		Locale:      translation.Locale,
+		Name:        translation.Name,
+		Description: translation.Description,
+	}
+}
+
+// CategoryTranslationResponse represents a product category translation response.
+type CategoryTranslationResponse struct {
+	Locale string `json:"locale"`
+	Name   string `json:"name"`
+}
+
+// NewCategoryTranslationResponse creates a new CategoryTranslationResponse instance.
+func NewCategoryTranslationResponse(translation *domain.CategoryTranslation) CategoryTranslationResponse {
+	return CategoryTranslationResponse{
+		Locale: translation.Locale,
+		Name:   translation.Name,
+	}
+}
+
+// ScheduleWindowResponse represents a schedule window response.
+type ScheduleWindowResponse struct {
+	Weekday int    `json:"weekday"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+}
+
+// NewScheduleWindowResponses creates ScheduleWindowResponse instances from schedule windows.
+func NewScheduleWindowResponses(windows []domain.ScheduleWindow) []ScheduleWindowResponse {
+	res := make([]ScheduleWindowResponse, 0, len(windows))
+	for _, window := range windows {
+		res = append(res, ScheduleWindowResponse{
+			Weekday: int(window.Weekday),
+			Start:   window.Start,
+			End:     window.End,
+		})
+	}
+	return res
+}
+
+// PricingRuleResponse represents a pricing rule response.
+type PricingRuleResponse struct {
+	Id         uuid.UUID                  `json:"id"`
+	Name       string                     `json:"name"`
+	ProductId  *uuid.UUID                 `json:"productId"`
+	CategoryId *uuid.UUID                 `json:"categoryId"`
+	Kind       domain.PriceAdjustmentKind `json:"kind"`
+	Value      decimal.Decimal            `json:"value"`
+	Windows    []ScheduleWindowResponse   `json:"windows"`
+}
+
+// NewPricingRuleResponse creates a new PricingRuleResponse instance.
+func NewPricingRuleResponse(rule *domain.PricingRule) PricingRuleResponse {
+	return PricingRuleResponse{
+		Id:         rule.Id,
+		Name:       rule.Name,
+		ProductId:  rule.ProductId,
+		CategoryId: rule.CategoryId,
+		Kind:       rule.Kind,
+		Value:      rule.Value,
+		Windows:    NewScheduleWindowResponses(rule.Windows),
 	}
 }
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"restaurant/internal/core/domain"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+	"go.uber.org/zap"
+)
+
+// imageFormField is the multipart form field an image is uploaded in.
+const imageFormField = "image"
+
+// isMultipart checks if the request body is a multipart form.
+func isMultipart(c *fiber.Ctx) bool {
+	return strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm)
+}
+
+// imageUpload returns the image uploaded either as the raw request body or as the image field
+// of a multipart form. Only the format is checked from the first bytes, so unsupported files are
+// rejected before they are processed, which decodes and verifies the image.
+func imageUpload(c *fiber.Ctx) (io.Reader, error) {
+	data := c.Body()
+	if isMultipart(c) {
+		fileHeader, err := c.FormFile(imageFormField)
+		if errors.Is(err, fasthttp.ErrMissingFile) || errors.Is(err, fasthttp.ErrNoMultipartForm) {
+			return nil, domain.ErrImageRequired
+		} else if err != nil {
+			return nil, fiber.ErrBadRequest
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			zap.L().Error("error opening uploaded image", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		defer func() {
+			if closeErr := file.Close(); closeErr != nil {
+				zap.L().Warn("error closing uploaded image", zap.Error(closeErr))
+			}
+		}()
+
+		if data, err = io.ReadAll(file); err != nil {
+			zap.L().Error("error reading uploaded image", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+	}
+
+	if len(data) == 0 {
+		return nil, domain.ErrImageRequired
+	}
+	if _, _, err := domain.DetectImageType(data); err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
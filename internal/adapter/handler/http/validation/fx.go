@@ -18,6 +18,15 @@ func validatePrice(fl validator.FieldLevel) bool {
 	return price.GreaterThan(decimal.Zero)
 }
 
+func validateNonNegativePrice(fl validator.FieldLevel) bool {
+	price, ok := fl.Field().Interface().(decimal.Decimal)
+	if !ok {
+		return false
+	}
+
+	return !price.IsNegative()
+}
+
 var orderStatuses = map[domain.OrderSessionStatus]struct{}{
 	domain.Closed: {},
 	domain.Open:   {},
@@ -51,6 +60,8 @@ func validateOrderedProductStatus(fl validator.FieldLevel) bool {
 var messageTypes = map[websocket.MessageType]struct{}{
 	websocket.Order:                      {},
 	websocket.SuccessfulOrder:            {},
+	websocket.SubmitCart:                 {},
+	websocket.OrderBundle:                {},
 	websocket.DeleteOrderedProduct:       {},
 	websocket.UpdateOrderedProductStatus: {},
 	websocket.UpdateSession:              {},
@@ -72,6 +83,9 @@ var Module = fx.Module(
 		if err := v.RegisterValidation("gtZero", validatePrice); err != nil {
 			return err
 		}
+		if err := v.RegisterValidation("gteZero", validateNonNegativePrice); err != nil {
+			return err
+		}
 		if err := v.RegisterValidation("orderStatus", validateOrderSessionStatus); err != nil {
 			return err
 		}
@@ -24,13 +24,25 @@ func NewRouter(
 	container *config.Container,
 	productHandler *http.ProductHandler,
 	orderHandler *http.OrderHandler,
+	inventoryHandler *http.InventoryHandler,
+	bundleHandler *http.BundleHandler,
 	websocketHandler *websocket.Handler,
 ) *Router {
 	app := fiber.New(fiber.Config{
-		ErrorHandler: response.ErrorHandler,
+		ErrorHandler: response.NewErrorHandler(container.DbConfig.ImagesMaxSize),
+		// The largest image must fit with the multipart framing around it. Images slightly
+		// above the limit still reach the image processor, which rejects them with a structured error.
+		BodyLimit: max(fiber.DefaultBodyLimit, container.DbConfig.ImagesMaxSize+1<<20),
 	})
 	app.Use(middleware.ZapLogger())
 
+	if container.DbConfig.ImagesStorage == config.FilesystemImageStorage {
+		// Image names are unique and never reused, so clients may cache them for good.
+		app.Static(config.ImagesRoute, container.DbConfig.ImagesDir, fiber.Static{
+			MaxAge: 365 * 24 * 60 * 60,
+		})
+	}
+
 	v1 := app.Group("/api/v1")
 	{
 		admin := v1.Group("/admin")
@@ -57,11 +69,57 @@ func NewRouter(
 				menu.Post("/categories", productHandler.AddProductCategory)
 				menu.Patch("/categories/:id", productHandler.UpdateCategory)
 				menu.Delete("/categories/:id", productHandler.DeleteCategory)
+				menu.Get("/categories/archived", productHandler.GetArchivedCategories)
+				menu.Post("/categories/:id/archive", productHandler.ArchiveCategory)
+				menu.Post("/categories/:id/restore", productHandler.RestoreCategory)
+				menu.Get("/categories/:id/translations", productHandler.GetCategoryTranslations)
+				menu.Put("/categories/:id/translations/:locale", productHandler.SetCategoryTranslation)
+				menu.Delete("/categories/:id/translations/:locale", productHandler.DeleteCategoryTranslation)
+				menu.Get("/categories/:id/schedule", productHandler.GetCategorySchedule)
+				menu.Put("/categories/:id/schedule", productHandler.SetCategorySchedule)
 
 				menu.Post("/products", productHandler.AddProduct)
 				menu.Patch("/products/:id", productHandler.UpdateProduct)
 				menu.Put("/products/:id/image", productHandler.ReplaceProductImage)
+				menu.Post("/products/:id/images", productHandler.AddProductImage)
+				menu.Put("/products/:id/images/order", productHandler.ReorderProductImages)
+				menu.Delete("/products/:id/images/:imageId", productHandler.DeleteProductImage)
 				menu.Delete("/products", productHandler.DeleteProduct)
+				menu.Put("/products/:id/availability", productHandler.SetProductAvailability)
+				menu.Get("/products/archived", productHandler.GetArchivedProducts)
+				menu.Post("/products/:id/archive", productHandler.ArchiveProduct)
+				menu.Post("/products/:id/restore", productHandler.RestoreProduct)
+				menu.Get("/products/:id/translations", productHandler.GetProductTranslations)
+				menu.Put("/products/:id/translations/:locale", productHandler.SetProductTranslation)
+				menu.Delete("/products/:id/translations/:locale", productHandler.DeleteProductTranslation)
+				menu.Get("/products/:id/schedule", productHandler.GetProductSchedule)
+				menu.Put("/products/:id/schedule", productHandler.SetProductSchedule)
+
+				menu.Post("/products/:id/modifier-groups", productHandler.AddModifierGroup)
+				menu.Delete("/modifier-groups/:id", productHandler.DeleteModifierGroup)
+
+				menu.Post("/tags", productHandler.AddTag)
+				menu.Delete("/tags/:id", productHandler.DeleteTag)
+				menu.Put("/products/:id/tags", productHandler.SetProductTags)
+
+				menu.Get("/pricing-rules", productHandler.GetPricingRules)
+				menu.Post("/pricing-rules", productHandler.AddPricingRule)
+				menu.Delete("/pricing-rules/:id", productHandler.DeletePricingRule)
+
+				menu.Post("/bundles", bundleHandler.AddBundle)
+				menu.Delete("/bundles/:id", bundleHandler.DeleteBundle)
+				menu.Put("/bundles/:id/availability", bundleHandler.SetBundleAvailability)
+
+				menu.Get("/export", productHandler.ExportMenu)
+				menu.Post("/import", productHandler.ImportMenu)
+
+				menu.Get("/draft", productHandler.GetMenuDraft)
+				menu.Put("/draft", productHandler.SaveMenuDraft)
+				menu.Delete("/draft", productHandler.DiscardMenuDraft)
+				menu.Get("/draft/preview", productHandler.PreviewMenuDraft)
+				menu.Post("/draft/publish", productHandler.PublishMenuDraft)
+				menu.Get("/versions", productHandler.GetMenuVersions)
+				menu.Post("/versions/:number/rollback", productHandler.RollbackMenu)
 			}
 
 			order := admin.Group("/orders")
@@ -72,12 +130,27 @@ func NewRouter(
 				order.Get("/ordered-products", orderHandler.GetOrderedProducts)
 				order.Get("/connect", fiberWebsocket.New(websocketHandler.Admin))
 			}
+
+			inventory := admin.Group("/inventory")
+			{
+				inventory.Get("/ingredients", inventoryHandler.GetIngredients)
+				inventory.Post("/ingredients", inventoryHandler.AddIngredient)
+				inventory.Delete("/ingredients/:id", inventoryHandler.DeleteIngredient)
+				inventory.Post("/ingredients/:id/adjustments", inventoryHandler.AdjustStock)
+				inventory.Post("/ingredients/:id/counts", inventoryHandler.CountStock)
+
+				inventory.Get("/recipes/:id", inventoryHandler.GetRecipe)
+				inventory.Put("/recipes/:id", inventoryHandler.SetRecipe)
+			}
 		}
 
 		public := v1.Group("/public")
 		{
 			public.Get("/product-categories", productHandler.GetProductCategories)
 			public.Get("/products", productHandler.GetProducts)
+			public.Get("/products/:id/recommendations", productHandler.GetProductRecommendations)
+			public.Get("/tags", productHandler.GetTags)
+			public.Get("/bundles", bundleHandler.GetBundles)
 			public.Get("/connect/:session", fiberWebsocket.New(websocketHandler.Client))
 			public.Get("/bill/:id", orderHandler.GetBill)
 		}
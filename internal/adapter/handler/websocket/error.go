@@ -19,6 +19,24 @@ func handleDomainError(conn *websocket.Conn, err error) {
 	case errors.Is(err, domain.ErrProductNotFound):
 		writeString("Product not found", conn)
 
+	case errors.Is(err, domain.ErrProductArchived):
+		writeString("Product is no longer available", conn)
+
+	case errors.Is(err, domain.ErrProductUnavailable):
+		writeString("Product is currently unavailable", conn)
+
+	case errors.Is(err, domain.ErrProductOutOfSchedule):
+		writeString("Product is not available at this time", conn)
+
+	case errors.Is(err, domain.ErrBundleNotFound):
+		writeString("Bundle not found", conn)
+
+	case errors.Is(err, domain.ErrBundleUnavailable):
+		writeString("Bundle is currently unavailable", conn)
+
+	case errors.Is(err, domain.ErrInvalidBundleSelection):
+		writeString("Choose one allowed product for every bundle slot", conn)
+
 	case errors.Is(err, domain.ErrOrderSessionNotFound):
 		writeString("Session not found", conn)
 
@@ -28,9 +46,24 @@ func handleDomainError(conn *websocket.Conn, err error) {
 	case errors.Is(err, domain.ErrOrderedProductNotFound):
 		writeString("Ordered product not found", conn)
 
+	case errors.Is(err, domain.ErrInsufficientStock):
+		writeString("Not enough stock to prepare the product", conn)
+
 	case errors.Is(err, domain.ErrOrderedProductNotPending):
 		writeString("Only pending products can be deleted by a client", conn)
 
+	case errors.Is(err, domain.ErrOrderedProductInBundle):
+		writeString("Products of a bundle can't be deleted on their own", conn)
+
+	case errors.Is(err, domain.ErrInvalidModifierSelection):
+		writeString("Invalid modifier selection", conn)
+
+	case errors.Is(err, domain.ErrInvalidQuantity):
+		writeString("Invalid quantity", conn)
+
+	case errors.Is(err, domain.ErrEmptyCart):
+		writeString("Cart is empty", conn)
+
 	case errors.Is(err, domain.ErrNothingToUpdate):
 		writeString("Nothing to update", conn)
 	default:
@@ -1,6 +1,10 @@
 package websocket
 
-import "go.uber.org/fx"
+import (
+	"restaurant/internal/core/port"
+
+	"go.uber.org/fx"
+)
 
 var Module = fx.Module("websocket",
 	fx.Provide(NewHub),
@@ -8,4 +12,10 @@ var Module = fx.Module("websocket",
 		go hub.Run()
 	}),
 	fx.Provide(NewHandler),
+	fx.Provide(
+		fx.Annotate(
+			NewNotifier,
+			fx.As(new(port.MenuNotifier)),
+		),
+	),
 )
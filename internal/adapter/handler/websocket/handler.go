@@ -14,17 +14,24 @@ import (
 
 // Handler represent a handler for websocket connections.
 type Handler struct {
-	orderService port.OrderService
-	hub          *Hub
-	validator    *validator.Validate
+	orderService          port.OrderService
+	recommendationService port.RecommendationService
+	hub                   *Hub
+	validator             *validator.Validate
 }
 
 // NewHandler creates a new Handler instance.
-func NewHandler(orderService port.OrderService, hub *Hub, validator *validator.Validate) *Handler {
+func NewHandler(
+	orderService port.OrderService,
+	recommendationService port.RecommendationService,
+	hub *Hub,
+	validator *validator.Validate,
+) *Handler {
 	return &Handler{
-		orderService: orderService,
-		hub:          hub,
-		validator:    validator,
+		orderService:          orderService,
+		recommendationService: recommendationService,
+		hub:                   hub,
+		validator:             validator,
 	}
 }
 
@@ -195,20 +202,31 @@ func (h *Handler) handleOrder(ctx context.Context, message *Message, sessionId u
 		return
 	}
 
-	orderedProduct, err := h.orderService.OrderProduct(ctx, orderData.ProductID, sessionId)
+	orderedProduct, err := h.orderService.OrderProduct(
+		ctx,
+		domain.NewOrderProductDTO(
+			orderData.ProductID,
+			sessionId,
+			orderData.OptionIds,
+			orderData.GetQuantity(),
+			orderData.Note,
+		),
+	)
 	if err != nil {
 		handleDomainError(conn, err)
 		return
 	}
 
-	data, encodeErr := json.Marshal(
-		NewSuccessfulOrderData(
-			orderedProduct.Id,
-			orderedProduct.ProductId,
-			orderedProduct.OrderSessionID,
-			orderedProduct.Status,
-		),
-	)
+	successfulOrderData := NewSuccessfulOrderData(orderedProduct)
+	// The order was accepted either way, so it is sent without suggestions when they can't be loaded.
+	// The message goes to every client of the session, so the companions aren't translated, like the product name.
+	recommendations, err := h.recommendationService.GetRecommendations(ctx, orderedProduct.ProductId, nil)
+	if err != nil {
+		zap.L().Warn("error getting recommendations", zap.Error(err))
+	}
+	successfulOrderData.Recommendations = NewRecommendationsData(recommendations)
+
+	data, encodeErr := json.Marshal(successfulOrderData)
 	if encodeErr != nil {
 		zap.L().Error("error encoding message", zap.Error(encodeErr))
 		writeString("Internal server error", conn)
@@ -218,6 +236,81 @@ func (h *Handler) handleOrder(ctx context.Context, message *Message, sessionId u
 	h.hub.broadcast <- NewBroadcast(NewMessage(SuccessfulOrder, data), sessionId)
 }
 
+// handleCartSubmission handles submission of multiple products at once from clients.
+func (h *Handler) handleCartSubmission(ctx context.Context, message *Message, sessionId uuid.UUID, conn *websocket.Conn) {
+	var cartData CartData
+	if err := json.Unmarshal(message.Data, &cartData); err != nil {
+		writeString("Invalid json data", conn)
+		return
+	}
+	if err := h.validator.Struct(cartData); err != nil {
+		writeString("Invalid json data", conn)
+		return
+	}
+
+	items := make([]domain.OrderProductDTO, 0, len(cartData.Items))
+	for _, item := range cartData.Items {
+		items = append(items, *domain.NewOrderProductDTO(
+			item.ProductID,
+			sessionId,
+			item.OptionIds,
+			item.GetQuantity(),
+			item.Note,
+		))
+	}
+
+	orderedProducts, err := h.orderService.SubmitCart(ctx, sessionId, items)
+	if err != nil {
+		handleDomainError(conn, err)
+		return
+	}
+
+	data, encodeErr := json.Marshal(NewSuccessfulCartSubmissionData(orderedProducts))
+	if encodeErr != nil {
+		zap.L().Error("error encoding message", zap.Error(encodeErr))
+		writeString("Internal server error", conn)
+		return
+	}
+
+	h.hub.broadcast <- NewBroadcast(NewMessage(SuccessfulCartSubmission, data), sessionId)
+}
+
+// handleBundleOrder handles bundle order message from clients.
+func (h *Handler) handleBundleOrder(ctx context.Context, message *Message, sessionId uuid.UUID, conn *websocket.Conn) {
+	var bundleData OrderBundleData
+	if err := json.Unmarshal(message.Data, &bundleData); err != nil {
+		writeString("Invalid json data", conn)
+		return
+	}
+	if err := h.validator.Struct(bundleData); err != nil {
+		writeString("Invalid json data", conn)
+		return
+	}
+
+	choices := make([]domain.BundleChoiceDTO, 0, len(bundleData.Choices))
+	for _, choice := range bundleData.Choices {
+		choices = append(choices, *domain.NewBundleChoiceDTO(choice.SlotId, choice.ProductId, choice.OptionIds, choice.Note))
+	}
+
+	orderedBundle, err := h.orderService.OrderBundle(
+		ctx,
+		domain.NewOrderBundleDTO(bundleData.BundleId, sessionId, bundleData.GetQuantity(), choices),
+	)
+	if err != nil {
+		handleDomainError(conn, err)
+		return
+	}
+
+	data, encodeErr := json.Marshal(NewSuccessfulBundleOrderData(orderedBundle))
+	if encodeErr != nil {
+		zap.L().Error("error encoding message", zap.Error(encodeErr))
+		writeString("Internal server error", conn)
+		return
+	}
+
+	h.hub.broadcast <- NewBroadcast(NewMessage(SuccessfulBundleOrder, data), sessionId)
+}
+
 // handlePayment handles the payment
 func (h *Handler) handlePayment(ctx context.Context, message *Message, conn *websocket.Conn) {
 	var paymentData PaymentData
@@ -284,6 +377,10 @@ func (h *Handler) Client(conn *websocket.Conn) {
 		switch message.Type {
 		case Order:
 			h.handleOrder(ctx, &message, sessionId, conn)
+		case SubmitCart:
+			h.handleCartSubmission(ctx, &message, sessionId, conn)
+		case OrderBundle:
+			h.handleBundleOrder(ctx, &message, sessionId, conn)
 		case DeleteOrderedProduct:
 			h.handleOrderedProductDeletion(ctx, &message, false, conn)
 		case Pay:
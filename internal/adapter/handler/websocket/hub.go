@@ -17,7 +17,8 @@ type Hub struct {
 	registerAdmin   chan *Admin
 	unregisterAdmin chan *Admin
 
-	broadcast chan *Broadcast
+	broadcast    chan *Broadcast
+	broadcastAll chan Message
 }
 
 func NewHub() *Hub {
@@ -31,10 +32,16 @@ func NewHub() *Hub {
 		registerAdmin:   make(chan *Admin),
 		unregisterAdmin: make(chan *Admin),
 
-		broadcast: make(chan *Broadcast),
+		broadcast:    make(chan *Broadcast),
+		broadcastAll: make(chan Message),
 	}
 }
 
+// BroadcastAll sends a message to every connected client and admin regardless of their session.
+func (h *Hub) BroadcastAll(message Message) {
+	h.broadcastAll <- message
+}
+
 func (h *Hub) Run() {
 	for {
 		select {
@@ -60,6 +67,20 @@ func (h *Hub) Run() {
 				}
 			}
 
+			for _, admin := range h.admins {
+				writeMessage(messageData, admin.Conn)
+			}
+
+		case message := <-h.broadcastAll:
+			messageData, err := json.Marshal(message)
+			if err != nil {
+				zap.L().Error("error encoding broadcast message", zap.Error(err))
+			}
+
+			for _, client := range h.clients {
+				writeMessage(messageData, client.Conn)
+			}
+
 			for _, admin := range h.admins {
 				writeMessage(messageData, admin.Conn)
 			}
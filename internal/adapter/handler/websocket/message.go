@@ -6,6 +6,7 @@ import (
 
 	"github.com/gofiber/websocket/v2"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
 
@@ -36,6 +37,11 @@ const (
 	SuccessfulUpdateOrderedProductStatus MessageType = "UPDATE_ORDERED_PRODUCT_STATUS_OK"
 	UpdateSession                        MessageType = "UPDATE_SESSION"
 	SuccessfulUpdateSession              MessageType = "UPDATE_SESSION_OK"
+	SubmitCart                           MessageType = "SUBMIT_CART"
+	SuccessfulCartSubmission             MessageType = "SUBMIT_CART_OK"
+	OrderBundle                          MessageType = "ORDER_BUNDLE"
+	SuccessfulBundleOrder                MessageType = "ORDER_BUNDLE_OK"
+	MenuAvailabilityChanged              MessageType = "MENU_AVAILABILITY_CHANGED"
 	Pay                                  MessageType = "PAY"
 	SuccessfulPayment                    MessageType = "PAY_OK"
 )
@@ -56,24 +62,172 @@ func NewMessage(messageType MessageType, data json.RawMessage) Message {
 
 // OrderData represent the message data for ordering a product.
 type OrderData struct {
-	ProductID uuid.UUID `json:"productId" validate:"required"`
+	ProductID uuid.UUID   `json:"productId" validate:"required"`
+	OptionIds []uuid.UUID `json:"optionIds" validate:"omitempty,unique"`
+	Quantity  *int        `json:"quantity" validate:"omitempty,min=1,max=99"`
+	Note      *string     `json:"note" validate:"omitempty,max=200"`
+}
+
+// GetQuantity returns the ordered quantity, defaulting to a single item when not provided.
+func (d *OrderData) GetQuantity() int {
+	if d.Quantity == nil {
+		return 1
+	}
+	return *d.Quantity
+}
+
+// SelectedOptionData represent a modifier option selected for an ordered product.
+type SelectedOptionData struct {
+	Id         uuid.UUID       `json:"id"`
+	GroupId    uuid.UUID       `json:"groupId"`
+	Name       string          `json:"name"`
+	PriceDelta decimal.Decimal `json:"priceDelta"`
+}
+
+// NewSelectedOptionsData creates SelectedOptionData instances from modifier options.
+func NewSelectedOptionsData(options []domain.ModifierOption) []SelectedOptionData {
+	data := make([]SelectedOptionData, 0, len(options))
+	for _, option := range options {
+		data = append(data, SelectedOptionData{
+			Id:         option.Id,
+			GroupId:    option.GroupId,
+			Name:       option.Name,
+			PriceDelta: option.PriceDelta,
+		})
+	}
+	return data
 }
 
 // SuccessfulOrderData represent a successful message when order is accepted.
 type SuccessfulOrderData struct {
-	Id        uuid.UUID                   `json:"id"`
-	ProductID uuid.UUID                   `json:"productId"`
-	SessionId uuid.UUID                   `json:"sessionId"`
-	Status    domain.OrderedProductStatus `json:"status"`
+	Id              uuid.UUID                   `json:"id"`
+	ProductID       uuid.UUID                   `json:"productId"`
+	SessionId       uuid.UUID                   `json:"sessionId"`
+	Status          domain.OrderedProductStatus `json:"status"`
+	Options         []SelectedOptionData        `json:"options"`
+	Quantity        int                         `json:"quantity"`
+	Note            *string                     `json:"note"`
+	ProductName     string                      `json:"productName"`
+	UnitPrice       decimal.Decimal             `json:"unitPrice"`
+	PricingRule     *string                     `json:"pricingRule,omitempty"`
+	OrderedBundleId *uuid.UUID                  `json:"orderedBundleId,omitempty"`
+	// Recommendations are products often ordered together with the ordered product,
+	// which clients may suggest. They are only sent for single orders.
+	Recommendations []RecommendationData `json:"recommendations,omitempty"`
 }
 
 // NewSuccessfulOrderData creates a new SuccessfulOrderData instance.
-func NewSuccessfulOrderData(id, productID, sessionId uuid.UUID, status domain.OrderedProductStatus) SuccessfulOrderData {
+func NewSuccessfulOrderData(orderedProduct *domain.OrderedProduct) SuccessfulOrderData {
 	return SuccessfulOrderData{
-		Id:        id,
-		ProductID: productID,
-		SessionId: sessionId,
-		Status:    status,
+		Id:              orderedProduct.Id,
+		ProductID:       orderedProduct.ProductId,
+		SessionId:       orderedProduct.OrderSessionID,
+		Status:          orderedProduct.Status,
+		Options:         NewSelectedOptionsData(orderedProduct.Options),
+		Quantity:        orderedProduct.Quantity,
+		Note:            orderedProduct.Note,
+		ProductName:     orderedProduct.ProductName,
+		UnitPrice:       orderedProduct.UnitPrice,
+		PricingRule:     orderedProduct.PricingRuleName,
+		OrderedBundleId: orderedProduct.OrderedBundleId,
+	}
+}
+
+// RecommendationData represent a product recommended together with an ordered product.
+type RecommendationData struct {
+	ProductID    uuid.UUID       `json:"productId"`
+	Name         string          `json:"name"`
+	ImageUrl     *string         `json:"imageUrl"`
+	Price        decimal.Decimal `json:"price"`
+	CurrentPrice decimal.Decimal `json:"currentPrice"`
+}
+
+// NewRecommendationsData creates RecommendationData instances from recommended products.
+func NewRecommendationsData(recommendations []domain.RecommendedProduct) []RecommendationData {
+	data := make([]RecommendationData, 0, len(recommendations))
+	for _, recommendation := range recommendations {
+		data = append(data, RecommendationData{
+			ProductID:    recommendation.Id,
+			Name:         recommendation.Name,
+			ImageUrl:     recommendation.ImageUrl,
+			Price:        recommendation.Price,
+			CurrentPrice: recommendation.CurrentPrice(),
+		})
+	}
+	return data
+}
+
+// CartData represent the message data for ordering multiple products at once.
+type CartData struct {
+	Items []OrderData `json:"items" validate:"required,min=1,max=50,dive"`
+}
+
+// SuccessfulCartSubmissionData represent a successful message when a cart is accepted.
+type SuccessfulCartSubmissionData struct {
+	OrderedProducts []SuccessfulOrderData `json:"orderedProducts"`
+}
+
+// NewSuccessfulCartSubmissionData creates a new SuccessfulCartSubmissionData instance.
+func NewSuccessfulCartSubmissionData(orderedProducts []domain.OrderedProduct) SuccessfulCartSubmissionData {
+	data := make([]SuccessfulOrderData, 0, len(orderedProducts))
+	for _, orderedProduct := range orderedProducts {
+		data = append(data, NewSuccessfulOrderData(&orderedProduct))
+	}
+
+	return SuccessfulCartSubmissionData{
+		OrderedProducts: data,
+	}
+}
+
+// BundleChoiceData represent the product chosen for a bundle slot.
+type BundleChoiceData struct {
+	SlotId    uuid.UUID   `json:"slotId" validate:"required"`
+	ProductId uuid.UUID   `json:"productId" validate:"required"`
+	OptionIds []uuid.UUID `json:"optionIds" validate:"omitempty,unique"`
+	Note      *string     `json:"note" validate:"omitempty,max=200"`
+}
+
+// OrderBundleData represent the message data for ordering a bundle.
+type OrderBundleData struct {
+	BundleId uuid.UUID          `json:"bundleId" validate:"required"`
+	Quantity *int               `json:"quantity" validate:"omitempty,min=1,max=99"`
+	Choices  []BundleChoiceData `json:"choices" validate:"required,min=1,max=20,unique=SlotId,dive"`
+}
+
+// GetQuantity returns the ordered quantity, defaulting to a single bundle when not provided.
+func (d *OrderBundleData) GetQuantity() int {
+	if d.Quantity == nil {
+		return 1
+	}
+	return *d.Quantity
+}
+
+// SuccessfulBundleOrderData represent a successful message when a bundle order is accepted.
+type SuccessfulBundleOrderData struct {
+	Id         uuid.UUID             `json:"id"`
+	BundleId   uuid.UUID             `json:"bundleId"`
+	SessionId  uuid.UUID             `json:"sessionId"`
+	Name       string                `json:"name"`
+	Price      decimal.Decimal       `json:"price"`
+	Quantity   int                   `json:"quantity"`
+	Components []SuccessfulOrderData `json:"components"`
+}
+
+// NewSuccessfulBundleOrderData creates a new SuccessfulBundleOrderData instance.
+func NewSuccessfulBundleOrderData(bundle *domain.OrderedBundle) SuccessfulBundleOrderData {
+	components := make([]SuccessfulOrderData, 0, len(bundle.Components))
+	for _, component := range bundle.Components {
+		components = append(components, NewSuccessfulOrderData(&component))
+	}
+
+	return SuccessfulBundleOrderData{
+		Id:         bundle.Id,
+		BundleId:   bundle.BundleId,
+		SessionId:  bundle.SessionId,
+		Name:       bundle.Name,
+		Price:      bundle.Price,
+		Quantity:   bundle.Quantity,
+		Components: components,
 	}
 }
 
@@ -124,6 +278,20 @@ func NewSuccessfulUpdateOrderSessionData(id uuid.UUID, tableNumber int, status d
 	}
 }
 
+// MenuAvailabilityChangedData represent a message when a product becomes available or unavailable.
+type MenuAvailabilityChangedData struct {
+	ProductId uuid.UUID `json:"productId"`
+	Available bool      `json:"available"`
+}
+
+// NewMenuAvailabilityChangedData creates a new MenuAvailabilityChangedData instance.
+func NewMenuAvailabilityChangedData(productId uuid.UUID, available bool) MenuAvailabilityChangedData {
+	return MenuAvailabilityChangedData{
+		ProductId: productId,
+		Available: available,
+	}
+}
+
 type PaymentData struct {
 	Id uuid.UUID `json:"id" validate:"required"`
 }
@@ -0,0 +1,29 @@
+package websocket
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Notifier implements port.MenuNotifier and broadcasts menu changes to all websocket connections.
+type Notifier struct {
+	hub *Hub
+}
+
+// NewNotifier creates a new Notifier instance.
+func NewNotifier(hub *Hub) *Notifier {
+	return &Notifier{
+		hub: hub,
+	}
+}
+
+func (n *Notifier) NotifyAvailabilityChanged(productId uuid.UUID, available bool) {
+	data, err := json.Marshal(NewMenuAvailabilityChangedData(productId, available))
+	if err != nil {
+		zap.L().Error("error encoding message", zap.Error(err))
+		return
+	}
+	n.hub.BroadcastAll(NewMessage(MenuAvailabilityChanged, data))
+}
@@ -0,0 +1,17 @@
+package imaging
+
+import (
+	"restaurant/internal/core/port"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module(
+	"imaging",
+	fx.Provide(
+		fx.Annotate(
+			NewImageProcessor,
+			fx.As(new(port.ImageProcessor)),
+		),
+	),
+)
@@ -0,0 +1,215 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"restaurant/internal/adapter/config"
+	"restaurant/internal/core/domain"
+
+	"go.uber.org/zap"
+	_ "golang.org/x/image/webp"
+)
+
+// jpegQuality is the quality variants of JPEG images are encoded with.
+const jpegQuality = 85
+
+// ImageProcessor implements port.ImageProcessor with the standard library codecs and the WebP decoder
+// of golang.org/x/image. Images are decoded and encoded again, so metadata like EXIF never reaches the storage.
+type ImageProcessor struct {
+	maxSize int
+}
+
+// NewImageProcessor creates a new ImageProcessor instance.
+func NewImageProcessor(storageConfig *config.StorageConfig) *ImageProcessor {
+	return &ImageProcessor{
+		maxSize: storageConfig.ImagesMaxSize,
+	}
+}
+
+func (p *ImageProcessor) ProcessImage(ctx context.Context, data io.Reader) ([]domain.ProcessedImage, error) {
+	body, err := io.ReadAll(io.LimitReader(data, int64(p.maxSize)+1))
+	if err != nil {
+		zap.L().Error("error reading image", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	if len(body) > p.maxSize {
+		return nil, domain.ErrImageTooLarge
+	}
+
+	contentType, _, err := domain.DetectImageType(body)
+	if err != nil {
+		return nil, err
+	}
+	// The dimensions are checked before decoding, so a small file declaring a huge image
+	// can't make the decoder allocate the memory for it.
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		return nil, domain.ErrCorruptImage
+	}
+	if imageConfig.Width > domain.MaxImageDimension || imageConfig.Height > domain.MaxImageDimension {
+		return nil, domain.ErrImageTooLarge
+	}
+	if contentType == "image/webp" {
+		if err = checkWebPFrame(body); err != nil {
+			return nil, err
+		}
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(body))
+	if err != nil {
+		return nil, domain.ErrCorruptImage
+	}
+
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = jpegOrientation(body)
+	}
+	img := orient(toRGBA(decoded), orientation)
+	outputType := encodedType(contentType, decoded)
+
+	// Every variant is scaled down from the next larger one, which is far cheaper
+	// than scaling down the original each time.
+	processed := make([]domain.ProcessedImage, len(domain.ImageVariants))
+	for i := len(domain.ImageVariants) - 1; i >= 0; i-- {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		variant := domain.ImageVariants[i]
+		img = resize(img, variant.MaxEdge())
+
+		var buf bytes.Buffer
+		if err = encode(&buf, img, outputType); err != nil {
+			zap.L().Error("error encoding image", zap.String("variant", string(variant)), zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		processed[i] = *domain.NewProcessedImage(variant, buf.Bytes())
+	}
+	return processed, nil
+}
+
+// encodedType returns the content type the variants of an image are encoded in. JPEG and PNG are
+// kept. WebP can't be encoded, so lossy WebP images become JPEG and lossless or transparent ones PNG.
+func encodedType(contentType string, decoded image.Image) string {
+	if contentType != "image/webp" {
+		return contentType
+	}
+	if _, ok := decoded.(*image.YCbCr); ok {
+		return "image/jpeg"
+	}
+	return "image/png"
+}
+
+// encode writes the image as the content type. PNG is kept for PNG uploads
+// because it may be transparent.
+func encode(w io.Writer, img image.Image, contentType string) error {
+	switch contentType {
+	case "image/jpeg":
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality})
+	case "image/png":
+		encoder := png.Encoder{CompressionLevel: png.BestCompression}
+		return encoder.Encode(w, img)
+	default:
+		return errors.New("unsupported content type " + contentType)
+	}
+}
+
+// toRGBA converts the image to RGBA with its origin at zero, which the other functions work on.
+func toRGBA(src image.Image) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Src)
+	return dst
+}
+
+// resize scales the image down so its longest edge is at most maxEdge, keeping the aspect ratio.
+// Every target pixel is the average of the source pixels it covers, which keeps thin lines and
+// text readable in thumbnails. Images that are small enough are returned unchanged.
+func resize(src *image.RGBA, maxEdge int) *image.RGBA {
+	width, height := src.Rect.Dx(), src.Rect.Dy()
+	if width <= maxEdge && height <= maxEdge {
+		return src
+	}
+
+	dstWidth, dstHeight := maxEdge, maxEdge
+	if width >= height {
+		dstHeight = max(1, height*maxEdge/width)
+	} else {
+		dstWidth = max(1, width*maxEdge/height)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		y0, y1 := y*height/dstHeight, (y+1)*height/dstHeight
+		for x := 0; x < dstWidth; x++ {
+			x0, x1 := x*width/dstWidth, (x+1)*width/dstWidth
+
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				offset := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					sum[0] += int(src.Pix[offset])
+					sum[1] += int(src.Pix[offset+1])
+					sum[2] += int(src.Pix[offset+2])
+					sum[3] += int(src.Pix[offset+3])
+					offset += 4
+				}
+			}
+
+			count := (y1 - y0) * (x1 - x0)
+			offset := dst.PixOffset(x, y)
+			for c := range sum {
+				dst.Pix[offset+c] = uint8((sum[c] + count/2) / count)
+			}
+		}
+	}
+	return dst
+}
+
+// orient turns the image as described by an EXIF orientation value, so it displays
+// correctly once the EXIF data is gone.
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	width, height := src.Rect.Dx(), src.Rect.Dy()
+
+	// source maps a pixel of the oriented image to the pixel of the source image.
+	var source func(x, y int) (int, int)
+	switch orientation {
+	case 2:
+		source = func(x, y int) (int, int) { return width - 1 - x, y }
+	case 3:
+		source = func(x, y int) (int, int) { return width - 1 - x, height - 1 - y }
+	case 4:
+		source = func(x, y int) (int, int) { return x, height - 1 - y }
+	case 5:
+		source = func(x, y int) (int, int) { return y, x }
+	case 6:
+		source = func(x, y int) (int, int) { return y, height - 1 - x }
+	case 7:
+		source = func(x, y int) (int, int) { return width - 1 - y, height - 1 - x }
+	case 8:
+		source = func(x, y int) (int, int) { return width - 1 - y, x }
+	default:
+		return src
+	}
+
+	// Orientations from 5 on are rotated by a quarter turn, which swaps the edges.
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		for x := 0; x < dstWidth; x++ {
+			sx, sy := source(x, y)
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):])
+		}
+	}
+	return dst
+}
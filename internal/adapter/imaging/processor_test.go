@@ -0,0 +1,387 @@
+package imaging
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"restaurant/internal/adapter/config"
+	"restaurant/internal/core/domain"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// exifSegment returns an APP1 segment with EXIF data holding the orientation in its first directory.
+func exifSegment(order binary.AppendByteOrder, orientation int) []byte {
+	tiff := []byte("II")
+	if order == binary.BigEndian {
+		tiff = []byte("MM")
+	}
+	tiff = order.AppendUint16(tiff, 42)
+	tiff = order.AppendUint32(tiff, 8)
+	tiff = order.AppendUint16(tiff, 2)
+	// An entry before the orientation, that has to be skipped.
+	tiff = order.AppendUint16(tiff, 0x010F)
+	tiff = order.AppendUint16(tiff, 2)
+	tiff = order.AppendUint32(tiff, 4)
+	tiff = append(tiff, "Cam\x00"...)
+	tiff = order.AppendUint16(tiff, exifOrientationTag)
+	tiff = order.AppendUint16(tiff, 3)
+	tiff = order.AppendUint32(tiff, 1)
+	tiff = order.AppendUint16(tiff, uint16(orientation))
+	tiff = order.AppendUint16(tiff, 0)
+	tiff = order.AppendUint32(tiff, 0)
+
+	return segment(0xE1, append([]byte("Exif\x00\x00"), tiff...))
+}
+
+// segment returns a JPEG segment with the marker and data.
+func segment(marker byte, data []byte) []byte {
+	return append(binary.BigEndian.AppendUint16([]byte{0xFF, marker}, uint16(len(data)+2)), data...)
+}
+
+// withSegments inserts the segments right after the start of image marker of a JPEG image.
+func withSegments(jpegData []byte, segments ...[]byte) []byte {
+	data := []byte{0xFF, 0xD8}
+	for _, s := range segments {
+		data = append(data, s...)
+	}
+	return append(data, jpegData[2:]...)
+}
+
+func TestJpegOrientation(t *testing.T) {
+	soi := []byte{0xFF, 0xD8}
+	app0 := segment(0xE0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00"))
+	sos := segment(0xDA, []byte{0x01, 0x01, 0x00, 0x00, 0x3F, 0x00})
+
+	join := func(parts ...[]byte) []byte {
+		return bytes.Join(parts, nil)
+	}
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected int
+	}{
+		{name: "little endian", data: join(soi, exifSegment(binary.LittleEndian, 6)), expected: 6},
+		{name: "big endian", data: join(soi, exifSegment(binary.BigEndian, 8)), expected: 8},
+		{name: "after other segments", data: join(soi, app0, exifSegment(binary.LittleEndian, 3)), expected: 3},
+		{name: "after fill bytes", data: join(soi, []byte{0xFF, 0xFF}, exifSegment(binary.LittleEndian, 5)), expected: 5},
+		{name: "without exif", data: join(soi, app0, sos), expected: 1},
+		{name: "exif after the start of scan", data: join(soi, sos, exifSegment(binary.LittleEndian, 6)), expected: 1},
+		{name: "other app1 segment", data: join(soi, segment(0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00"))), expected: 1},
+		{name: "segment longer than the data", data: join(soi, exifSegment(binary.LittleEndian, 6)[:20]), expected: 1},
+		{name: "segment length below its own size", data: join(soi, []byte{0xFF, 0xE1, 0x00, 0x01}, exifSegment(binary.LittleEndian, 6)), expected: 1},
+		{name: "garbage between segments", data: join(soi, []byte{0x00, 0x00, 0x00, 0x00}, exifSegment(binary.LittleEndian, 6)), expected: 1},
+		{name: "not a jpeg", data: join([]byte("\x89PNG"), exifSegment(binary.LittleEndian, 6)), expected: 1},
+		{name: "empty", expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, jpegOrientation(tt.data))
+		})
+	}
+}
+
+func TestExifOrientation(t *testing.T) {
+	tiff := exifSegment(binary.LittleEndian, 6)[4+6:]
+	withOrientation := func(orientation uint16) []byte {
+		data := bytes.Clone(tiff)
+		binary.LittleEndian.PutUint16(data[8+2+12+8:], orientation)
+		return data
+	}
+	withUint32 := func(at int, value uint32) []byte {
+		data := bytes.Clone(tiff)
+		binary.LittleEndian.PutUint32(data[at:], value)
+		return data
+	}
+
+	tests := []struct {
+		name     string
+		tiff     []byte
+		expected int
+	}{
+		{name: "valid", tiff: tiff, expected: 6},
+		{name: "orientation 0", tiff: withOrientation(0), expected: 1},
+		{name: "orientation 9", tiff: withOrientation(9), expected: 1},
+		{name: "shorter than the header", tiff: tiff[:7], expected: 1},
+		{name: "unknown byte order", tiff: append([]byte("XX"), tiff[2:]...), expected: 1},
+		{name: "directory inside the header", tiff: withUint32(4, 4), expected: 1},
+		{name: "directory past the end", tiff: withUint32(4, uint32(len(tiff))), expected: 1},
+		{name: "truncated entries", tiff: tiff[:8+2+12+6], expected: 1},
+		{name: "without orientation", tiff: append(bytes.Clone(tiff[:8]), 0, 0, 0, 0, 0, 0), expected: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, exifOrientation(tt.tiff))
+		})
+	}
+}
+
+// testImage returns an image in which every pixel has a different color.
+func testImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetRGBA(x, y, color.RGBA{R: uint8(x * 40), G: uint8(y * 40), B: uint8(x*10 + y), A: 255})
+		}
+	}
+	return img
+}
+
+func TestOrient(t *testing.T) {
+	// upright is the image as it should be displayed, width 3 and height 2.
+	upright := testImage(3, 2)
+	width, height := 3, 2
+
+	// Every case stores the upright image the way a camera held in the orientation would.
+	tests := []struct {
+		orientation int
+		stored      func(x, y int) (int, int)
+		rotated     bool
+	}{
+		{orientation: 1, stored: func(x, y int) (int, int) { return x, y }},
+		{orientation: 2, stored: func(x, y int) (int, int) { return width - 1 - x, y }},
+		{orientation: 3, stored: func(x, y int) (int, int) { return width - 1 - x, height - 1 - y }},
+		{orientation: 4, stored: func(x, y int) (int, int) { return x, height - 1 - y }},
+		{orientation: 5, stored: func(x, y int) (int, int) { return y, x }, rotated: true},
+		{orientation: 6, stored: func(x, y int) (int, int) { return width - 1 - y, x }, rotated: true},
+		{orientation: 7, stored: func(x, y int) (int, int) { return width - 1 - y, height - 1 - x }, rotated: true},
+		{orientation: 8, stored: func(x, y int) (int, int) { return y, height - 1 - x }, rotated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.orientation), func(t *testing.T) {
+			storedWidth, storedHeight := width, height
+			if tt.rotated {
+				storedWidth, storedHeight = height, width
+			}
+
+			stored := image.NewRGBA(image.Rect(0, 0, storedWidth, storedHeight))
+			for y := 0; y < storedHeight; y++ {
+				for x := 0; x < storedWidth; x++ {
+					stored.SetRGBA(x, y, upright.RGBAAt(tt.stored(x, y)))
+				}
+			}
+
+			require.Equal(t, upright, orient(stored, tt.orientation))
+		})
+	}
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		name           string
+		width          int
+		height         int
+		maxEdge        int
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{name: "landscape", width: 400, height: 200, maxEdge: 200, expectedWidth: 200, expectedHeight: 100},
+		{name: "portrait", width: 300, height: 600, maxEdge: 200, expectedWidth: 100, expectedHeight: 200},
+		{name: "square", width: 641, height: 641, maxEdge: 640, expectedWidth: 640, expectedHeight: 640},
+		{name: "thin line keeps a pixel", width: 1000, height: 2, maxEdge: 200, expectedWidth: 200, expectedHeight: 1},
+		{name: "small enough", width: 200, height: 100, maxEdge: 200, expectedWidth: 200, expectedHeight: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := testImage(tt.width, tt.height)
+			dst := resize(src, tt.maxEdge)
+			require.Equal(t, image.Rect(0, 0, tt.expectedWidth, tt.expectedHeight), dst.Rect)
+			if tt.width == tt.expectedWidth && tt.height == tt.expectedHeight {
+				require.Same(t, src, dst)
+			}
+		})
+	}
+
+	t.Run("averages the covered pixels", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+		src.SetRGBA(0, 0, color.RGBA{R: 255, A: 255})
+		src.SetRGBA(1, 0, color.RGBA{G: 255, A: 255})
+		src.SetRGBA(0, 1, color.RGBA{B: 255, A: 255})
+		src.SetRGBA(1, 1, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+		dst := resize(src, 1)
+		require.Equal(t, color.RGBA{R: 128, G: 128, B: 128, A: 255}, dst.RGBAAt(0, 0))
+	})
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// withPNGSize changes the dimensions in the header of a PNG image.
+func withPNGSize(data []byte, width, height int) []byte {
+	data = bytes.Clone(data)
+	// The IHDR chunk follows the 8 byte signature, its data starts after its length and type.
+	ihdr := data[16 : 16+13]
+	binary.BigEndian.PutUint32(ihdr, uint32(width))
+	binary.BigEndian.PutUint32(ihdr[4:], uint32(height))
+	binary.BigEndian.PutUint32(data[16+13:], crc32.ChecksumIEEE(data[12:16+13]))
+	return data
+}
+
+func TestImageProcessor_ProcessImage(t *testing.T) {
+	landscape := testImage(1600, 800)
+	small := testImage(100, 50)
+
+	tests := []struct {
+		name           string
+		data           []byte
+		maxSize        int
+		expectedFormat string
+		expectedSizes  []image.Point
+		expectedError  error
+	}{
+		{
+			name:           "jpeg",
+			data:           encodeJPEG(t, landscape),
+			expectedFormat: "jpeg",
+			expectedSizes:  []image.Point{{200, 100}, {640, 320}, {1280, 640}},
+		},
+		{
+			name:           "png",
+			data:           encodePNG(t, landscape),
+			expectedFormat: "png",
+			expectedSizes:  []image.Point{{200, 100}, {640, 320}, {1280, 640}},
+		},
+		{
+			name:           "small image keeps its size",
+			data:           encodePNG(t, small),
+			expectedFormat: "png",
+			expectedSizes:  []image.Point{{100, 50}, {100, 50}, {100, 50}},
+		},
+		{
+			name:           "rotated jpeg",
+			data:           withSegments(encodeJPEG(t, landscape), exifSegment(binary.BigEndian, 6)),
+			expectedFormat: "jpeg",
+			expectedSizes:  []image.Point{{100, 200}, {320, 640}, {640, 1280}},
+		},
+		{
+			name:           "lossless webp",
+			data:           webp(webpChunk("VP8L", vp8l(1600, 800))),
+			expectedFormat: "png",
+			expectedSizes:  []image.Point{{200, 100}, {640, 320}, {1280, 640}},
+		},
+		{
+			name:           "lossy webp",
+			data:           lossyWebP,
+			expectedFormat: "jpeg",
+			expectedSizes:  []image.Point{{1, 1}, {1, 1}, {1, 1}},
+		},
+		{
+			name:           "extended webp with metadata",
+			data:           exifWebP(800, 1600),
+			expectedFormat: "png",
+			expectedSizes:  []image.Point{{100, 200}, {320, 640}, {640, 1280}},
+		},
+		{
+			name:          "file too large",
+			data:          encodePNG(t, small),
+			maxSize:       100,
+			expectedError: domain.ErrImageTooLarge,
+		},
+		{
+			name:          "dimensions too large",
+			data:          withPNGSize(encodePNG(t, small), domain.MaxImageDimension+1, 1),
+			expectedError: domain.ErrImageTooLarge,
+		},
+		{
+			name:          "webp dimensions too large",
+			data:          webp(webpChunk("VP8L", vp8l(domain.MaxImageDimension+1, 1))),
+			expectedError: domain.ErrImageTooLarge,
+		},
+		{
+			name:          "webp frame larger than its canvas",
+			data:          webp(vp8x(0, 100, 50), webpChunk("VP8L", vp8l(domain.MaxImageDimension+1, 50))),
+			expectedError: domain.ErrImageTooLarge,
+		},
+		{
+			name:          "corrupt header",
+			data:          append([]byte("\xff\xd8\xff"), strings.Repeat("\x00", 100)...),
+			expectedError: domain.ErrCorruptImage,
+		},
+		{
+			name:          "truncated image data",
+			data:          encodePNG(t, landscape)[:200],
+			expectedError: domain.ErrCorruptImage,
+		},
+		{
+			name:          "truncated webp",
+			data:          webp(webpChunk("VP8L", vp8l(1600, 800)))[:24],
+			expectedError: domain.ErrCorruptImage,
+		},
+		{
+			name:          "unknown format",
+			data:          []byte("GIF89a"),
+			expectedError: domain.ErrInvalidImageFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxSize := tt.maxSize
+			if maxSize == 0 {
+				maxSize = 10 << 20
+			}
+
+			processed, err := NewImageProcessor(&config.StorageConfig{ImagesMaxSize: maxSize}).
+				ProcessImage(context.Background(), bytes.NewReader(tt.data))
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError != nil {
+				require.Nil(t, processed)
+				return
+			}
+
+			require.Len(t, processed, len(domain.ImageVariants))
+			for i, variant := range domain.ImageVariants {
+				require.Equal(t, variant, processed[i].Variant)
+
+				imageConfig, format, err := image.DecodeConfig(bytes.NewReader(processed[i].Data))
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedFormat, format)
+				require.Equal(t, tt.expectedSizes[i], image.Pt(imageConfig.Width, imageConfig.Height))
+			}
+		})
+	}
+}
+
+func TestImageProcessor_ProcessImageStripsExif(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "jpeg", data: withSegments(encodeJPEG(t, testImage(300, 200)), exifSegment(binary.LittleEndian, 1))},
+		{name: "webp", data: exifWebP(300, 200)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processed, err := NewImageProcessor(&config.StorageConfig{ImagesMaxSize: 10 << 20}).
+				ProcessImage(context.Background(), bytes.NewReader(tt.data))
+			require.NoError(t, err)
+			for _, variant := range processed {
+				require.NotContains(t, string(variant.Data), "II*\x00")
+			}
+		})
+	}
+}
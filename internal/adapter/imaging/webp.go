@@ -0,0 +1,50 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"restaurant/internal/core/domain"
+)
+
+// checkWebPFrame checks the dimensions of the bitstream of a WebP image. For extended images the
+// decoder only reports the dimensions of the canvas, but allocates the frame by the dimensions in
+// the bitstream, so a small canvas could otherwise hide a huge frame.
+func checkWebPFrame(data []byte) error {
+	// The RIFF header and the WEBP form type are checked when detecting the image type.
+	for offset := 12; len(data)-offset >= 8; {
+		fourCC := string(data[offset : offset+4])
+		size := int(binary.LittleEndian.Uint32(data[offset+4 : offset+8]))
+		payload := data[offset+8:]
+		if size < len(payload) {
+			payload = payload[:size]
+		}
+
+		var width, height int
+		switch fourCC {
+		case "VP8 ":
+			// A key frame starts with a three byte frame tag and the start code, followed by the 14 bit dimensions.
+			if len(payload) < 10 {
+				return domain.ErrCorruptImage
+			}
+			width = int(binary.LittleEndian.Uint16(payload[6:8]) & 0x3fff)
+			height = int(binary.LittleEndian.Uint16(payload[8:10]) & 0x3fff)
+		case "VP8L":
+			// A lossless bitstream starts with its signature, followed by the 14 bit dimensions minus one.
+			if len(payload) < 5 {
+				return domain.ErrCorruptImage
+			}
+			bits := binary.LittleEndian.Uint32(payload[1:5])
+			width = int(bits&0x3fff) + 1
+			height = int(bits>>14&0x3fff) + 1
+		default:
+			// Chunks are padded to an even size.
+			offset += 8 + size + size%2
+			continue
+		}
+
+		if width > domain.MaxImageDimension || height > domain.MaxImageDimension {
+			return domain.ErrImageTooLarge
+		}
+		return nil
+	}
+	return domain.ErrCorruptImage
+}
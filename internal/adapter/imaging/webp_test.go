@@ -0,0 +1,105 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"restaurant/internal/core/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// lossyWebP is a lossy WebP image of a single pixel.
+var lossyWebP, _ = base64.StdEncoding.DecodeString("UklGRiIAAABXRUJQVlA4IBYAAAAwAQCdASoBAAEADsD+JaQAA3AAAAAA")
+
+// bitWriter writes values least significant bit first, as the lossless WebP bitstream is read.
+type bitWriter struct {
+	data []byte
+	n    uint
+}
+
+func (w *bitWriter) write(value uint32, bits uint) {
+	for i := range bits {
+		if w.n%8 == 0 {
+			w.data = append(w.data, 0)
+		}
+		w.data[len(w.data)-1] |= byte(value>>i&1) << (w.n % 8)
+		w.n++
+	}
+}
+
+// vp8l returns a lossless bitstream of an image in a single color. Every prefix code holds a
+// single symbol, which takes no bits, so the pixels themselves need no data at all.
+func vp8l(width, height int) []byte {
+	w := &bitWriter{}
+	w.write(0x2f, 8)
+	w.write(uint32(width-1), 14)
+	w.write(uint32(height-1), 14)
+	// No alpha hint, version 0, no transforms, no color cache and no meta prefix codes.
+	w.write(0, 7)
+	// Green, red, blue and alpha codes with a single eight bit symbol.
+	for _, value := range []uint32{200, 100, 50, 255} {
+		w.write(0b101|value<<3, 11)
+	}
+	// Distance code with a single one bit symbol.
+	w.write(0b0001, 4)
+	return w.data
+}
+
+// vp8x returns the header chunk of an extended WebP image.
+func vp8x(flags byte, width, height int) []byte {
+	payload := []byte{flags, 0, 0, 0}
+	payload = binary.LittleEndian.AppendUint32(payload, uint32(width-1))[:7]
+	payload = binary.LittleEndian.AppendUint32(payload, uint32(height-1))[:10]
+	return webpChunk("VP8X", payload)
+}
+
+// webpChunk returns a RIFF chunk with the payload, padded to an even size.
+func webpChunk(fourCC string, payload []byte) []byte {
+	data := binary.LittleEndian.AppendUint32([]byte(fourCC), uint32(len(payload)))
+	data = append(data, payload...)
+	if len(payload)%2 == 1 {
+		data = append(data, 0)
+	}
+	return data
+}
+
+// exifWebP returns an extended lossless WebP image with EXIF data.
+func exifWebP(width, height int) []byte {
+	const exifFlag = 0x08
+	tiff := exifSegment(binary.LittleEndian, 1)[4+6:]
+	return webp(vp8x(exifFlag, width, height), webpChunk("VP8L", vp8l(width, height)), webpChunk("EXIF", tiff))
+}
+
+// webp returns a WebP image of the chunks.
+func webp(chunks ...[]byte) []byte {
+	body := append([]byte("WEBP"), bytes.Join(chunks, nil)...)
+	return append(binary.LittleEndian.AppendUint32([]byte("RIFF"), uint32(len(body))), body...)
+}
+
+func TestCheckWebPFrame(t *testing.T) {
+	tooLarge := domain.MaxImageDimension + 1
+
+	tests := []struct {
+		name          string
+		data          []byte
+		expectedError error
+	}{
+		{name: "lossless", data: webp(webpChunk("VP8L", vp8l(100, 50)))},
+		{name: "lossy", data: lossyWebP},
+		{name: "extended", data: webp(vp8x(0, 100, 50), webpChunk("ICCP", []byte("odd")), webpChunk("VP8L", vp8l(100, 50)))},
+		{name: "lossless too wide", data: webp(webpChunk("VP8L", vp8l(tooLarge, 1))), expectedError: domain.ErrImageTooLarge},
+		{name: "lossless too high", data: webp(webpChunk("VP8L", vp8l(1, tooLarge))), expectedError: domain.ErrImageTooLarge},
+		{name: "frame larger than the canvas", data: webp(vp8x(0, 100, 50), webpChunk("VP8L", vp8l(tooLarge, 50))), expectedError: domain.ErrImageTooLarge},
+		{name: "truncated bitstream", data: webp(webpChunk("VP8L", vp8l(100, 50)))[:22], expectedError: domain.ErrCorruptImage},
+		{name: "without bitstream", data: webp(vp8x(0, 100, 50)), expectedError: domain.ErrCorruptImage},
+		{name: "chunk size past the end", data: webp(append(webpChunk("VP8X", nil)[:4], 0xff, 0xff, 0xff, 0xff)), expectedError: domain.ErrCorruptImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, checkWebPFrame(tt.data), tt.expectedError)
+		})
+	}
+}
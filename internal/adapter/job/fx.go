@@ -0,0 +1,26 @@
+package job
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module(
+	"job",
+	fx.Provide(NewJobs),
+	fx.Provide(func(jobs []Job) *Scheduler {
+		return NewScheduler(jobs...)
+	}),
+	fx.Invoke(func(lc fx.Lifecycle, scheduler *Scheduler) {
+		lc.Append(fx.Hook{
+			OnStart: func(context.Context) error {
+				scheduler.Start()
+				return nil
+			},
+			OnStop: func(ctx context.Context) error {
+				return scheduler.Stop(ctx)
+			},
+		})
+	}),
+)
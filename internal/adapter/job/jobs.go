@@ -0,0 +1,52 @@
+package job
+
+import (
+	"context"
+	"restaurant/internal/adapter/config"
+	"restaurant/internal/core/port"
+
+	"go.uber.org/zap"
+)
+
+// NewJobs creates the background jobs of the application.
+func NewJobs(
+	jobConfig *config.JobConfig,
+	imageCleanupService port.ImageCleanupService,
+	recommendationService port.RecommendationService,
+) []Job {
+	return []Job{
+		{
+			Name:     "image_operations",
+			Interval: jobConfig.ImageOperationsInterval,
+			Run: func(ctx context.Context) error {
+				deleted, err := imageCleanupService.ProcessImageOperations(ctx)
+				if deleted > 0 {
+					zap.L().Info("deleted unused images", zap.Int("count", deleted))
+				}
+				return err
+			},
+		},
+		{
+			Name:     "image_reconciliation",
+			Interval: jobConfig.ImageReconciliationInterval,
+			Run: func(ctx context.Context) error {
+				orphans, err := imageCleanupService.ReconcileImages(ctx)
+				if orphans > 0 {
+					zap.L().Info("scheduled deletion of orphaned images", zap.Int("count", orphans))
+				}
+				return err
+			},
+		},
+		{
+			Name:     "recommendations",
+			Interval: jobConfig.RecommendationsInterval,
+			Run: func(ctx context.Context) error {
+				products, err := recommendationService.RefreshRecommendations(ctx)
+				if err == nil {
+					zap.L().Info("refreshed recommendations", zap.Int("products", products))
+				}
+				return err
+			},
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"restaurant/internal/adapter/config"
+	"restaurant/internal/core/domain"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ImageRepository implements port.ImageRepository and keeps images in a local directory
+// that is served under config.ImagesRoute.
+type ImageRepository struct {
+	dir       string
+	urlPrefix string
+}
+
+// NewImageRepository creates a new ImageRepository instance and the images directory if it doesn't exist.
+func NewImageRepository(storageConfig *config.StorageConfig) (*ImageRepository, error) {
+	if err := os.MkdirAll(storageConfig.ImagesDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &ImageRepository{
+		dir:       storageConfig.ImagesDir,
+		urlPrefix: storageConfig.ImagesPublicUrl + config.ImagesRoute + "/",
+	}, nil
+}
+
+func (r *ImageRepository) SaveImage(ctx context.Context, data io.Reader) (*domain.Image, error) {
+	reader := bufio.NewReaderSize(data, 512)
+	head, err := reader.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		zap.L().Error("error reading image", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	_, extension, err := domain.DetectImageType(head)
+	if err != nil {
+		return nil, err
+	}
+
+	// The image is written to a temporary file first, so a failed upload never leaves
+	// a partial file behind under a served name.
+	file, err := os.CreateTemp(r.dir, ".upload-*")
+	if err != nil {
+		zap.L().Error("error creating image file", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	defer func() {
+		if removeErr := os.Remove(file.Name()); removeErr != nil && !errors.Is(removeErr, fs.ErrNotExist) {
+			zap.L().Warn("error removing temporary image file", zap.Error(removeErr))
+		}
+	}()
+
+	if _, err = io.Copy(file, reader); err != nil {
+		_ = file.Close()
+		zap.L().Error("error writing image file", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	if err = file.Close(); err != nil {
+		zap.L().Error("error closing image file", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	name := "restaurant-" + uuid.New().String() + extension
+	if err = os.Rename(file.Name(), filepath.Join(r.dir, name)); err != nil {
+		zap.L().Error("error moving image file", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	url := r.urlPrefix + name
+	return &domain.Image{
+		Url:       url,
+		DeleteUrl: url,
+	}, nil
+}
+
+func (r *ImageRepository) DeleteImage(ctx context.Context, deleteUrl string) error {
+	// Images uploaded to another storage before switching to the filesystem can't be deleted here.
+	name, ok := strings.CutPrefix(deleteUrl, r.urlPrefix)
+	if !ok || name == "" || name != path.Base(name) {
+		zap.L().Warn("skipping deletion of image outside the images directory", zap.String("url", deleteUrl))
+		return nil
+	}
+
+	if err := os.Remove(filepath.Join(r.dir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		zap.L().Error("error deleting image file", zap.String("name", name), zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ImageRepository) ListImages(ctx context.Context) ([]domain.StoredImage, error) {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		zap.L().Error("error reading images directory", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	var images []domain.StoredImage
+	for _, entry := range entries {
+		// Temporary files of running uploads don't have the prefix and are left alone.
+		if !entry.Type().IsRegular() || !strings.HasPrefix(entry.Name(), "restaurant-") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			zap.L().Error("error reading image file info", zap.String("name", entry.Name()), zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+
+		url := r.urlPrefix + entry.Name()
+		images = append(images, domain.StoredImage{
+			Image: domain.Image{
+				Url:       url,
+				DeleteUrl: url,
+			},
+			UploadedAt: info.ModTime(),
+		})
+	}
+	return images, nil
+}
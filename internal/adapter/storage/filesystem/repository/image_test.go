@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"restaurant/internal/adapter/config"
+	"restaurant/internal/core/domain"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testImagesPublicUrl = "https://cdn.example.com"
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// failingReader returns its data, then fails instead of ending.
+type failingReader struct {
+	data io.Reader
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	n, err := r.data.Read(p)
+	if errors.Is(err, io.EOF) {
+		return n, errors.New("connection reset")
+	}
+	return n, err
+}
+
+func newTestImageRepository(t *testing.T) (*ImageRepository, string) {
+	dir := filepath.Join(t.TempDir(), "images")
+	r, err := NewImageRepository(&config.StorageConfig{
+		ImagesDir:       dir,
+		ImagesPublicUrl: testImagesPublicUrl,
+	})
+	require.NoError(t, err)
+	return r, dir
+}
+
+func dirNames(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestImageRepository_SaveImage(t *testing.T) {
+	png := append(bytes.Clone(pngHeader), bytes.Repeat([]byte{1}, 2048)...)
+
+	tests := []struct {
+		name              string
+		data              io.Reader
+		expectedExtension string
+		expectedError     error
+	}{
+		{
+			name:              "success",
+			data:              bytes.NewReader(png),
+			expectedExtension: ".png",
+		},
+		{
+			name:          "unknown format",
+			data:          strings.NewReader("GIF89a" + strings.Repeat("\x00", 100)),
+			expectedError: domain.ErrInvalidImageFormat,
+		},
+		{
+			name:          "empty",
+			data:          bytes.NewReader(nil),
+			expectedError: domain.ErrInvalidImageFormat,
+		},
+		{
+			name:          "interrupted upload leaves no file behind",
+			data:          &failingReader{data: bytes.NewReader(png)},
+			expectedError: domain.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dir := newTestImageRepository(t)
+
+			image, err := r.SaveImage(context.Background(), tt.data)
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError != nil {
+				require.Nil(t, image)
+				require.Empty(t, dirNames(t, dir))
+				return
+			}
+
+			names := dirNames(t, dir)
+			require.Len(t, names, 1)
+			require.True(t, strings.HasPrefix(names[0], "restaurant-"))
+			require.Equal(t, tt.expectedExtension, filepath.Ext(names[0]))
+			require.Equal(t, testImagesPublicUrl+config.ImagesRoute+"/"+names[0], image.Url)
+			require.Equal(t, image.Url, image.DeleteUrl)
+
+			saved, err := os.ReadFile(filepath.Join(dir, names[0]))
+			require.NoError(t, err)
+			require.Equal(t, png, saved)
+		})
+	}
+}
+
+func TestImageRepository_DeleteImage(t *testing.T) {
+	prefix := testImagesPublicUrl + config.ImagesRoute + "/"
+
+	tests := []struct {
+		name          string
+		deleteUrl     string
+		expectedNames []string
+	}{
+		{
+			name:          "success",
+			deleteUrl:     prefix + "restaurant-a.png",
+			expectedNames: []string{"restaurant-b.png"},
+		},
+		{
+			name:          "missing file",
+			deleteUrl:     prefix + "restaurant-c.png",
+			expectedNames: []string{"restaurant-a.png", "restaurant-b.png"},
+		},
+		{
+			name:          "path traversal",
+			deleteUrl:     prefix + "../secret.png",
+			expectedNames: []string{"restaurant-a.png", "restaurant-b.png"},
+		},
+		{
+			name:          "nested path",
+			deleteUrl:     prefix + "nested/restaurant-a.png",
+			expectedNames: []string{"restaurant-a.png", "restaurant-b.png"},
+		},
+		{
+			name:          "directory itself",
+			deleteUrl:     prefix,
+			expectedNames: []string{"restaurant-a.png", "restaurant-b.png"},
+		},
+		{
+			name:          "other storage",
+			deleteUrl:     "https://i.ibb.co/abc/restaurant-a.png",
+			expectedNames: []string{"restaurant-a.png", "restaurant-b.png"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, dir := newTestImageRepository(t)
+			for _, name := range []string{"restaurant-a.png", "restaurant-b.png"} {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), pngHeader, 0o644))
+			}
+			secret := filepath.Join(filepath.Dir(dir), "secret.png")
+			require.NoError(t, os.WriteFile(secret, pngHeader, 0o644))
+
+			require.NoError(t, r.DeleteImage(context.Background(), tt.deleteUrl))
+			require.Equal(t, tt.expectedNames, dirNames(t, dir))
+			require.FileExists(t, secret)
+		})
+	}
+}
+
+func TestImageRepository_ListImages(t *testing.T) {
+	r, dir := newTestImageRepository(t)
+	for _, name := range []string{"restaurant-a.png", ".upload-123", "other.png"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), pngHeader, 0o644))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "restaurant-dir"), 0o755))
+
+	images, err := r.ListImages(context.Background())
+	require.NoError(t, err)
+	require.Len(t, images, 1)
+	require.Equal(t, testImagesPublicUrl+config.ImagesRoute+"/restaurant-a.png", images[0].Url)
+	require.Equal(t, images[0].Url, images[0].DeleteUrl)
+	require.False(t, images[0].UploadedAt.IsZero())
+}
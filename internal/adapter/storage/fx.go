@@ -1,14 +1,32 @@
 package storage
 
 import (
-	"restaurant/internal/adapter/storage/http"
+	"restaurant/internal/adapter/config"
+	filesystemRepository "restaurant/internal/adapter/storage/filesystem/repository"
+	httpRepository "restaurant/internal/adapter/storage/http/repository"
+	"restaurant/internal/adapter/storage/memory"
 	"restaurant/internal/adapter/storage/postgres"
+	s3Repository "restaurant/internal/adapter/storage/s3/repository"
+	"restaurant/internal/core/port"
 
 	"go.uber.org/fx"
 )
 
 var Module = fx.Module(
 	"storage",
-	http.Module,
 	postgres.Module,
+	memory.Module,
+	fx.Provide(newImageRepository),
 )
+
+// newImageRepository creates the image repository selected by the storage config.
+func newImageRepository(storageConfig *config.StorageConfig) (port.ImageRepository, error) {
+	switch storageConfig.ImagesStorage {
+	case config.FilesystemImageStorage:
+		return filesystemRepository.NewImageRepository(storageConfig)
+	case config.S3ImageStorage:
+		return s3Repository.NewImageRepository(storageConfig), nil
+	default:
+		return httpRepository.NewImageRepository(storageConfig), nil
+	}
+}
@@ -135,3 +135,8 @@ func (r *ImageRepository) DeleteImage(ctx context.Context, deleteUrl string) err
 
 	return nil
 }
+
+func (r *ImageRepository) ListImages(ctx context.Context) ([]domain.StoredImage, error) {
+	// imgbb has no API for listing the uploaded images.
+	return nil, domain.ErrImageListingUnsupported
+}
@@ -0,0 +1,22 @@
+package memory
+
+import (
+	"restaurant/internal/adapter/storage/memory/repository"
+	"restaurant/internal/core/port"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module(
+	"memoryStorage",
+	fx.Provide(
+		fx.Annotate(
+			repository.NewMenuCacheRepository,
+			fx.As(new(port.MenuCacheRepository)),
+		),
+		fx.Annotate(
+			repository.NewRecommendationCacheRepository,
+			fx.As(new(port.RecommendationCacheRepository)),
+		),
+	),
+)
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"restaurant/internal/core/port"
+	"sync"
+	"time"
+)
+
+// maxMenuCacheEntries limits how many listings are cached, since every search is cached on its own.
+const maxMenuCacheEntries = 1000
+
+// MenuCacheRepository implements port.MenuCacheRepository and keeps the listings in memory.
+// Besides being invalidated, all listings expire at the start of every minute, because schedules
+// and pricing rules change what guests see without the menu being changed.
+type MenuCacheRepository struct {
+	clock         port.Clock
+	mu            sync.Mutex
+	entries       map[string]any
+	expiresAt     time.Time
+	invalidatedAt time.Time
+}
+
+// NewMenuCacheRepository creates a new MenuCacheRepository instance.
+func NewMenuCacheRepository(clock port.Clock) *MenuCacheRepository {
+	return &MenuCacheRepository{
+		clock:         clock,
+		entries:       make(map[string]any),
+		invalidatedAt: clock.Now(),
+	}
+}
+
+// expire drops the listings once the minute they were cached in has passed.
+// It must be called with the mutex held.
+func (r *MenuCacheRepository) expire(now time.Time) {
+	if now.Before(r.expiresAt) {
+		return
+	}
+	clear(r.entries)
+	r.expiresAt = now.Truncate(time.Minute).Add(time.Minute)
+}
+
+func (r *MenuCacheRepository) Get(key string) (any, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire(r.clock.Now())
+	value, ok := r.entries[key]
+	return value, ok
+}
+
+func (r *MenuCacheRepository) Set(key string, value any, loadedAt time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire(r.clock.Now())
+	if loadedAt.Before(r.invalidatedAt) || loadedAt.Before(r.expiresAt.Add(-time.Minute)) {
+		return
+	}
+	if _, ok := r.entries[key]; !ok && len(r.entries) >= maxMenuCacheEntries {
+		return
+	}
+	r.entries[key] = value
+}
+
+func (r *MenuCacheRepository) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clear(r.entries)
+	r.invalidatedAt = r.clock.Now()
+}
+
+func (r *MenuCacheRepository) ModifiedAt() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if minute := r.clock.Now().Truncate(time.Minute); minute.After(r.invalidatedAt) {
+		return minute
+	}
+	return r.invalidatedAt
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func TestMenuCacheRepository_GetSet(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 30, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		run      func(r *MenuCacheRepository, clock *testClock)
+		expected any
+	}{
+		{
+			name: "cached within the minute",
+			run: func(r *MenuCacheRepository, clock *testClock) {
+				r.Set("key", "menu", clock.now)
+				clock.now = start.Add(29 * time.Second)
+			},
+			expected: "menu",
+		},
+		{
+			name: "expires at the start of the next minute",
+			run: func(r *MenuCacheRepository, clock *testClock) {
+				r.Set("key", "menu", clock.now)
+				clock.now = start.Add(30 * time.Second)
+			},
+		},
+		{
+			name: "dropped on invalidation",
+			run: func(r *MenuCacheRepository, clock *testClock) {
+				r.Set("key", "menu", clock.now)
+				r.Invalidate()
+			},
+		},
+		{
+			name: "not cached when loaded before an invalidation",
+			run: func(r *MenuCacheRepository, clock *testClock) {
+				loadedAt := clock.now
+				clock.now = start.Add(time.Second)
+				r.Invalidate()
+				clock.now = start.Add(2 * time.Second)
+				r.Set("key", "menu", loadedAt)
+			},
+		},
+		{
+			name: "cached when loaded after an invalidation",
+			run: func(r *MenuCacheRepository, clock *testClock) {
+				r.Invalidate()
+				clock.now = start.Add(time.Second)
+				r.Set("key", "menu", clock.now)
+			},
+			expected: "menu",
+		},
+		{
+			name: "not cached when loaded in a previous minute",
+			run: func(r *MenuCacheRepository, clock *testClock) {
+				loadedAt := clock.now
+				clock.now = start.Add(31 * time.Second)
+				r.Set("key", "menu", loadedAt)
+			},
+		},
+		{
+			name: "not cached when full",
+			run: func(r *MenuCacheRepository, clock *testClock) {
+				for i := range maxMenuCacheEntries {
+					r.Set(strconv.Itoa(i), i, clock.now)
+				}
+				r.Set("key", "menu", clock.now)
+			},
+		},
+		{
+			name: "replaced when full",
+			run: func(r *MenuCacheRepository, clock *testClock) {
+				r.Set("key", "old", clock.now)
+				for i := range maxMenuCacheEntries - 1 {
+					r.Set(strconv.Itoa(i), i, clock.now)
+				}
+				r.Set("key", "menu", clock.now)
+			},
+			expected: "menu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &testClock{now: start.Add(-time.Hour)}
+			r := NewMenuCacheRepository(clock)
+			clock.now = start
+
+			tt.run(r, clock)
+
+			value, ok := r.Get("key")
+			require.Equal(t, tt.expected != nil, ok)
+			require.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestMenuCacheRepository_ModifiedAt(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 30, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		run      func(r *MenuCacheRepository, clock *testClock)
+		expected time.Time
+	}{
+		{
+			name:     "start of the minute",
+			run:      func(*MenuCacheRepository, *testClock) {},
+			expected: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "invalidation within the minute",
+			run: func(r *MenuCacheRepository, clock *testClock) {
+				r.Invalidate()
+				clock.now = start.Add(10 * time.Second)
+			},
+			expected: start,
+		},
+		{
+			name: "start of a minute after the invalidation",
+			run: func(r *MenuCacheRepository, clock *testClock) {
+				r.Invalidate()
+				clock.now = start.Add(45 * time.Second)
+			},
+			expected: time.Date(2024, 1, 1, 12, 1, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &testClock{now: start.Add(-time.Hour)}
+			r := NewMenuCacheRepository(clock)
+			clock.now = start
+
+			tt.run(r, clock)
+
+			require.Equal(t, tt.expected, r.ModifiedAt())
+		})
+	}
+}
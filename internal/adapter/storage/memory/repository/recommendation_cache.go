@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"restaurant/internal/core/domain"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// RecommendationCacheRepository implements port.RecommendationCacheRepository and keeps the
+// recommendations in memory until they are recomputed.
+type RecommendationCacheRepository struct {
+	mu              sync.RWMutex
+	recommendations map[uuid.UUID][]domain.Recommendation
+}
+
+// NewRecommendationCacheRepository creates a new RecommendationCacheRepository instance.
+func NewRecommendationCacheRepository() *RecommendationCacheRepository {
+	return &RecommendationCacheRepository{
+		recommendations: make(map[uuid.UUID][]domain.Recommendation),
+	}
+}
+
+func (r *RecommendationCacheRepository) Get(productId uuid.UUID) []domain.Recommendation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.recommendations[productId]
+}
+
+func (r *RecommendationCacheRepository) Replace(recommendations map[uuid.UUID][]domain.Recommendation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.recommendations = recommendations
+}
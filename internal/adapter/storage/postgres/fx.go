@@ -22,4 +22,28 @@ var Module = fx.Module(
 			fx.As(new(port.OrderRepository)),
 		),
 	),
+	fx.Provide(
+		fx.Annotate(
+			repository.NewInventoryRepository,
+			fx.As(new(port.InventoryRepository)),
+		),
+	),
+	fx.Provide(
+		fx.Annotate(
+			repository.NewBundleRepository,
+			fx.As(new(port.BundleRepository)),
+		),
+	),
+	fx.Provide(
+		fx.Annotate(
+			repository.NewImageOperationRepository,
+			fx.As(new(port.ImageOperationRepository)),
+		),
+	),
+	fx.Provide(
+		fx.Annotate(
+			repository.NewRecommendationRepository,
+			fx.As(new(port.RecommendationRepository)),
+		),
+	),
 )
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS ordered_product_options;
+DROP TABLE IF EXISTS modifier_options;
+DROP TABLE IF EXISTS modifier_groups;
@@ -0,0 +1,26 @@
+CREATE TABLE modifier_groups
+(
+    id           UUID PRIMARY KEY,
+    product_id   UUID         NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    name         VARCHAR(100) NOT NULL CHECK ( length(name) >= 3 ),
+    min_selected INT          NOT NULL CHECK ( min_selected >= 0 ),
+    max_selected INT          NOT NULL CHECK ( max_selected >= 1 AND max_selected >= min_selected ),
+    UNIQUE (product_id, name)
+);
+
+CREATE TABLE modifier_options
+(
+    id          UUID PRIMARY KEY,
+    group_id    UUID          NOT NULL REFERENCES modifier_groups (id) ON DELETE CASCADE,
+    name        VARCHAR(100)  NOT NULL CHECK ( length(name) >= 1 ),
+    price_delta DECIMAL(8, 2) NOT NULL DEFAULT 0 CHECK ( price_delta >= 0 ),
+    position    INT           NOT NULL,
+    UNIQUE (group_id, name)
+);
+
+CREATE TABLE ordered_product_options
+(
+    ordered_product_id UUID NOT NULL REFERENCES ordered_products (id) ON DELETE CASCADE,
+    option_id          UUID NOT NULL REFERENCES modifier_options (id),
+    PRIMARY KEY (ordered_product_id, option_id)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE ordered_products
+    DROP COLUMN IF EXISTS quantity,
+    DROP COLUMN IF EXISTS note;
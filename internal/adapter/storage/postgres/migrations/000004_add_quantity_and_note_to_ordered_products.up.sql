@@ -0,0 +1,3 @@
+ALTER TABLE ordered_products
+    ADD COLUMN quantity INT NOT NULL DEFAULT 1 CHECK ( quantity > 0 ),
+    ADD COLUMN note     VARCHAR(200);
@@ -0,0 +1,7 @@
+ALTER TABLE ordered_product_options
+    DROP COLUMN IF EXISTS name,
+    DROP COLUMN IF EXISTS price_delta;
+
+ALTER TABLE ordered_products
+    DROP COLUMN IF EXISTS product_name,
+    DROP COLUMN IF EXISTS unit_price;
@@ -0,0 +1,27 @@
+ALTER TABLE ordered_products
+    ADD COLUMN product_name VARCHAR(100),
+    ADD COLUMN unit_price   DECIMAL(8, 2);
+
+ALTER TABLE ordered_product_options
+    ADD COLUMN name        VARCHAR(100),
+    ADD COLUMN price_delta DECIMAL(8, 2);
+
+UPDATE ordered_products op
+SET product_name = p.name,
+    unit_price   = p.price
+FROM products p
+WHERE op.product_id = p.id;
+
+UPDATE ordered_product_options opo
+SET name        = mo.name,
+    price_delta = mo.price_delta
+FROM modifier_options mo
+WHERE opo.option_id = mo.id;
+
+ALTER TABLE ordered_products
+    ALTER COLUMN product_name SET NOT NULL,
+    ALTER COLUMN unit_price SET NOT NULL;
+
+ALTER TABLE ordered_product_options
+    ALTER COLUMN name SET NOT NULL,
+    ALTER COLUMN price_delta SET NOT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE products
+    DROP COLUMN IF EXISTS archived_at;
+
+ALTER TABLE product_categories
+    DROP COLUMN IF EXISTS archived_at;
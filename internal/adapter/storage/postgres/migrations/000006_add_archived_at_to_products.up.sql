@@ -0,0 +1,5 @@
+ALTER TABLE product_categories
+    ADD COLUMN archived_at TIMESTAMPTZ;
+
+ALTER TABLE products
+    ADD COLUMN archived_at TIMESTAMPTZ;
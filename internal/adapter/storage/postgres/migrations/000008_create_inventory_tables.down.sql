@@ -0,0 +1,7 @@
+ALTER TABLE ordered_products
+    DROP COLUMN IF EXISTS stock_deducted;
+
+DROP TABLE IF EXISTS stock_movements;
+DROP TYPE IF EXISTS stock_movement_kind;
+DROP TABLE IF EXISTS recipe_items;
+DROP TABLE IF EXISTS ingredients;
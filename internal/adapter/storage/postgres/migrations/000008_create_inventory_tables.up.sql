@@ -0,0 +1,31 @@
+CREATE TABLE ingredients
+(
+    id      UUID PRIMARY KEY,
+    name    VARCHAR(100)   NOT NULL UNIQUE CHECK ( length(name) >= 2 ),
+    unit    VARCHAR(20)    NOT NULL,
+    on_hand DECIMAL(12, 3) NOT NULL DEFAULT 0
+);
+
+CREATE TABLE recipe_items
+(
+    product_id    UUID           NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    ingredient_id UUID           NOT NULL REFERENCES ingredients (id),
+    amount        DECIMAL(12, 3) NOT NULL CHECK ( amount > 0 ),
+    PRIMARY KEY (product_id, ingredient_id)
+);
+
+CREATE TYPE stock_movement_kind AS ENUM ('adjustment', 'count', 'consumption');
+
+CREATE TABLE stock_movements
+(
+    id                 UUID PRIMARY KEY,
+    ingredient_id      UUID                NOT NULL REFERENCES ingredients (id) ON DELETE CASCADE,
+    kind               stock_movement_kind NOT NULL,
+    delta              DECIMAL(12, 3)      NOT NULL,
+    reason             VARCHAR(200),
+    ordered_product_id UUID,
+    created_at         TIMESTAMPTZ         NOT NULL DEFAULT now()
+);
+
+ALTER TABLE ordered_products
+    ADD COLUMN stock_deducted BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,32 @@
+CREATE TYPE tag_kind AS ENUM ('allergen', 'dietary');
+
+CREATE TABLE tags
+(
+    id   UUID PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE CHECK ( length(name) >= 2 ),
+    kind tag_kind    NOT NULL
+);
+
+CREATE TABLE product_tags
+(
+    product_id UUID NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    tag_id     UUID NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (product_id, tag_id)
+);
+
+-- The 14 allergens that must be declared under EU Regulation No 1169/2011.
+INSERT INTO tags(id, name, kind)
+VALUES (gen_random_uuid(), 'gluten', 'allergen'),
+       (gen_random_uuid(), 'crustaceans', 'allergen'),
+       (gen_random_uuid(), 'eggs', 'allergen'),
+       (gen_random_uuid(), 'fish', 'allergen'),
+       (gen_random_uuid(), 'peanuts', 'allergen'),
+       (gen_random_uuid(), 'soybeans', 'allergen'),
+       (gen_random_uuid(), 'milk', 'allergen'),
+       (gen_random_uuid(), 'nuts', 'allergen'),
+       (gen_random_uuid(), 'celery', 'allergen'),
+       (gen_random_uuid(), 'mustard', 'allergen'),
+       (gen_random_uuid(), 'sesame', 'allergen'),
+       (gen_random_uuid(), 'sulphites', 'allergen'),
+       (gen_random_uuid(), 'lupin', 'allergen'),
+       (gen_random_uuid(), 'molluscs', 'allergen');
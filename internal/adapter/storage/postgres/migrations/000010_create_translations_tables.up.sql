@@ -0,0 +1,16 @@
+CREATE TABLE product_translations
+(
+    product_id  UUID         NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    locale      VARCHAR(35)  NOT NULL,
+    name        VARCHAR(100) NOT NULL CHECK ( length(name) >= 3 ),
+    description TEXT         NOT NULL CHECK ( length(description) >= 15 ),
+    PRIMARY KEY (product_id, locale)
+);
+
+CREATE TABLE category_translations
+(
+    category_id UUID         NOT NULL REFERENCES product_categories (id) ON DELETE CASCADE,
+    locale      VARCHAR(35)  NOT NULL,
+    name        VARCHAR(100) NOT NULL CHECK ( length(name) >= 4 ),
+    PRIMARY KEY (category_id, locale)
+);
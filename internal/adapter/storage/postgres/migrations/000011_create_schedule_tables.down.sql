@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS category_schedule_windows;
+DROP TABLE IF EXISTS product_schedule_windows;
@@ -0,0 +1,17 @@
+CREATE TABLE product_schedule_windows
+(
+    product_id UUID     NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    weekday    SMALLINT NOT NULL CHECK ( weekday BETWEEN 0 AND 6 ),
+    start_time TIME     NOT NULL,
+    end_time   TIME     NOT NULL,
+    PRIMARY KEY (product_id, weekday, start_time)
+);
+
+CREATE TABLE category_schedule_windows
+(
+    category_id UUID     NOT NULL REFERENCES product_categories (id) ON DELETE CASCADE,
+    weekday     SMALLINT NOT NULL CHECK ( weekday BETWEEN 0 AND 6 ),
+    start_time  TIME     NOT NULL,
+    end_time    TIME     NOT NULL,
+    PRIMARY KEY (category_id, weekday, start_time)
+);
@@ -0,0 +1,6 @@
+ALTER TABLE ordered_products
+    DROP COLUMN IF EXISTS pricing_rule_name;
+
+DROP TABLE IF EXISTS pricing_rule_windows;
+DROP TABLE IF EXISTS pricing_rules;
+DROP TYPE IF EXISTS price_adjustment_kind;
@@ -0,0 +1,25 @@
+CREATE TYPE price_adjustment_kind AS ENUM ('percentage', 'fixed_price');
+
+CREATE TABLE pricing_rules
+(
+    id          UUID PRIMARY KEY,
+    name        VARCHAR(100)          NOT NULL UNIQUE CHECK ( length(name) >= 3 ),
+    product_id  UUID REFERENCES products (id) ON DELETE CASCADE,
+    category_id UUID REFERENCES product_categories (id) ON DELETE CASCADE,
+    kind        price_adjustment_kind NOT NULL,
+    value       DECIMAL(8, 2)         NOT NULL CHECK ( value > 0 ),
+    CHECK ( num_nonnulls(product_id, category_id) = 1 ),
+    CHECK ( kind <> 'percentage' OR value <= 100 )
+);
+
+CREATE TABLE pricing_rule_windows
+(
+    rule_id    UUID     NOT NULL REFERENCES pricing_rules (id) ON DELETE CASCADE,
+    weekday    SMALLINT NOT NULL CHECK ( weekday BETWEEN 0 AND 6 ),
+    start_time TIME     NOT NULL,
+    end_time   TIME     NOT NULL,
+    PRIMARY KEY (rule_id, weekday, start_time)
+);
+
+ALTER TABLE ordered_products
+    ADD COLUMN pricing_rule_name VARCHAR(100);
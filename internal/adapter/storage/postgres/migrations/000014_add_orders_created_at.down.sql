@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS ordered_products_created_at_idx;
+DROP INDEX IF EXISTS order_sessions_created_at_idx;
+
+ALTER TABLE ordered_products
+    DROP COLUMN IF EXISTS created_at;
+
+ALTER TABLE order_sessions
+    DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,8 @@
+ALTER TABLE order_sessions
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+ALTER TABLE ordered_products
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX order_sessions_created_at_idx ON order_sessions (created_at DESC, id DESC);
+CREATE INDEX ordered_products_created_at_idx ON ordered_products (created_at DESC, id DESC);
@@ -0,0 +1,7 @@
+ALTER TABLE ordered_products
+    DROP COLUMN IF EXISTS ordered_bundle_id;
+
+DROP TABLE IF EXISTS ordered_bundles;
+DROP TABLE IF EXISTS bundle_slot_products;
+DROP TABLE IF EXISTS bundle_slots;
+DROP TABLE IF EXISTS bundles;
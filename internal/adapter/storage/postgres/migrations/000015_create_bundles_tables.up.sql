@@ -0,0 +1,39 @@
+CREATE TABLE bundles
+(
+    id          UUID PRIMARY KEY,
+    name        VARCHAR(100)  NOT NULL UNIQUE CHECK ( length(name) >= 3 ),
+    description TEXT          NOT NULL CHECK ( length(description) >= 15 ),
+    price       DECIMAL(8, 2) NOT NULL CHECK ( price > 0 ),
+    available   BOOLEAN       NOT NULL DEFAULT TRUE
+);
+
+CREATE TABLE bundle_slots
+(
+    id          UUID PRIMARY KEY,
+    bundle_id   UUID         NOT NULL REFERENCES bundles (id) ON DELETE CASCADE,
+    name        VARCHAR(100) NOT NULL CHECK ( length(name) >= 1 ),
+    category_id UUID REFERENCES product_categories (id) ON DELETE CASCADE,
+    position    INT          NOT NULL,
+    UNIQUE (bundle_id, name)
+);
+
+CREATE TABLE bundle_slot_products
+(
+    slot_id    UUID NOT NULL REFERENCES bundle_slots (id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    PRIMARY KEY (slot_id, product_id)
+);
+
+CREATE TABLE ordered_bundles
+(
+    id         UUID PRIMARY KEY,
+    bundle_id  UUID          REFERENCES bundles (id) ON DELETE SET NULL,
+    session_id UUID          NOT NULL REFERENCES order_sessions (id),
+    name       VARCHAR(100)  NOT NULL,
+    price      DECIMAL(8, 2) NOT NULL,
+    quantity   INT           NOT NULL CHECK ( quantity > 0 ),
+    created_at TIMESTAMPTZ   NOT NULL DEFAULT now()
+);
+
+ALTER TABLE ordered_products
+    ADD COLUMN ordered_bundle_id UUID REFERENCES ordered_bundles (id) ON DELETE CASCADE;
@@ -0,0 +1,15 @@
+CREATE TABLE menu_versions
+(
+    number        INT PRIMARY KEY CHECK ( number > 0 ),
+    rows          JSONB       NOT NULL,
+    restored_from INT REFERENCES menu_versions (number),
+    published_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- There is a single draft, so the key can only ever be true.
+CREATE TABLE menu_draft
+(
+    id         BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK ( id ),
+    rows       JSONB       NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,2 @@
+ALTER TABLE products
+    ADD COLUMN image_variants JSONB;
@@ -0,0 +1,18 @@
+-- Positions are swapped when the gallery is reordered, so their uniqueness is only checked on commit.
+CREATE TABLE product_images
+(
+    id         UUID PRIMARY KEY,
+    product_id UUID         NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    position   INT          NOT NULL CHECK ( position >= 0 ),
+    alt_text   VARCHAR(200) NOT NULL DEFAULT '',
+    url        VARCHAR(200) NOT NULL,
+    delete_url VARCHAR(200),
+    variants   JSONB,
+    CONSTRAINT product_images_position_key UNIQUE (product_id, position) DEFERRABLE INITIALLY DEFERRED
+);
+
+-- The image a product already has becomes the first image of its gallery.
+INSERT INTO product_images(id, product_id, position, url, delete_url, variants)
+SELECT gen_random_uuid(), id, 0, image_url, delete_image_url, image_variants
+FROM products
+WHERE image_url IS NOT NULL;
@@ -0,0 +1,13 @@
+CREATE TABLE image_operations
+(
+    id              UUID PRIMARY KEY,
+    kind            VARCHAR(10)  NOT NULL CHECK ( kind IN ('upload', 'delete') ),
+    delete_url      VARCHAR(200) NOT NULL,
+    attempts        INT          NOT NULL DEFAULT 0 CHECK ( attempts >= 0 ),
+    last_error      TEXT,
+    next_attempt_at TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX image_operations_next_attempt_at_idx ON image_operations (next_attempt_at);
+CREATE INDEX image_operations_delete_url_idx ON image_operations (delete_url);
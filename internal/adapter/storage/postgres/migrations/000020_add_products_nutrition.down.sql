@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS products_calories_idx;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS calories,
+    DROP COLUMN IF EXISTS protein,
+    DROP COLUMN IF EXISTS fat,
+    DROP COLUMN IF EXISTS carbohydrates,
+    DROP COLUMN IF EXISTS sugar,
+    DROP COLUMN IF EXISTS salt,
+    DROP COLUMN IF EXISTS portion_size;
@@ -0,0 +1,11 @@
+-- Nutrition facts refer to a portion of the product and are NULL while unknown.
+ALTER TABLE products
+    ADD COLUMN calories      INT CHECK ( calories >= 0 ),
+    ADD COLUMN protein       NUMERIC(6, 2) CHECK ( protein >= 0 ),
+    ADD COLUMN fat           NUMERIC(6, 2) CHECK ( fat >= 0 ),
+    ADD COLUMN carbohydrates NUMERIC(6, 2) CHECK ( carbohydrates >= 0 ),
+    ADD COLUMN sugar         NUMERIC(6, 2) CHECK ( sugar >= 0 ),
+    ADD COLUMN salt          NUMERIC(6, 2) CHECK ( salt >= 0 ),
+    ADD COLUMN portion_size  VARCHAR(50);
+
+CREATE INDEX products_calories_idx ON products (calories) WHERE calories IS NOT NULL;
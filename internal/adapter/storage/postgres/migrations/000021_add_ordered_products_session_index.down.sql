@@ -0,0 +1 @@
+DROP INDEX IF EXISTS ordered_products_session_id_idx;
@@ -0,0 +1,2 @@
+-- Recommendations join the ordered products of every session with each other.
+CREATE INDEX ordered_products_session_id_idx ON ordered_products (session_id, product_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS product_translations_search_vector_idx;
+
+ALTER TABLE product_translations
+    DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,7 @@
+ALTER TABLE product_translations
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', name), 'A') ||
+        setweight(to_tsvector('simple', description), 'B')
+    ) STORED;
+
+CREATE INDEX product_translations_search_vector_idx ON product_translations USING GIN (search_vector);
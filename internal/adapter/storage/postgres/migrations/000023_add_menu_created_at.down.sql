@@ -0,0 +1,8 @@
+ALTER TABLE menu_draft
+    DROP COLUMN IF EXISTS created_at;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS created_at;
+
+ALTER TABLE product_categories
+    DROP COLUMN IF EXISTS created_at;
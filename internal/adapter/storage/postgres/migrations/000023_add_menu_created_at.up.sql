@@ -0,0 +1,9 @@
+-- Publishing a draft only archives the entries that existed when the draft was started.
+ALTER TABLE product_categories
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+ALTER TABLE products
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+ALTER TABLE menu_draft
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
@@ -0,0 +1,2 @@
+ALTER TABLE menu_versions
+    DROP COLUMN IF EXISTS details;
@@ -0,0 +1,3 @@
+-- Versions published before the details were kept have none.
+ALTER TABLE menu_versions
+    ADD COLUMN details JSONB;
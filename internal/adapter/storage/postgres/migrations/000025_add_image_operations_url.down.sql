@@ -0,0 +1,2 @@
+ALTER TABLE image_operations
+    DROP COLUMN IF EXISTS url;
@@ -0,0 +1,7 @@
+ALTER TABLE image_operations
+    ADD COLUMN url VARCHAR(200);
+-- Earlier operations only kept the delete url, which is the url itself for every storage but imgbb.
+UPDATE image_operations
+SET url = delete_url;
+ALTER TABLE image_operations
+    ALTER COLUMN url SET NOT NULL;
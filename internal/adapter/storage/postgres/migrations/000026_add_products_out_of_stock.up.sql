@@ -0,0 +1,4 @@
+-- Products that were marked unavailable by the stock rule become available again once restocked,
+-- unlike products an admin marked unavailable.
+ALTER TABLE products
+    ADD COLUMN out_of_stock BOOLEAN NOT NULL DEFAULT FALSE;
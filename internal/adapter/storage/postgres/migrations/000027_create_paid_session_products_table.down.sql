@@ -0,0 +1 @@
+DROP TABLE IF EXISTS paid_session_products;
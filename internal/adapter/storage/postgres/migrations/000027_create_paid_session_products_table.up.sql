@@ -0,0 +1,11 @@
+-- Paying a bill deletes the ordered products of the session, so the products of paid sessions
+-- are kept here for the recommendations. Sessions may be deleted later, the history stays.
+CREATE TABLE paid_session_products
+(
+    session_id UUID        NOT NULL,
+    product_id UUID        NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    ordered_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (session_id, product_id)
+);
+
+CREATE INDEX paid_session_products_ordered_at_idx ON paid_session_products (ordered_at);
@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"restaurant/internal/core/domain"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// BundleRepository implements port.BundleRepository and provides access to postgres.
+type BundleRepository struct {
+	db *sql.DB
+}
+
+// NewBundleRepository creates a new BundleRepository instance.
+func NewBundleRepository(db *sql.DB) *BundleRepository {
+	return &BundleRepository{
+		db: db,
+	}
+}
+
+// selectBundles selects bundles b with their slots aggregated into a JSON array.
+const selectBundles = `SELECT b.id,
+		b.name,
+		b.description,
+		b.price,
+		b.available,
+		COALESCE((
+			SELECT jsonb_agg(
+				jsonb_build_object(
+					'id', s.id,
+					'name', s.name,
+					'categoryId', s.category_id,
+					'productIds', COALESCE((
+						SELECT jsonb_agg(sp.product_id ORDER BY sp.product_id)
+						FROM bundle_slot_products sp
+						WHERE sp.slot_id = s.id
+					), '[]'::jsonb)
+				)
+				ORDER BY s.position
+			)
+			FROM bundle_slots s
+			WHERE s.bundle_id = b.id
+		), '[]'::jsonb)
+	FROM bundles b`
+
+// scanBundle scans a bundle selected by selectBundles.
+func scanBundle(scan func(dest ...any) error) (*domain.Bundle, error) {
+	var bundle domain.Bundle
+	var slots []byte
+	if err := scan(
+		&bundle.Id,
+		&bundle.Name,
+		&bundle.Description,
+		&bundle.Price,
+		&bundle.Available,
+		&slots,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(slots, &bundle.Slots); err != nil {
+		zap.L().Error("error decoding bundle slots", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return &bundle, nil
+}
+
+func (r *BundleRepository) GetBundles(ctx context.Context) ([]domain.Bundle, error) {
+	rows, err := r.db.QueryContext(ctx, selectBundles+" ORDER BY b.name")
+	if err != nil {
+		zap.L().Error("error getting bundles", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var bundles []domain.Bundle
+	for rows.Next() {
+		bundle, err := scanBundle(rows.Scan)
+		if errors.Is(err, domain.ErrInternal) {
+			return nil, err
+		} else if err != nil {
+			zap.L().Error("error scanning row", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		bundles = append(bundles, *bundle)
+	}
+
+	return bundles, nil
+}
+
+func (r *BundleRepository) GetBundleById(ctx context.Context, id uuid.UUID) (*domain.Bundle, error) {
+	bundle, err := scanBundle(r.db.QueryRowContext(ctx, selectBundles+" WHERE b.id = $1", id).Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrBundleNotFound
+	} else if errors.Is(err, domain.ErrInternal) {
+		return nil, err
+	} else if err != nil {
+		zap.L().Error("error scanning row", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return bundle, nil
+}
+
+var addBundlePqErrorMap = map[string]map[string]error{
+	"23505": {
+		"bundles_name_key":                domain.ErrBundleNameAlreadyInUse,
+		"bundle_slots_bundle_id_name_key": domain.ErrInvalidBundle,
+		"bundle_slot_products_pkey":       domain.ErrInvalidBundle,
+	},
+	"23503": {
+		"bundle_slots_category_id_fkey":        domain.ErrProductCategoryNotFound,
+		"bundle_slot_products_product_id_fkey": domain.ErrProductNotFound,
+	},
+}
+
+func (r *BundleRepository) AddBundle(ctx context.Context, bundle *domain.Bundle) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	mapErr := func(err error) error {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			if mappedCode, ok := addBundlePqErrorMap[string(pqErr.Code)]; ok {
+				if mappedConstraint, ok := mappedCode[pqErr.Constraint]; ok {
+					return mappedConstraint
+				}
+			}
+
+			zap.L().Error("unexpected pq error", zap.Error(pqErr))
+			return domain.ErrInternal
+		}
+
+		zap.L().Error("error adding bundle", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO bundles(id, name, description, price, available)
+		VALUES ($1, $2, $3, $4, $5)`,
+		bundle.Id,
+		bundle.Name,
+		bundle.Description,
+		bundle.Price,
+		bundle.Available,
+	); err != nil {
+		return mapErr(err)
+	}
+
+	for position, slot := range bundle.Slots {
+		if _, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO bundle_slots(id, bundle_id, name, category_id, position)
+			VALUES ($1, $2, $3, $4, $5)`,
+			slot.Id,
+			bundle.Id,
+			slot.Name,
+			slot.CategoryId,
+			position,
+		); err != nil {
+			return mapErr(err)
+		}
+
+		for _, productId := range slot.ProductIds {
+			if _, err = tx.ExecContext(
+				ctx,
+				"INSERT INTO bundle_slot_products(slot_id, product_id) VALUES ($1, $2)",
+				slot.Id,
+				productId,
+			); err != nil {
+				return mapErr(err)
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *BundleRepository) DeleteBundle(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM bundles WHERE id = $1", id)
+	if err != nil {
+		zap.L().Error("error deleting bundle", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrBundleNotFound
+	}
+	return nil
+}
+
+func (r *BundleRepository) SetBundleAvailability(ctx context.Context, id uuid.UUID, available bool) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE bundles
+		SET available = $1
+		WHERE id = $2`,
+		available,
+		id,
+	)
+	if err != nil {
+		zap.L().Error("error updating bundle availability", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrBundleNotFound
+	}
+	return nil
+}
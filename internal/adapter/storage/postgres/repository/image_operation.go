@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"restaurant/internal/core/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// ImageOperationRepository implements port.ImageOperationRepository and provides access to postgres.
+type ImageOperationRepository struct {
+	db *sql.DB
+}
+
+// NewImageOperationRepository creates a new ImageOperationRepository instance.
+func NewImageOperationRepository(db *sql.DB) *ImageOperationRepository {
+	return &ImageOperationRepository{
+		db: db,
+	}
+}
+
+func (r *ImageOperationRepository) AddImageOperations(ctx context.Context, operations []domain.ImageOperation) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	for _, operation := range operations {
+		if _, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO image_operations(id, kind, url, delete_url, next_attempt_at)
+			VALUES ($1, $2, $3, $4, $5)`,
+			operation.Id,
+			operation.Kind,
+			operation.Url,
+			operation.DeleteUrl,
+			operation.NextAttemptAt,
+		); err != nil {
+			zap.L().Error("error adding image operation", zap.Error(err))
+			return domain.ErrInternal
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ImageOperationRepository) ClaimImageOperations(ctx context.Context, limit int, lease time.Duration) ([]domain.ImageOperation, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`UPDATE image_operations
+		SET attempts = attempts + 1,
+		next_attempt_at = now() + $2 * INTERVAL '1 second'
+		WHERE id IN (
+			SELECT id
+			FROM image_operations
+			WHERE next_attempt_at <= now()
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, kind, url, delete_url, attempts, last_error, next_attempt_at, created_at`,
+		limit,
+		lease.Seconds(),
+	)
+	if err != nil {
+		zap.L().Error("error claiming image operations", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var operations []domain.ImageOperation
+	for rows.Next() {
+		var operation domain.ImageOperation
+		if err = rows.Scan(
+			&operation.Id,
+			&operation.Kind,
+			&operation.Url,
+			&operation.DeleteUrl,
+			&operation.Attempts,
+			&operation.LastError,
+			&operation.NextAttemptAt,
+			&operation.CreatedAt,
+		); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		operations = append(operations, operation)
+	}
+
+	if err = rows.Err(); err != nil {
+		zap.L().Error("error claiming image operations", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return operations, nil
+}
+
+func (r *ImageOperationRepository) CompleteImageOperation(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM image_operations WHERE id = $1", id); err != nil {
+		zap.L().Error("error completing image operation", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ImageOperationRepository) FailImageOperation(ctx context.Context, id uuid.UUID, message string, nextAttemptAt time.Time) error {
+	if _, err := r.db.ExecContext(
+		ctx,
+		`UPDATE image_operations
+		SET last_error = $1,
+		next_attempt_at = $2
+		WHERE id = $3`,
+		message,
+		nextAttemptAt,
+		id,
+	); err != nil {
+		zap.L().Error("error failing image operation", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ImageOperationRepository) GetUsedImageUrls(ctx context.Context) (map[string]struct{}, error) {
+	return r.getImageUrls(ctx, usedImageUrlsQuery)
+}
+
+func (r *ImageOperationRepository) GetReferencedImageUrls(ctx context.Context) (map[string]struct{}, error) {
+	return r.getImageUrls(ctx, usedImageUrlsQuery+" UNION SELECT delete_url FROM image_operations")
+}
+
+// usedImageUrlsQuery selects the urls and delete urls of all images used by products. Menu versions
+// and the draft keep the image urls of their rows, so rolling back or publishing them can bring an image back.
+const usedImageUrlsQuery = `SELECT url FROM product_images
+	UNION SELECT delete_url FROM product_images
+	UNION SELECT v.value->>'Url' FROM product_images, jsonb_each(variants) v
+	UNION SELECT v.value->>'DeleteUrl' FROM product_images, jsonb_each(variants) v
+	UNION SELECT image_url FROM products
+	UNION SELECT delete_image_url FROM products
+	UNION SELECT r->>'ImageUrl' FROM menu_versions, jsonb_array_elements(rows) r
+	UNION SELECT r->>'ImageUrl' FROM menu_draft, jsonb_array_elements(rows) r`
+
+// getImageUrls fetches the set of image urls selected by the query.
+func (r *ImageOperationRepository) getImageUrls(ctx context.Context, query string) (map[string]struct{}, error) {
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		zap.L().Error("error getting image urls", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	urls := make(map[string]struct{})
+	for rows.Next() {
+		var url sql.NullString
+		if err = rows.Scan(&url); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		if url.Valid {
+			urls[url.String] = struct{}{}
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		zap.L().Error("error getting image urls", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return urls, nil
+}
+
+// claimImageUploads completes the pending upload operations of images that were linked to a product inside the transaction.
+func claimImageUploads(ctx context.Context, tx *sql.Tx, deleteUrls []string) error {
+	if len(deleteUrls) == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`DELETE FROM image_operations
+		WHERE kind = $1 AND delete_url = ANY($2)`,
+		domain.UploadImageOperation,
+		pq.Array(deleteUrls),
+	); err != nil {
+		zap.L().Error("error claiming image uploads", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// scheduleImageDeletions records the deletion of images that are no longer used inside the transaction,
+// so they are only deleted when the change that made them unused is committed.
+func scheduleImageDeletions(ctx context.Context, tx *sql.Tx, images []domain.Image) error {
+	for _, operation := range domain.NewImageOperations(domain.DeleteImageOperation, images, time.Now()) {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO image_operations(id, kind, url, delete_url, next_attempt_at)
+			VALUES ($1, $2, $3, $4, $5)`,
+			operation.Id,
+			operation.Kind,
+			operation.Url,
+			operation.DeleteUrl,
+			operation.NextAttemptAt,
+		); err != nil {
+			zap.L().Error("error scheduling image deletion", zap.Error(err))
+			return domain.ErrInternal
+		}
+	}
+	return nil
+}
@@ -0,0 +1,402 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"restaurant/internal/core/domain"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// InventoryRepository implements port.InventoryRepository and provides access to postgres.
+type InventoryRepository struct {
+	db *sql.DB
+}
+
+// NewInventoryRepository creates a new InventoryRepository instance.
+func NewInventoryRepository(db *sql.DB) *InventoryRepository {
+	return &InventoryRepository{
+		db: db,
+	}
+}
+
+func (r *InventoryRepository) AddIngredient(ctx context.Context, ingredient *domain.Ingredient) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		"INSERT INTO ingredients(id, name, unit, on_hand) VALUES ($1, $2, $3, $4)",
+		ingredient.Id,
+		ingredient.Name,
+		ingredient.Unit,
+		ingredient.OnHand,
+	)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return domain.ErrIngredientNameAlreadyInUse
+	} else if err != nil {
+		zap.L().Error("error adding ingredient", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *InventoryRepository) GetIngredients(ctx context.Context) ([]domain.Ingredient, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT id, name, unit, on_hand FROM ingredients ORDER BY name")
+	if err != nil {
+		zap.L().Error("error getting ingredients", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var ingredients []domain.Ingredient
+	for rows.Next() {
+		var ingredient domain.Ingredient
+		if err = rows.Scan(&ingredient.Id, &ingredient.Name, &ingredient.Unit, &ingredient.OnHand); err != nil {
+			zap.L().Error("error scanning row", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		ingredients = append(ingredients, ingredient)
+	}
+
+	if err = rows.Err(); err != nil {
+		zap.L().Error("error iterating rows", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return ingredients, nil
+}
+
+func (r *InventoryRepository) DeleteIngredient(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM ingredients WHERE id = $1", id)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return domain.ErrIngredientInUse
+	} else if err != nil {
+		zap.L().Error("error deleting ingredient", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrIngredientNotFound
+	}
+	return nil
+}
+
+func (r *InventoryRepository) AdjustStock(ctx context.Context, dto *domain.StockAdjustmentDTO) (*domain.Ingredient, []domain.AvailabilityChange, error) {
+	return r.changeStock(ctx, dto.IngredientId, domain.StockAdjustment, dto.Reason, func(decimal.Decimal) decimal.Decimal {
+		return dto.Delta
+	})
+}
+
+func (r *InventoryRepository) CountStock(ctx context.Context, dto *domain.StockCountDTO) (*domain.Ingredient, []domain.AvailabilityChange, error) {
+	return r.changeStock(ctx, dto.IngredientId, domain.StockCount, dto.Reason, func(onHand decimal.Decimal) decimal.Decimal {
+		return dto.Counted.Sub(onHand)
+	})
+}
+
+// changeStock locks an ingredient, applies the delta computed from its current on-hand
+// quantity, records the change as a stock movement and updates the availability of the products.
+func (r *InventoryRepository) changeStock(
+	ctx context.Context,
+	ingredientId uuid.UUID,
+	kind domain.StockMovementKind,
+	reason *string,
+	delta func(onHand decimal.Decimal) decimal.Decimal,
+) (*domain.Ingredient, []domain.AvailabilityChange, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return nil, nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	var ingredient domain.Ingredient
+	err = tx.QueryRowContext(
+		ctx,
+		"SELECT id, name, unit, on_hand FROM ingredients WHERE id = $1 FOR UPDATE",
+		ingredientId,
+	).Scan(&ingredient.Id, &ingredient.Name, &ingredient.Unit, &ingredient.OnHand)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, domain.ErrIngredientNotFound
+	} else if err != nil {
+		zap.L().Error("error getting ingredient", zap.Error(err))
+		return nil, nil, domain.ErrInternal
+	}
+
+	change := delta(ingredient.OnHand)
+	if ingredient.OnHand.Add(change).IsNegative() {
+		return nil, nil, domain.ErrInsufficientStock
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		"UPDATE ingredients SET on_hand = on_hand + $1 WHERE id = $2",
+		change,
+		ingredientId,
+	); err != nil {
+		zap.L().Error("error updating ingredient stock", zap.Error(err))
+		return nil, nil, domain.ErrInternal
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO stock_movements(id, ingredient_id, kind, delta, reason)
+		VALUES ($1, $2, $3, $4, $5)`,
+		uuid.New(),
+		ingredientId,
+		kind,
+		change,
+		reason,
+	); err != nil {
+		zap.L().Error("error adding stock movement", zap.Error(err))
+		return nil, nil, domain.ErrInternal
+	}
+
+	changes, err := updateStockAvailability(ctx, tx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return nil, nil, domain.ErrInternal
+	}
+
+	ingredient.OnHand = ingredient.OnHand.Add(change)
+	return &ingredient, changes, nil
+}
+
+var setRecipePqErrorMap = map[string]map[string]error{
+	"23503": {
+		"recipe_items_product_id_fkey":    domain.ErrProductNotFound,
+		"recipe_items_ingredient_id_fkey": domain.ErrIngredientNotFound,
+	},
+}
+
+func (r *InventoryRepository) SetRecipe(ctx context.Context, productId uuid.UUID, items []domain.RecipeItem) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	var exists bool
+	if err = tx.QueryRowContext(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM products WHERE id = $1)",
+		productId,
+	).Scan(&exists); err != nil {
+		zap.L().Error("error checking product", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if !exists {
+		return domain.ErrProductNotFound
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM recipe_items WHERE product_id = $1", productId); err != nil {
+		zap.L().Error("error deleting recipe items", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	for _, item := range items {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO recipe_items(product_id, ingredient_id, amount) VALUES ($1, $2, $3)",
+			productId,
+			item.IngredientId,
+			item.Amount,
+		)
+
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			if mappedCode, ok := setRecipePqErrorMap[string(pqErr.Code)]; ok {
+				if mappedConstraint, ok := mappedCode[pqErr.Constraint]; ok {
+					return mappedConstraint
+				}
+			}
+
+			zap.L().Error("unexpected pq error", zap.Error(pqErr))
+			return domain.ErrInternal
+		} else if err != nil {
+			zap.L().Error("error adding recipe item", zap.Error(err))
+			return domain.ErrInternal
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *InventoryRepository) GetRecipe(ctx context.Context, productId uuid.UUID) ([]domain.RecipeItem, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT product_id, ingredient_id, amount FROM recipe_items WHERE product_id = $1",
+		productId,
+	)
+	if err != nil {
+		zap.L().Error("error getting recipe", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var items []domain.RecipeItem
+	for rows.Next() {
+		var item domain.RecipeItem
+		if err = rows.Scan(&item.ProductId, &item.IngredientId, &item.Amount); err != nil {
+			zap.L().Error("error scanning row", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		zap.L().Error("error iterating rows", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return items, nil
+}
+
+// deductStock deducts the recipe of an ordered product from stock once inside the transaction, updates
+// the availability of the products and returns the changes. It fails with domain.ErrInsufficientStock
+// if an ingredient of the recipe would go negative.
+func deductStock(ctx context.Context, tx *sql.Tx, orderedProductId uuid.UUID) ([]domain.AvailabilityChange, error) {
+	// The flag guarantees the recipe is deducted only once, even if the product
+	// is moved to preparing several times.
+	var productId uuid.UUID
+	var quantity int
+	err := tx.QueryRowContext(
+		ctx,
+		`UPDATE ordered_products SET stock_deducted = TRUE
+		WHERE id = $1 AND NOT stock_deducted
+		RETURNING product_id, quantity`,
+		orderedProductId,
+	).Scan(&productId, &quantity)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		zap.L().Error("error marking ordered product as deducted", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO stock_movements(id, ingredient_id, kind, delta, ordered_product_id)
+		SELECT gen_random_uuid(), ingredient_id, 'consumption', -amount * $2, $3
+		FROM recipe_items
+		WHERE product_id = $1`,
+		productId,
+		quantity,
+		orderedProductId,
+	); err != nil {
+		zap.L().Error("error adding stock movements", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	// The updated ingredients stay locked until the transaction ends, so the check below
+	// sees the final quantities.
+	var insufficient bool
+	if err = tx.QueryRowContext(
+		ctx,
+		`WITH deducted AS (
+			UPDATE ingredients i SET on_hand = i.on_hand - ri.amount * $2
+			FROM recipe_items ri
+			WHERE ri.ingredient_id = i.id AND ri.product_id = $1
+			RETURNING i.on_hand
+		)
+		SELECT EXISTS(SELECT 1 FROM deducted WHERE on_hand < 0)`,
+		productId,
+		quantity,
+	).Scan(&insufficient); err != nil {
+		zap.L().Error("error deducting stock", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	if insufficient {
+		return nil, domain.ErrInsufficientStock
+	}
+	return updateStockAvailability(ctx, tx)
+}
+
+// updateStockAvailability marks products unavailable when an ingredient of their recipe would go negative
+// by preparing them once more, and makes the products it marked before available again once they can be
+// prepared. Products an admin marked unavailable are left as they are. It returns the changes.
+func updateStockAvailability(ctx context.Context, tx *sql.Tx) ([]domain.AvailabilityChange, error) {
+	rows, err := tx.QueryContext(
+		ctx,
+		`UPDATE products p SET available = NOT s.short, out_of_stock = s.short
+		FROM (
+			SELECT q.id, EXISTS(
+				SELECT 1 FROM recipe_items ri
+				JOIN ingredients i ON i.id = ri.ingredient_id
+				WHERE ri.product_id = q.id AND i.on_hand < ri.amount
+			) AS short
+			FROM products q
+		) s
+		WHERE s.id = p.id AND (p.available AND s.short OR p.out_of_stock AND NOT s.short)
+		RETURNING p.id, p.available`,
+	)
+	if err != nil {
+		zap.L().Error("error updating product availability", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	var changes []domain.AvailabilityChange
+	for rows.Next() {
+		var change domain.AvailabilityChange
+		if err = rows.Scan(&change.ProductId, &change.Available); err != nil {
+			zap.L().Error("error scanning row", zap.Error(err))
+			_ = rows.Close()
+			return nil, domain.ErrInternal
+		}
+		changes = append(changes, change)
+	}
+
+	if err = rows.Err(); err != nil {
+		zap.L().Error("error iterating rows", zap.Error(err))
+		_ = rows.Close()
+		return nil, domain.ErrInternal
+	}
+
+	if err = rows.Close(); err != nil {
+		zap.L().Error("error closing rows", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return changes, nil
+}
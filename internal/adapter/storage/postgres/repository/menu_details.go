@@ -0,0 +1,453 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"restaurant/internal/core/domain"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// captureMenuDetails fetches the details of the categories and products of the rows and all pricing rules.
+func captureMenuDetails(ctx context.Context, q queryer, rows []domain.MenuRow) (*domain.MenuDetails, error) {
+	var categoryIds, productIds []uuid.UUID
+	seen := make(map[uuid.UUID]struct{}, len(rows))
+	for _, row := range rows {
+		if row.CategoryId != nil {
+			if _, ok := seen[*row.CategoryId]; !ok {
+				seen[*row.CategoryId] = struct{}{}
+				categoryIds = append(categoryIds, *row.CategoryId)
+			}
+		}
+		if row.ProductId != nil {
+			productIds = append(productIds, *row.ProductId)
+		}
+	}
+
+	categories := make(map[uuid.UUID]*domain.CategoryDetails, len(categoryIds))
+	details := &domain.MenuDetails{
+		Categories: make([]domain.CategoryDetails, len(categoryIds)),
+		Products:   make([]domain.ProductDetails, len(productIds)),
+	}
+	for i, id := range categoryIds {
+		details.Categories[i].CategoryId = id
+		categories[id] = &details.Categories[i]
+	}
+	products := make(map[uuid.UUID]*domain.ProductDetails, len(productIds))
+	for i, id := range productIds {
+		details.Products[i].ProductId = id
+		products[id] = &details.Products[i]
+	}
+
+	categoryArg := pq.Array(uuidStrings(categoryIds))
+	productArg := pq.Array(uuidStrings(productIds))
+
+	for _, query := range []struct {
+		description string
+		statement   string
+		arg         any
+		scan        func(scan func(dest ...any) error) error
+	}{
+		{
+			description: "category schedule windows",
+			statement: `SELECT category_id, weekday, to_char(start_time, 'HH24:MI'), to_char(end_time, 'HH24:MI')
+			FROM category_schedule_windows
+			WHERE category_id = ANY($1::uuid[])
+			ORDER BY weekday, start_time`,
+			arg: categoryArg,
+			scan: func(scan func(dest ...any) error) error {
+				var id uuid.UUID
+				var window domain.ScheduleWindow
+				if err := scan(&id, &window.Weekday, &window.Start, &window.End); err != nil {
+					return err
+				}
+				categories[id].Schedule = append(categories[id].Schedule, window)
+				return nil
+			},
+		},
+		{
+			description: "category translations",
+			statement: `SELECT category_id, locale, name
+			FROM category_translations
+			WHERE category_id = ANY($1::uuid[])
+			ORDER BY locale`,
+			arg: categoryArg,
+			scan: func(scan func(dest ...any) error) error {
+				var translation domain.CategoryTranslation
+				if err := scan(&translation.CategoryId, &translation.Locale, &translation.Name); err != nil {
+					return err
+				}
+				category := categories[translation.CategoryId]
+				category.Translations = append(category.Translations, translation)
+				return nil
+			},
+		},
+		{
+			description: "product nutrition",
+			statement:   `SELECT id, ` + nutritionColumns + ` FROM products WHERE id = ANY($1::uuid[])`,
+			arg:         productArg,
+			scan: func(scan func(dest ...any) error) error {
+				var id uuid.UUID
+				var nutrition domain.Nutrition
+				if err := scan(append([]any{&id}, nutritionFields(&nutrition)...)...); err != nil {
+					return err
+				}
+				products[id].Nutrition = nutrition
+				return nil
+			},
+		},
+		{
+			description: "product tags",
+			statement: `SELECT product_id, tag_id
+			FROM product_tags
+			WHERE product_id = ANY($1::uuid[])
+			ORDER BY tag_id`,
+			arg: productArg,
+			scan: func(scan func(dest ...any) error) error {
+				var id, tagId uuid.UUID
+				if err := scan(&id, &tagId); err != nil {
+					return err
+				}
+				products[id].TagIds = append(products[id].TagIds, tagId)
+				return nil
+			},
+		},
+		{
+			description: "product schedule windows",
+			statement: `SELECT product_id, weekday, to_char(start_time, 'HH24:MI'), to_char(end_time, 'HH24:MI')
+			FROM product_schedule_windows
+			WHERE product_id = ANY($1::uuid[])
+			ORDER BY weekday, start_time`,
+			arg: productArg,
+			scan: func(scan func(dest ...any) error) error {
+				var id uuid.UUID
+				var window domain.ScheduleWindow
+				if err := scan(&id, &window.Weekday, &window.Start, &window.End); err != nil {
+					return err
+				}
+				products[id].Schedule = append(products[id].Schedule, window)
+				return nil
+			},
+		},
+		{
+			description: "product translations",
+			statement: `SELECT product_id, locale, name, description
+			FROM product_translations
+			WHERE product_id = ANY($1::uuid[])
+			ORDER BY locale`,
+			arg: productArg,
+			scan: func(scan func(dest ...any) error) error {
+				var translation domain.ProductTranslation
+				if err := scan(&translation.ProductId, &translation.Locale, &translation.Name, &translation.Description); err != nil {
+					return err
+				}
+				product := products[translation.ProductId]
+				product.Translations = append(product.Translations, translation)
+				return nil
+			},
+		},
+	} {
+		if err := scanMenuDetails(ctx, q, query.description, query.statement, query.arg, query.scan); err != nil {
+			return nil, err
+		}
+	}
+
+	groups, err := getModifierGroups(ctx, q, productIds)
+	if err != nil {
+		return nil, err
+	}
+	for id, product := range products {
+		product.ModifierGroups = groups[id]
+	}
+
+	if details.PricingRules, err = getAllPricingRules(ctx, q); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// scanMenuDetails runs the query and scans every row of it with scan.
+func scanMenuDetails(
+	ctx context.Context,
+	q queryer,
+	description string,
+	query string,
+	arg any,
+	scan func(scan func(dest ...any) error) error,
+) error {
+	rows, err := q.QueryContext(ctx, query, arg)
+	if err != nil {
+		zap.L().Error("error getting "+description, zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	for rows.Next() {
+		if err = scan(rows.Scan); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return domain.ErrInternal
+		}
+	}
+	return nil
+}
+
+// restoreMenuDetails replaces the details of the restored categories and products and the pricing rules
+// with the ones of a version inside the transaction. Tags and the owners of pricing rules that were deleted
+// since are left out, and modifier groups and options that were ordered since are kept.
+func restoreMenuDetails(ctx context.Context, tx *sql.Tx, details *domain.MenuDetails) error {
+	for _, category := range details.Categories {
+		if err := deleteRows(ctx, tx, "category schedule windows",
+			"DELETE FROM category_schedule_windows WHERE category_id = $1",
+			category.CategoryId,
+		); err != nil {
+			return err
+		}
+		for _, window := range category.Schedule {
+			if err := restoreRow(ctx, tx, "category schedule window",
+				"INSERT INTO category_schedule_windows(category_id, weekday, start_time, end_time) VALUES ($1, $2, $3, $4)",
+				category.CategoryId, int(window.Weekday), window.Start, window.End,
+			); err != nil {
+				return err
+			}
+		}
+
+		if err := deleteRows(ctx, tx, "category translations",
+			"DELETE FROM category_translations WHERE category_id = $1",
+			category.CategoryId,
+		); err != nil {
+			return err
+		}
+		for _, translation := range category.Translations {
+			if err := restoreRow(ctx, tx, "category translation",
+				"INSERT INTO category_translations(category_id, locale, name) VALUES ($1, $2, $3)",
+				category.CategoryId, translation.Locale, translation.Name,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, product := range details.Products {
+		if err := restoreRow(ctx, tx, "product nutrition",
+			`UPDATE products SET (`+nutritionColumns+`) = ($2, $3, $4, $5, $6, $7, $8) WHERE id = $1`,
+			append([]any{product.ProductId}, nutritionValues(&product.Nutrition)...)...,
+		); err != nil {
+			return err
+		}
+
+		if err := deleteRows(ctx, tx, "product tags",
+			"DELETE FROM product_tags WHERE product_id = $1",
+			product.ProductId,
+		); err != nil {
+			return err
+		}
+		if err := restoreRow(ctx, tx, "product tags",
+			"INSERT INTO product_tags(product_id, tag_id) SELECT $1::uuid, id FROM tags WHERE id = ANY($2::uuid[])",
+			product.ProductId, pq.Array(uuidStrings(product.TagIds)),
+		); err != nil {
+			return err
+		}
+
+		if err := deleteRows(ctx, tx, "product schedule windows",
+			"DELETE FROM product_schedule_windows WHERE product_id = $1",
+			product.ProductId,
+		); err != nil {
+			return err
+		}
+		for _, window := range product.Schedule {
+			if err := restoreRow(ctx, tx, "product schedule window",
+				"INSERT INTO product_schedule_windows(product_id, weekday, start_time, end_time) VALUES ($1, $2, $3, $4)",
+				product.ProductId, int(window.Weekday), window.Start, window.End,
+			); err != nil {
+				return err
+			}
+		}
+
+		if err := deleteRows(ctx, tx, "product translations",
+			"DELETE FROM product_translations WHERE product_id = $1",
+			product.ProductId,
+		); err != nil {
+			return err
+		}
+		for _, translation := range product.Translations {
+			if err := restoreRow(ctx, tx, "product translation",
+				"INSERT INTO product_translations(product_id, locale, name, description) VALUES ($1, $2, $3, $4)",
+				product.ProductId, translation.Locale, translation.Name, translation.Description,
+			); err != nil {
+				return err
+			}
+		}
+
+		if err := restoreModifierGroups(ctx, tx, product.ProductId, product.ModifierGroups); err != nil {
+			return err
+		}
+	}
+
+	return restorePricingRules(ctx, tx, details.PricingRules)
+}
+
+// restoreModifierGroups replaces the modifier groups of a product with the ones of a version.
+func restoreModifierGroups(ctx context.Context, tx *sql.Tx, productId uuid.UUID, groups []domain.ModifierGroup) error {
+	groupIds := make([]uuid.UUID, 0, len(groups))
+	for _, group := range groups {
+		groupIds = append(groupIds, group.Id)
+	}
+
+	if err := deleteRows(ctx, tx, "modifier groups",
+		`DELETE FROM modifier_groups g
+		WHERE g.product_id = $1 AND NOT g.id = ANY($2::uuid[])
+		AND NOT EXISTS(
+			SELECT 1 FROM modifier_options o
+			JOIN ordered_product_options opo ON opo.option_id = o.id
+			WHERE o.group_id = g.id
+		)`,
+		productId, pq.Array(uuidStrings(groupIds)),
+	); err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		if err := restoreRow(ctx, tx, "modifier group",
+			`INSERT INTO modifier_groups(id, product_id, name, min_selected, max_selected) VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (id) DO UPDATE
+			SET name = EXCLUDED.name, min_selected = EXCLUDED.min_selected, max_selected = EXCLUDED.max_selected`,
+			group.Id, productId, group.Name, group.MinSelected, group.MaxSelected,
+		); err != nil {
+			return err
+		}
+
+		optionIds := make([]uuid.UUID, 0, len(group.Options))
+		for _, option := range group.Options {
+			optionIds = append(optionIds, option.Id)
+		}
+
+		if err := deleteRows(ctx, tx, "modifier options",
+			`DELETE FROM modifier_options o
+			WHERE o.group_id = $1 AND NOT o.id = ANY($2::uuid[])
+			AND NOT EXISTS(SELECT 1 FROM ordered_product_options opo WHERE opo.option_id = o.id)`,
+			group.Id, pq.Array(uuidStrings(optionIds)),
+		); err != nil {
+			return err
+		}
+
+		for position, option := range group.Options {
+			if err := restoreRow(ctx, tx, "modifier option",
+				`INSERT INTO modifier_options(id, group_id, name, price_delta, position) VALUES ($1, $2, $3, $4, $5)
+				ON CONFLICT (id) DO UPDATE
+				SET name = EXCLUDED.name, price_delta = EXCLUDED.price_delta, position = EXCLUDED.position`,
+				option.Id, group.Id, option.Name, option.PriceDelta, position,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// restorePricingRules replaces all pricing rules with the ones of a version.
+func restorePricingRules(ctx context.Context, tx *sql.Tx, rules []domain.PricingRule) error {
+	ruleIds := make([]uuid.UUID, 0, len(rules))
+	for _, rule := range rules {
+		ruleIds = append(ruleIds, rule.Id)
+	}
+
+	if err := deleteRows(ctx, tx, "pricing rules",
+		"DELETE FROM pricing_rules WHERE NOT id = ANY($1::uuid[])",
+		pq.Array(uuidStrings(ruleIds)),
+	); err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		result, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO pricing_rules(id, name, product_id, category_id, kind, value)
+			SELECT $1::uuid, $2, $3::uuid, $4::uuid, $5::price_adjustment_kind, $6::decimal
+			WHERE EXISTS(SELECT 1 FROM products WHERE id = $3) OR EXISTS(SELECT 1 FROM product_categories WHERE id = $4)
+			ON CONFLICT (id) DO UPDATE
+			SET name = EXCLUDED.name,
+				product_id = EXCLUDED.product_id,
+				category_id = EXCLUDED.category_id,
+				kind = EXCLUDED.kind,
+				value = EXCLUDED.value`,
+			rule.Id,
+			rule.Name,
+			rule.ProductId,
+			rule.CategoryId,
+			rule.Kind,
+			rule.Value,
+		)
+		if err != nil {
+			zap.L().Error("error restoring pricing rule", zap.Error(err))
+			return domain.ErrInternal
+		}
+
+		rows, err := result.RowsAffected()
+		if err != nil {
+			zap.L().Error("error getting rows affected", zap.Error(err))
+			return domain.ErrInternal
+		}
+
+		// The product or category of the rule was deleted since.
+		if rows == 0 {
+			continue
+		}
+
+		if err = deleteRows(ctx, tx, "pricing rule windows",
+			"DELETE FROM pricing_rule_windows WHERE rule_id = $1",
+			rule.Id,
+		); err != nil {
+			return err
+		}
+		for _, window := range rule.Windows {
+			if err = restoreRow(ctx, tx, "pricing rule window",
+				"INSERT INTO pricing_rule_windows(rule_id, weekday, start_time, end_time) VALUES ($1, $2, $3, $4)",
+				rule.Id, int(window.Weekday), window.Start, window.End,
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteRows deletes the rows a version replaces.
+func deleteRows(ctx context.Context, tx *sql.Tx, description string, query string, args ...any) error {
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		zap.L().Error("error deleting "+description, zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// restoreRow writes a row of a version. A unique violation means that the live menu took a name of
+// the version since, which is reported as ErrMenuVersionConflict.
+func restoreRow(ctx context.Context, tx *sql.Tx, description string, query string, args ...any) error {
+	_, err := tx.ExecContext(ctx, query, args...)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return domain.ErrMenuVersionConflict
+	} else if err != nil {
+		zap.L().Error("error restoring "+description, zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// uuidStrings formats the ids for a uuid[] parameter.
+func uuidStrings(ids []uuid.UUID) []string {
+	strs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		strs = append(strs, id.String())
+	}
+	return strs
+}
@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"restaurant/internal/core/domain"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -24,8 +26,35 @@ func NewOrderRepository(db *sql.DB) *OrderRepository {
 	}
 }
 
-func (r *OrderRepository) GetSessions(ctx context.Context) ([]domain.OrderSession, error) {
-	rows, err := r.db.QueryContext(ctx, "SELECT id, table_number, status FROM order_sessions")
+// cursorArgs returns the nullable query parameters of a pagination cursor.
+func cursorArgs(cursor *domain.Cursor) (*time.Time, *uuid.UUID) {
+	if cursor == nil {
+		return nil, nil
+	}
+	return &cursor.CreatedAt, &cursor.Id
+}
+
+func (r *OrderRepository) GetSessions(ctx context.Context, dto *domain.GetSessionsDTO) ([]domain.OrderSession, error) {
+	cursorCreatedAt, cursorId := cursorArgs(dto.Cursor)
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, table_number, status, created_at
+		FROM order_sessions
+		WHERE ($1::order_session_status IS NULL OR status = $1)
+		AND ($2::int IS NULL OR table_number = $2)
+		AND ($3::timestamptz IS NULL OR created_at >= $3)
+		AND ($4::timestamptz IS NULL OR created_at < $4)
+		AND ($5::timestamptz IS NULL OR (created_at, id) < ($5, $6::uuid))
+		ORDER BY created_at DESC, id DESC
+		LIMIT $7`,
+		dto.Status,
+		dto.TableNumber,
+		dto.CreatedAfter,
+		dto.CreatedBefore,
+		cursorCreatedAt,
+		cursorId,
+		dto.Limit,
+	)
 	if err != nil {
 		zap.L().Error("error getting product", zap.Error(err))
 		return nil, domain.ErrInternal
@@ -40,7 +69,7 @@ func (r *OrderRepository) GetSessions(ctx context.Context) ([]domain.OrderSessio
 	var sessions []domain.OrderSession
 	for rows.Next() {
 		var session domain.OrderSession
-		if err = rows.Scan(&session.Id, &session.TableNumber, &session.Status); err != nil {
+		if err = rows.Scan(&session.Id, &session.TableNumber, &session.Status, &session.CreatedAt); err != nil {
 			zap.L().Error("error scanning row", zap.Error(err))
 			return nil, domain.ErrInternal
 		}
@@ -53,12 +82,12 @@ func (r *OrderRepository) GetSessions(ctx context.Context) ([]domain.OrderSessio
 func (r *OrderRepository) GetSessionByID(ctx context.Context, id uuid.UUID) (*domain.OrderSession, error) {
 	row := r.db.QueryRowContext(
 		ctx,
-		"SELECT id, table_number, status FROM order_sessions WHERE id = $1",
+		"SELECT id, table_number, status, created_at FROM order_sessions WHERE id = $1",
 		id,
 	)
 
 	var session domain.OrderSession
-	err := row.Scan(&session.Id, &session.TableNumber, &session.Status)
+	err := row.Scan(&session.Id, &session.TableNumber, &session.Status, &session.CreatedAt)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, domain.ErrOrderSessionNotFound
@@ -71,14 +100,15 @@ func (r *OrderRepository) GetSessionByID(ctx context.Context, id uuid.UUID) (*do
 }
 
 func (r *OrderRepository) AddSession(ctx context.Context, order *domain.OrderSession) error {
-	_, err := r.db.ExecContext(
+	err := r.db.QueryRowContext(
 		ctx,
 		`INSERT INTO order_sessions(id, table_number, status) 
-		VALUES ($1, $2, $3)`,
+		VALUES ($1, $2, $3)
+		RETURNING created_at`,
 		order.Id,
 		order.TableNumber,
 		order.Status,
-	)
+	).Scan(&order.CreatedAt)
 
 	if err != nil {
 		zap.L().Error("error inserting order", zap.Error(err))
@@ -95,14 +125,14 @@ func (r *OrderRepository) UpdateSession(ctx context.Context, session *domain.Upd
 		SET table_number = COALESCE($1, table_number),
     		status       = COALESCE($2, status)
 		WHERE id = $3
-		RETURNING id, table_number, status`,
+		RETURNING id, table_number, status, created_at`,
 		session.NewTableNumber,
 		session.NewStatus,
 		session.Id,
 	)
 
 	var orderSession domain.OrderSession
-	err := row.Scan(&orderSession.Id, &orderSession.TableNumber, &orderSession.Status)
+	err := row.Scan(&orderSession.Id, &orderSession.TableNumber, &orderSession.Status, &orderSession.CreatedAt)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, domain.ErrOrderSessionNotFound
 	} else if err != nil {
@@ -136,10 +166,49 @@ func (r *OrderRepository) DeleteSession(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
-func (r *OrderRepository) GetOrderedProducts(ctx context.Context) ([]domain.OrderedProduct, error) {
+func (r *OrderRepository) GetOrderedProducts(ctx context.Context, dto *domain.GetOrderedProductsDTO) ([]domain.OrderedProduct, error) {
+	cursorCreatedAt, cursorId := cursorArgs(dto.Cursor)
 	rows, err := r.db.QueryContext(
 		ctx,
-		"SELECT id, product_id, status, session_id FROM ordered_products",
+		`SELECT op.id,
+			op.product_id,
+			op.status,
+			op.session_id,
+			op.quantity,
+			op.note,
+			op.product_name,
+			op.unit_price,
+			op.pricing_rule_name,
+			op.ordered_bundle_id,
+			op.created_at,
+			COALESCE(
+				jsonb_agg(
+					jsonb_build_object('id', opo.option_id, 'groupId', mo.group_id, 'name', opo.name, 'priceDelta', opo.price_delta)
+					ORDER BY opo.option_id
+				) FILTER (WHERE opo.option_id IS NOT NULL),
+				'[]'::jsonb
+			) AS options
+		FROM ordered_products op
+		JOIN order_sessions s ON s.id = op.session_id
+		LEFT JOIN ordered_product_options opo ON opo.ordered_product_id = op.id
+		LEFT JOIN modifier_options mo ON mo.id = opo.option_id
+		WHERE ($1::ordered_product_status IS NULL OR op.status = $1)
+		AND ($2::uuid IS NULL OR op.session_id = $2)
+		AND ($3::int IS NULL OR s.table_number = $3)
+		AND ($4::timestamptz IS NULL OR op.created_at >= $4)
+		AND ($5::timestamptz IS NULL OR op.created_at < $5)
+		AND ($6::timestamptz IS NULL OR (op.created_at, op.id) < ($6, $7::uuid))
+		GROUP BY op.id
+		ORDER BY op.created_at DESC, op.id DESC
+		LIMIT $8`,
+		dto.Status,
+		dto.SessionId,
+		dto.TableNumber,
+		dto.CreatedAfter,
+		dto.CreatedBefore,
+		cursorCreatedAt,
+		cursorId,
+		dto.Limit,
 	)
 	if err != nil {
 		zap.L().Error("error getting products", zap.Error(err))
@@ -155,35 +224,165 @@ func (r *OrderRepository) GetOrderedProducts(ctx context.Context) ([]domain.Orde
 	var products []domain.OrderedProduct
 	for rows.Next() {
 		var product domain.OrderedProduct
-		if err = rows.Scan(&product.Id, &product.ProductId, &product.Status, &product.OrderSessionID); err != nil {
+		var options []byte
+		if err = rows.Scan(
+			&product.Id,
+			&product.ProductId,
+			&product.Status,
+			&product.OrderSessionID,
+			&product.Quantity,
+			&product.Note,
+			&product.ProductName,
+			&product.UnitPrice,
+			&product.PricingRuleName,
+			&product.OrderedBundleId,
+			&product.CreatedAt,
+			&options,
+		); err != nil {
 			zap.L().Error("error scanning row", zap.Error(err))
 			return nil, domain.ErrInternal
 		}
+
+		if err = json.Unmarshal(options, &product.Options); err != nil {
+			zap.L().Error("error decoding ordered product options", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
 		products = append(products, product)
 	}
 	return products, nil
 }
 
-func (r *OrderRepository) AddOrderedProduct(ctx context.Context, product *domain.OrderedProduct) error {
-	_, err := r.db.ExecContext(
+// insertOrderedProduct inserts an ordered product with its selected options using the provided transaction.
+func (r *OrderRepository) insertOrderedProduct(ctx context.Context, tx *sql.Tx, product *domain.OrderedProduct) error {
+	err := tx.QueryRowContext(
 		ctx,
-		`INSERT INTO ordered_products(id, product_id, session_id, status) VALUES ($1, $2, $3, $4)`,
+		`INSERT INTO ordered_products(id, product_id, session_id, status, quantity, note, product_name, unit_price, pricing_rule_name, ordered_bundle_id) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING created_at`,
 		product.Id,
 		product.ProductId,
 		product.OrderSessionID,
 		product.Status,
-	)
+		product.Quantity,
+		product.Note,
+		product.ProductName,
+		product.UnitPrice,
+		product.PricingRuleName,
+		product.OrderedBundleId,
+	).Scan(&product.CreatedAt)
 
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) {
 		if pqErr.Code == "23503" && pqErr.Constraint == "ordered_products_product_id_fkey" {
 			return domain.ErrProductNotFound
 		}
+		zap.L().Error("unexpected pq error", zap.Error(pqErr))
+		return domain.ErrInternal
 	} else if err != nil {
 		zap.L().Error("error inserting ordered product", zap.Error(err))
 		return domain.ErrInternal
 	}
 
+	for _, option := range product.Options {
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO ordered_product_options(ordered_product_id, option_id, name, price_delta) 
+			VALUES ($1, $2, $3, $4)`,
+			product.Id,
+			option.Id,
+			option.Name,
+			option.PriceDelta,
+		)
+
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+			return domain.ErrInvalidModifierSelection
+		} else if err != nil {
+			zap.L().Error("error inserting ordered product option", zap.Error(err))
+			return domain.ErrInternal
+		}
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) AddOrderedProduct(ctx context.Context, product *domain.OrderedProduct) error {
+	return r.AddOrderedProducts(ctx, []domain.OrderedProduct{*product})
+}
+
+func (r *OrderRepository) AddOrderedProducts(ctx context.Context, products []domain.OrderedProduct) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	for i := range products {
+		if err = r.insertOrderedProduct(ctx, tx, &products[i]); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *OrderRepository) AddOrderedBundle(ctx context.Context, bundle *domain.OrderedBundle) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	err = tx.QueryRowContext(
+		ctx,
+		`INSERT INTO ordered_bundles(id, bundle_id, session_id, name, price, quantity)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at`,
+		bundle.Id,
+		bundle.BundleId,
+		bundle.SessionId,
+		bundle.Name,
+		bundle.Price,
+		bundle.Quantity,
+	).Scan(&bundle.CreatedAt)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		if pqErr.Code == "23503" && pqErr.Constraint == "ordered_bundles_bundle_id_fkey" {
+			return domain.ErrBundleNotFound
+		}
+		zap.L().Error("unexpected pq error", zap.Error(pqErr))
+		return domain.ErrInternal
+	} else if err != nil {
+		zap.L().Error("error inserting ordered bundle", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	for i := range bundle.Components {
+		if err = r.insertOrderedProduct(ctx, tx, &bundle.Components[i]); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
 	return nil
 }
 
@@ -194,10 +393,16 @@ func (r *OrderRepository) DeletePendingOrderedProduct(ctx context.Context, order
 		return nil, domain.ErrInternal
 	}
 
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
 	row := tx.QueryRowContext(
 		ctx, `DELETE FROM ordered_products 
        	WHERE id = $1
-       	RETURNING id, product_id, session_id, status`,
+       	RETURNING id, product_id, session_id, status, quantity, note, product_name, unit_price, pricing_rule_name, ordered_bundle_id, created_at`,
 		orderedProductId,
 	)
 
@@ -207,6 +412,13 @@ func (r *OrderRepository) DeletePendingOrderedProduct(ctx context.Context, order
 		&orderedProduct.ProductId,
 		&orderedProduct.OrderSessionID,
 		&orderedProduct.Status,
+		&orderedProduct.Quantity,
+		&orderedProduct.Note,
+		&orderedProduct.ProductName,
+		&orderedProduct.UnitPrice,
+		&orderedProduct.PricingRuleName,
+		&orderedProduct.OrderedBundleId,
+		&orderedProduct.CreatedAt,
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -217,13 +429,13 @@ func (r *OrderRepository) DeletePendingOrderedProduct(ctx context.Context, order
 	}
 
 	if orderedProduct.Status != domain.Pending {
-		err = tx.Rollback()
-		if err != nil {
-			zap.L().Warn("error rolling back transaction", zap.Error(err))
-		}
 		return nil, domain.ErrOrderedProductNotPending
 	}
 
+	if orderedProduct.OrderedBundleId != nil {
+		return nil, domain.ErrOrderedProductInBundle
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		zap.L().Warn("error committing transaction", zap.Error(err))
@@ -233,19 +445,38 @@ func (r *OrderRepository) DeletePendingOrderedProduct(ctx context.Context, order
 }
 
 func (r *OrderRepository) DeleteOrderedProduct(ctx context.Context, orderedProductId uuid.UUID) (*domain.OrderedProduct, error) {
-	row := r.db.QueryRowContext(
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	row := tx.QueryRowContext(
 		ctx, `DELETE FROM ordered_products 
        	WHERE id = $1
-       	RETURNING id, product_id, session_id, status`,
+       	RETURNING id, product_id, session_id, status, quantity, note, product_name, unit_price, pricing_rule_name, ordered_bundle_id, created_at`,
 		orderedProductId,
 	)
 
 	var orderedProduct domain.OrderedProduct
-	err := row.Scan(
+	err = row.Scan(
 		&orderedProduct.Id,
 		&orderedProduct.ProductId,
 		&orderedProduct.OrderSessionID,
 		&orderedProduct.Status,
+		&orderedProduct.Quantity,
+		&orderedProduct.Note,
+		&orderedProduct.ProductName,
+		&orderedProduct.UnitPrice,
+		&orderedProduct.PricingRuleName,
+		&orderedProduct.OrderedBundleId,
+		&orderedProduct.CreatedAt,
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -255,57 +486,126 @@ func (r *OrderRepository) DeleteOrderedProduct(ctx context.Context, orderedProdu
 		return nil, domain.ErrInternal
 	}
 
+	if orderedProduct.OrderedBundleId != nil {
+		return nil, domain.ErrOrderedProductInBundle
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
 	return &orderedProduct, nil
 }
 
-func (r *OrderRepository) UpdateOrderedProductStatus(ctx context.Context, id uuid.UUID, status domain.OrderedProductStatus) (*domain.OrderedProduct, error) {
-	row := r.db.QueryRowContext(
+func (r *OrderRepository) UpdateOrderedProductStatus(ctx context.Context, id uuid.UUID, status domain.OrderedProductStatus) (*domain.OrderedProduct, []domain.AvailabilityChange, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return nil, nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	row := tx.QueryRowContext(
 		ctx,
 		`UPDATE ordered_products 
 		SET status = $1
 		WHERE id = $2
-		RETURNING id, product_id, session_id, status`,
+		RETURNING id, product_id, session_id, status, quantity, note, product_name, unit_price, pricing_rule_name, ordered_bundle_id, created_at`,
 		status,
 		id,
 	)
 
 	var orderedProduct domain.OrderedProduct
-	err := row.Scan(
+	err = row.Scan(
 		&orderedProduct.Id,
 		&orderedProduct.ProductId,
 		&orderedProduct.OrderSessionID,
 		&orderedProduct.Status,
+		&orderedProduct.Quantity,
+		&orderedProduct.Note,
+		&orderedProduct.ProductName,
+		&orderedProduct.UnitPrice,
+		&orderedProduct.PricingRuleName,
+		&orderedProduct.OrderedBundleId,
+		&orderedProduct.CreatedAt,
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
-		return nil, domain.ErrOrderedProductNotFound
+		return nil, nil, domain.ErrOrderedProductNotFound
 	} else if err != nil {
 		zap.L().Error("error scanning row", zap.Error(err))
-		return nil, domain.ErrInternal
+		return nil, nil, domain.ErrInternal
 	}
 
-	return &orderedProduct, nil
+	// The stock is deducted together with the status change, so a product is never
+	// preparing without its recipe being deducted.
+	var changes []domain.AvailabilityChange
+	if status == domain.Preparing {
+		if changes, err = deductStock(ctx, tx, id); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return nil, nil, domain.ErrInternal
+	}
+	return &orderedProduct, changes, nil
 }
 
 func (r *OrderRepository) GetBillFromSession(ctx context.Context, id uuid.UUID) (*domain.Bill, error) {
 	rows, err := r.db.QueryContext(
 		ctx,
-		`SELECT
+		`WITH lines AS (
+			SELECT op.id,
+				op.product_id,
+				op.product_name,
+				op.unit_price,
+				op.pricing_rule_name,
+				op.quantity,
+				COALESCE(SUM(opo.price_delta), 0) AS options_price,
+				COALESCE(
+					jsonb_agg(
+						jsonb_build_object('id', opo.option_id, 'groupId', mo.group_id, 'name', opo.name, 'priceDelta', opo.price_delta)
+						ORDER BY opo.option_id
+					) FILTER (WHERE opo.option_id IS NOT NULL),
+					'[]'::jsonb
+				) AS options
+			FROM ordered_products op
+			LEFT JOIN ordered_product_options opo ON opo.ordered_product_id = op.id
+			LEFT JOIN modifier_options mo ON mo.id = opo.option_id
+			WHERE op.session_id = $1 AND op.ordered_bundle_id IS NULL
+			GROUP BY op.id
+		)
+		SELECT
     		p.id AS product_id,
-    		p.name,
+    		l.product_name,
     		p.description, 
     		p.image_url,
     		p.delete_image_url,
     		p.category, 
-    		p.price,
-    		COUNT(op.id) as quantity,
-    		(COUNT(op.id) * p.price) AS total_price
-    	FROM ordered_products op
-    	JOIN products p ON op.product_id = p.id
-    	WHERE op.session_id = $1
-    	GROUP BY p.id`,
+    		l.unit_price,
+    		l.pricing_rule_name,
+    		l.options,
+    		SUM(l.quantity) as quantity,
+    		SUM((l.unit_price + l.options_price) * l.quantity) AS total_price
+    	FROM lines l
+    	JOIN products p ON l.product_id = p.id
+    	GROUP BY p.id, l.product_name, l.unit_price, l.pricing_rule_name, l.options`,
 		id,
 	)
+	if err != nil {
+		zap.L().Error("error getting bill from session", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
 	defer func() {
 		closeErr := rows.Close()
 		if closeErr != nil {
@@ -313,15 +613,11 @@ func (r *OrderRepository) GetBillFromSession(ctx context.Context, id uuid.UUID)
 		}
 	}()
 
-	if err != nil {
-		zap.L().Error("error getting bill from session", zap.Error(err))
-		return nil, domain.ErrInternal
-	}
-
 	var billItems []domain.BillItem
 	var totalPrice decimal.Decimal
 	for rows.Next() {
 		var billItem domain.BillItem
+		var options []byte
 		if err = rows.Scan(
 			&billItem.Product.Id,
 			&billItem.Product.Name,
@@ -330,6 +626,8 @@ func (r *OrderRepository) GetBillFromSession(ctx context.Context, id uuid.UUID)
 			&billItem.Product.DeleteImageUrl,
 			&billItem.Product.Category,
 			&billItem.Product.Price,
+			&billItem.PricingRuleName,
+			&options,
 			&billItem.Quantity,
 			&billItem.TotalPrice,
 		); err != nil {
@@ -337,11 +635,82 @@ func (r *OrderRepository) GetBillFromSession(ctx context.Context, id uuid.UUID)
 			return nil, domain.ErrInternal
 		}
 
+		if err = json.Unmarshal(options, &billItem.Options); err != nil {
+			zap.L().Error("error decoding bill item options", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+
 		billItems = append(billItems, billItem)
 		totalPrice = totalPrice.Add(billItem.TotalPrice)
 	}
 
-	return domain.NewBill(billItems, totalPrice), nil
+	bundles, err := r.getBillBundles(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, bundle := range bundles {
+		totalPrice = totalPrice.Add(bundle.TotalPrice)
+	}
+
+	return domain.NewBill(billItems, bundles, totalPrice), nil
+}
+
+// getBillBundles fetches the ordered bundles of a session priced for the bill.
+func (r *OrderRepository) getBillBundles(ctx context.Context, sessionId uuid.UUID) ([]domain.BillBundleItem, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT b.name,
+			b.price,
+			b.quantity,
+			COALESCE(
+				(SELECT jsonb_agg(op.product_name ORDER BY op.product_name) FROM ordered_products op WHERE op.ordered_bundle_id = b.id),
+				'[]'::jsonb
+			) AS components,
+			b.price * b.quantity + COALESCE((
+				SELECT SUM(opo.price_delta * op.quantity)
+				FROM ordered_products op
+				JOIN ordered_product_options opo ON opo.ordered_product_id = op.id
+				WHERE op.ordered_bundle_id = b.id
+			), 0) AS total_price
+		FROM ordered_bundles b
+		WHERE b.session_id = $1
+		ORDER BY b.created_at`,
+		sessionId,
+	)
+	if err != nil {
+		zap.L().Error("error getting bill bundles", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var bundles []domain.BillBundleItem
+	for rows.Next() {
+		var bundle domain.BillBundleItem
+		var components []byte
+		if err = rows.Scan(
+			&bundle.Name,
+			&bundle.Price,
+			&bundle.Quantity,
+			&components,
+			&bundle.TotalPrice,
+		); err != nil {
+			zap.L().Error("error scanning row", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+
+		if err = json.Unmarshal(components, &bundle.Components); err != nil {
+			zap.L().Error("error decoding bill bundle components", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		bundles = append(bundles, bundle)
+	}
+
+	return bundles, nil
 }
 
 func (r *OrderRepository) HasIncompletedOrderedProducts(ctx context.Context, id uuid.UUID) (bool, error) {
@@ -362,26 +731,36 @@ func (r *OrderRepository) HasIncompletedOrderedProducts(ctx context.Context, id
 	return exists, nil
 }
 
-func (r *OrderRepository) DeleteOrderedProductsBySessionId(ctx context.Context, sessionId uuid.UUID) error {
-	result, err := r.db.ExecContext(
+func (r *OrderRepository) MoveOrderedProductsToHistory(ctx context.Context, sessionId uuid.UUID) error {
+	// A single statement copies the products of the session and deletes its orders at once.
+	// Every product counts once per session, bundle components are left out like in the recommendations.
+	var deleted int
+	err := r.db.QueryRowContext(
 		ctx,
-		`DELETE FROM ordered_products 
-       	WHERE session_id = $1`,
+		`WITH deleted_bundles AS (
+			DELETE FROM ordered_bundles WHERE session_id = $1
+		), deleted AS (
+			DELETE FROM ordered_products
+			WHERE session_id = $1
+			RETURNING product_id, ordered_bundle_id, created_at
+		), history AS (
+			INSERT INTO paid_session_products(session_id, product_id, ordered_at)
+			SELECT $1, product_id, min(created_at)
+			FROM deleted
+			WHERE ordered_bundle_id IS NULL
+			GROUP BY product_id
+			ON CONFLICT (session_id, product_id) DO NOTHING
+		)
+		SELECT count(*) FROM deleted`,
 		sessionId,
-	)
-
-	if err != nil {
-		zap.L().Error("error deleting ordered products", zap.Error(err))
-		return domain.ErrInternal
-	}
+	).Scan(&deleted)
 
-	rows, err := result.RowsAffected()
 	if err != nil {
-		zap.L().Error("error getting affected rows", zap.Error(err))
+		zap.L().Error("error moving ordered products to history", zap.Error(err))
 		return domain.ErrInternal
 	}
 
-	if rows == 0 {
+	if deleted == 0 {
 		return domain.ErrOrderSessionNotFound
 	}
 	return nil
@@ -3,9 +3,14 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -97,10 +102,23 @@ func (r *ProductRepository) DeleteCategory(ctx context.Context, id uuid.UUID) er
 	return nil
 }
 
-func (r *ProductRepository) GetProductCategories(ctx context.Context) ([]domain.ProductCategory, error) {
-	rows, err := r.db.QueryContext(ctx, `SELECT id, name FROM product_categories`)
+func (r *ProductRepository) GetProductCategories(ctx context.Context, locales []string) ([]domain.ProductCategory, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT c.id, COALESCE(t.name, c.name)
+		FROM product_categories c
+		LEFT JOIN LATERAL (
+			SELECT name FROM category_translations
+			WHERE category_id = c.id AND locale = ANY($1::text[])
+			ORDER BY array_position($1::text[], locale::text)
+			LIMIT 1
+		) t ON TRUE
+		WHERE c.archived_at IS NULL`,
+		pq.Array(locales),
+	)
 	if err != nil {
 		zap.L().Error("error getting product categories", zap.Error(err))
+		return nil, domain.ErrInternal
 	}
 
 	defer func() {
@@ -124,6 +142,61 @@ func (r *ProductRepository) GetProductCategories(ctx context.Context) ([]domain.
 	return products, nil
 }
 
+func (r *ProductRepository) GetArchivedCategories(ctx context.Context) ([]domain.ProductCategory, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, name, archived_at FROM product_categories WHERE archived_at IS NOT NULL`,
+	)
+	if err != nil {
+		zap.L().Error("error getting archived product categories", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var categories []domain.ProductCategory
+	for rows.Next() {
+		var category domain.ProductCategory
+		if err = rows.Scan(&category.Id, &category.Name, &category.ArchivedAt); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
+
+func (r *ProductRepository) SetCategoryArchived(ctx context.Context, id uuid.UUID, archived bool) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE product_categories
+		SET archived_at = CASE WHEN $1 THEN COALESCE(archived_at, now()) END
+		WHERE id = $2`,
+		archived,
+		id,
+	)
+	if err != nil {
+		zap.L().Error("error archiving category", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrProductCategoryNotFound
+	}
+	return nil
+}
+
 var addProductPqErrorMap = map[string]map[string]error{
 	"23505": {
 		"products_name_key": domain.ErrProductNameAlreadyInUse,
@@ -133,19 +206,51 @@ var addProductPqErrorMap = map[string]map[string]error{
 	},
 }
 
+// nutritionColumns are the nutrition columns of products in the order of nutritionFields.
+const nutritionColumns = "calories, protein, fat, carbohydrates, sugar, salt, portion_size"
+
+// nutritionFields returns scan destinations for the fields of the nutrition in the order of nutritionColumns.
+func nutritionFields(nutrition *domain.Nutrition) []any {
+	return []any{
+		&nutrition.Calories,
+		&nutrition.Protein,
+		&nutrition.Fat,
+		&nutrition.Carbohydrates,
+		&nutrition.Sugar,
+		&nutrition.Salt,
+		&nutrition.PortionSize,
+	}
+}
+
+// nutritionValues returns the values of the nutrition in the order of nutritionColumns.
+func nutritionValues(nutrition *domain.Nutrition) []any {
+	return []any{
+		nutrition.Calories,
+		nutrition.Protein,
+		nutrition.Fat,
+		nutrition.Carbohydrates,
+		nutrition.Sugar,
+		nutrition.Salt,
+		nutrition.PortionSize,
+	}
+}
+
 func (r *ProductRepository) AddProduct(ctx context.Context, product *domain.Product) error {
 	_, err := r.db.ExecContext(
 		ctx,
 		`INSERT INTO 
-    	products(id, name, description, image_url, delete_image_url ,category, price)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
-		product.Id,
-		product.Name,
-		product.Description,
-		product.ImageUrl,
-		product.DeleteImageUrl,
-		product.Category,
-		product.Price,
+    	products(id, name, description, image_url, delete_image_url ,category, price, available, `+nutritionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+		append([]any{
+			product.Id,
+			product.Name,
+			product.Description,
+			product.ImageUrl,
+			product.DeleteImageUrl,
+			product.Category,
+			product.Price,
+			product.Available,
+		}, nutritionValues(&product.Nutrition)...)...,
 	)
 
 	var pqErr *pq.Error
@@ -184,19 +289,35 @@ var updateProductPqErrorMap = map[string]map[string]error{
 }
 
 func (r *ProductRepository) UpdateProduct(ctx context.Context, dto *domain.UpdateProductDTO) error {
+	// The nutrition facts are replaced together when they are provided, so unknown facts can be cleared.
+	nutrition := dto.Nutrition
+	if nutrition == nil {
+		nutrition = &domain.Nutrition{}
+	}
+
 	result, err := r.db.ExecContext(
 		ctx,
 		`UPDATE products
 			SET name = COALESCE($1, name),
 			description = COALESCE($2, description),
 			category = COALESCE($3, category),
-			price = COALESCE($4, price)
+			price = COALESCE($4, price),
+			calories = CASE WHEN $6 THEN $7 ELSE calories END,
+			protein = CASE WHEN $6 THEN $8 ELSE protein END,
+			fat = CASE WHEN $6 THEN $9 ELSE fat END,
+			carbohydrates = CASE WHEN $6 THEN $10 ELSE carbohydrates END,
+			sugar = CASE WHEN $6 THEN $11 ELSE sugar END,
+			salt = CASE WHEN $6 THEN $12 ELSE salt END,
+			portion_size = CASE WHEN $6 THEN $13 ELSE portion_size END
 			WHERE id = $5`,
-		dto.Name,
-		dto.Description,
-		dto.Category,
-		dto.Price,
-		dto.Id,
+		append([]any{
+			dto.Name,
+			dto.Description,
+			dto.Category,
+			dto.Price,
+			dto.Id,
+			dto.Nutrition != nil,
+		}, nutritionValues(nutrition)...)...,
 	)
 
 	var pqErr *pq.Error
@@ -228,54 +349,61 @@ func (r *ProductRepository) UpdateProduct(ctx context.Context, dto *domain.Updat
 	return nil
 }
 
-func (r *ProductRepository) UpdateProductImage(ctx context.Context, productId uuid.UUID, image *domain.Image) error {
-	result, err := r.db.ExecContext(
-		ctx,
-		`UPDATE products
-		SET image_url = $1,
-		delete_image_url = $2
-		WHERE id = $3`,
-		image.Url,
-		image.DeleteUrl,
-		productId,
-	)
-
-	if err != nil {
-		zap.L().Error("error updating product image", zap.Error(err))
+// unmarshalImageVariants decodes the image_variants column into the product. It is NULL
+// for products without an image and for images uploaded before variants existed.
+func unmarshalImageVariants(data []byte, product *domain.Product) error {
+	if data == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, &product.ImageVariants); err != nil {
+		zap.L().Error("error unmarshalling image variants", zap.Error(err))
 		return domain.ErrInternal
 	}
+	return nil
+}
 
-	rows, err := result.RowsAffected()
+func (r *ProductRepository) DeleteProductById(ctx context.Context, id uuid.UUID) (*domain.Product, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		zap.L().Error("error getting rows affected", zap.Error(err))
-		return domain.ErrInternal
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return nil, domain.ErrInternal
 	}
 
-	if rows == 0 {
-		return domain.ErrProductNotFound
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	// The gallery is read before the product, because deleting the product deletes it too.
+	galleries, err := getProductGalleries(ctx, tx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-func (r *ProductRepository) DeleteProductById(ctx context.Context, id uuid.UUID) (*domain.Product, error) {
 	var imageUrl sql.NullString
 	var deleteImageUrl sql.NullString
+	var imageVariants []byte
 
-	row := r.db.
+	row := tx.
 		QueryRowContext(
 			ctx,
 			`DELETE FROM products 
        		WHERE id = $1 
-       		RETURNING id, name, description, image_url, delete_image_url, category, price`,
+       		RETURNING id, name, description, image_url, delete_image_url, image_variants, category, price`,
 			id,
 		)
 
 	var product domain.Product
-	err := row.Scan(&product.Id, &product.Name, &product.Description, &imageUrl, &deleteImageUrl, &product.Category, &product.Price)
+	err = row.Scan(&product.Id, &product.Name, &product.Description, &imageUrl, &deleteImageUrl, &imageVariants, &product.Category, &product.Price)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, domain.ErrProductNotFound
 	}
-	if err != nil {
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return nil, domain.ErrProductHasOrderHistory
+	} else if err != nil {
 		zap.L().Error("error deleting product", zap.Error(err))
 		return nil, domain.ErrInternal
 	}
@@ -292,20 +420,58 @@ func (r *ProductRepository) DeleteProductById(ctx context.Context, id uuid.UUID)
 		product.DeleteImageUrl = nil
 	}
 
+	if err = unmarshalImageVariants(imageVariants, &product); err != nil {
+		return nil, err
+	}
+
+	product.Gallery = galleries[id]
+	if err = scheduleImageDeletions(ctx, tx, product.StoredImages()); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
 	return &product, nil
 }
 
 func (r *ProductRepository) DeleteProductsByCategory(ctx context.Context, categoryId uuid.UUID) ([]domain.Product, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
 
-	rows, err := r.db.QueryContext(
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	// The galleries are read before the products, because deleting the products deletes them too.
+	galleries, err := getProductImages(
+		ctx,
+		tx,
+		"i.product_id IN (SELECT id FROM products WHERE category = $1)",
+		categoryId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(
 		ctx,
 		`DELETE FROM products 
        	WHERE category = $1 
-       	RETURNING id, name, description, image_url, delete_image_url, category, price`,
+       	RETURNING id, name, description, image_url, delete_image_url, image_variants, category, price`,
 		categoryId,
 	)
 
-	if err != nil {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return nil, domain.ErrProductHasOrderHistory
+	} else if err != nil {
 		zap.L().Error("error deleting products", zap.Error(err))
 		return nil, domain.ErrInternal
 	}
@@ -321,8 +487,9 @@ func (r *ProductRepository) DeleteProductsByCategory(ctx context.Context, catego
 		var product domain.Product
 		var imageUrl sql.NullString
 		var deleteImageUrl sql.NullString
+		var imageVariants []byte
 
-		err = rows.Scan(&product.Id, &product.Name, &product.Description, &imageUrl, &deleteImageUrl, &product.Category, &product.Price)
+		err = rows.Scan(&product.Id, &product.Name, &product.Description, &imageUrl, &deleteImageUrl, &imageVariants, &product.Category, &product.Price)
 		if err != nil {
 			zap.L().Error("error scanning rows", zap.Error(err))
 			return nil, domain.ErrInternal
@@ -340,32 +507,73 @@ func (r *ProductRepository) DeleteProductsByCategory(ctx context.Context, catego
 			product.DeleteImageUrl = nil
 		}
 
+		if err = unmarshalImageVariants(imageVariants, &product); err != nil {
+			return nil, err
+		}
+		product.Gallery = galleries[product.Id]
 		products = append(products, product)
 	}
+
+	if errors.As(rows.Err(), &pqErr) && pqErr.Code == "23503" {
+		return nil, domain.ErrProductHasOrderHistory
+	} else if rows.Err() != nil {
+		zap.L().Error("error deleting products", zap.Error(rows.Err()))
+		return nil, domain.ErrInternal
+	}
+
+	for _, product := range products {
+		if err = scheduleImageDeletions(ctx, tx, product.StoredImages()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
 	return products, nil
 }
 
 func (r *ProductRepository) GetProductById(ctx context.Context, id uuid.UUID) (*domain.Product, error) {
 	row := r.db.QueryRowContext(
 		ctx,
-		`SELECT name, description, image_url, delete_image_url, category, price
-		FROM products
-		WHERE id = $1`,
+		`SELECT p.name, 
+       		p.description, 
+       		p.image_url, 
+       		p.delete_image_url, 
+       		p.image_variants,
+       		p.category, 
+       		p.price, 
+       		p.available,
+       		p.archived_at,
+       		c.archived_at,
+       		p.calories, p.protein, p.fat, p.carbohydrates, p.sugar, p.salt, p.portion_size
+		FROM products p
+		JOIN product_categories c ON c.id = p.category
+		WHERE p.id = $1`,
 		id,
 	)
 
 	var product domain.Product
 	var imageUrl sql.NullString
 	var deleteImageUrl sql.NullString
+	var imageVariants []byte
 
-	err := row.Scan(
-		&product.Name,
-		&product.Description,
-		&imageUrl,
-		&deleteImageUrl,
-		&product.Category,
-		&product.Price,
-	)
+	err := row.Scan(append(
+		[]any{
+			&product.Name,
+			&product.Description,
+			&imageUrl,
+			&deleteImageUrl,
+			&imageVariants,
+			&product.Category,
+			&product.Price,
+			&product.Available,
+			&product.ArchivedAt,
+			&product.CategoryArchivedAt,
+		},
+		nutritionFields(&product.Nutrition)...,
+	)...)
 
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, domain.ErrProductNotFound
@@ -374,8 +582,8 @@ func (r *ProductRepository) GetProductById(ctx context.Context, id uuid.UUID) (*
 		return nil, domain.ErrInternal
 	}
 
-	if deleteImageUrl.Valid {
-		product.ImageUrl = &deleteImageUrl.String
+	if imageUrl.Valid {
+		product.ImageUrl = &imageUrl.String
 	} else {
 		product.ImageUrl = nil
 	}
@@ -386,15 +594,172 @@ func (r *ProductRepository) GetProductById(ctx context.Context, id uuid.UUID) (*
 		product.DeleteImageUrl = nil
 	}
 
+	if err = unmarshalImageVariants(imageVariants, &product); err != nil {
+		return nil, err
+	}
+
 	product.Id = id
+	groups, err := getModifierGroups(ctx, r.db, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	product.ModifierGroups = groups[id]
+
+	tags, err := r.getTags(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	product.Tags = tags[id]
+
+	galleries, err := getProductGalleries(ctx, r.db, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	product.Gallery = galleries[id]
+
+	if product.Schedule, err = r.GetProductSchedule(ctx, id); err != nil {
+		return nil, err
+	}
+	if product.CategorySchedule, err = r.GetCategorySchedule(ctx, product.Category); err != nil {
+		return nil, err
+	}
+
+	rules, err := r.getPricingRules(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	product.PricingRules = rules[id]
+
 	return &product, nil
 }
 
-func (r *ProductRepository) GetProducts(ctx context.Context) ([]domain.Product, error) {
+// productsFilter selects the products listed by GetProducts and CountProducts with the parameters of productsFilterArgs.
+const productsFilter = `FROM products p
+		JOIN product_categories c ON c.id = p.category
+		LEFT JOIN LATERAL (
+			SELECT name, description, search_vector FROM product_translations
+			WHERE product_id = p.id AND locale = ANY($4::text[])
+			ORDER BY array_position($4::text[], locale::text)
+			LIMIT 1
+		) t ON TRUE
+		WHERE p.archived_at IS NULL AND c.archived_at IS NULL
+		AND ($1::uuid IS NULL OR p.category = $1)
+		AND (
+			SELECT COUNT(*) FROM product_tags pt
+			JOIN tags t ON t.id = pt.tag_id
+			WHERE pt.product_id = p.id AND t.name = ANY($2::text[])
+		) = COALESCE(cardinality($2::text[]), 0)
+		AND NOT EXISTS(
+			SELECT 1 FROM product_tags pt
+			JOIN tags t ON t.id = pt.tag_id
+			WHERE pt.product_id = p.id AND t.name = ANY($3::text[])
+		)
+		AND (
+			NOT EXISTS(SELECT 1 FROM product_schedule_windows w WHERE w.product_id = p.id)
+			OR EXISTS(
+				SELECT 1 FROM product_schedule_windows w
+				WHERE w.product_id = p.id AND (` + scheduleWindowContains + `)
+			)
+		)
+		AND (
+			NOT EXISTS(SELECT 1 FROM category_schedule_windows w WHERE w.category_id = c.id)
+			OR EXISTS(
+				SELECT 1 FROM category_schedule_windows w
+				WHERE w.category_id = c.id AND (` + scheduleWindowContains + `)
+			)
+		)
+		AND ($7::text = '' OR p.search_vector @@ to_tsquery('simple', $7) OR t.search_vector @@ to_tsquery('simple', $7))
+		AND ($8::int IS NULL OR p.calories <= $8)
+		AND ($9::uuid[] IS NULL OR p.id = ANY($9::uuid[]))`
+
+// productsFilterArgs returns the query parameters of productsFilter.
+func productsFilterArgs(dto *domain.GetProductsDTO) []any {
+	var ids []string
+	if dto.Ids != nil {
+		ids = uuidStrings(dto.Ids)
+	}
+
+	return []any{
+		dto.CategoryId,
+		pq.Array(dto.IncludeTags),
+		pq.Array(dto.ExcludeTags),
+		pq.Array(dto.Locales),
+		int(dto.At.Weekday()),
+		dto.At.Format(domain.TimeOfDayLayout),
+		prefixTsQuery(dto.Search),
+		dto.MaxCalories,
+		pq.Array(ids),
+	}
+}
+
+// prefixTsQuery turns a search phrase into a tsquery matching products containing words
+// starting with every word of the phrase. Everything but letters and digits is dropped,
+// so the result is always a valid tsquery.
+func prefixTsQuery(search string) string {
+	words := strings.FieldsFunc(strings.ToLower(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, word+":*")
+	}
+	return strings.Join(terms, " & ")
+}
+
+// productsOrderBy maps the product sorts to ORDER BY expressions of productsFilter.
+var productsOrderBy = map[domain.ProductSort][]string{
+	domain.SortByName:      {"COALESCE(t.name, p.name)"},
+	domain.SortByPrice:     {"p.price"},
+	domain.SortByCategory:  {"c.name", "COALESCE(t.name, p.name)"},
+	domain.SortByRelevance: {"GREATEST(ts_rank(p.search_vector, to_tsquery('simple', $7)), COALESCE(ts_rank(t.search_vector, to_tsquery('simple', $7)), 0))"},
+}
+
+// productsOrderClause builds the ORDER BY clause of GetProducts. Relevance is ranked from the best match,
+// the products id makes the order stable between pages.
+func productsOrderClause(dto *domain.GetProductsDTO) string {
+	descending := dto.Descending
+	if dto.Sort == domain.SortByRelevance {
+		descending = !descending
+	}
+
+	direction := " ASC"
+	if descending {
+		direction = " DESC"
+	}
+
+	var columns []string
+	for _, expression := range productsOrderBy[dto.Sort] {
+		columns = append(columns, expression+direction)
+	}
+	return "ORDER BY " + strings.Join(append(columns, "p.id"), ", ")
+}
+
+// productsLimit returns the LIMIT parameter of GetProducts, NULL lists all products.
+func productsLimit(limit int) *int {
+	if limit == 0 {
+		return nil
+	}
+	return &limit
+}
+
+func (r *ProductRepository) GetProducts(ctx context.Context, dto *domain.GetProductsDTO) ([]domain.Product, error) {
 	rows, err := r.db.QueryContext(
 		ctx,
-		`SELECT id, name, description, image_url, delete_image_url, category, price
-		FROM products`,
+		`SELECT p.id,
+			COALESCE(t.name, p.name),
+			COALESCE(t.description, p.description),
+			p.image_url,
+			p.delete_image_url,
+			p.image_variants,
+			p.category,
+			p.price,
+			p.available,
+			p.calories, p.protein, p.fat, p.carbohydrates, p.sugar, p.salt, p.portion_size
+		`+productsFilter+`
+		`+productsOrderClause(dto)+`
+		LIMIT $10 OFFSET $11`,
+		append(productsFilterArgs(dto), productsLimit(dto.Limit), dto.Offset)...,
 	)
 	if err != nil {
 		zap.L().Error("error getting products", zap.Error(err))
@@ -413,16 +778,22 @@ func (r *ProductRepository) GetProducts(ctx context.Context) ([]domain.Product,
 		var product domain.Product
 		var imageUrl sql.NullString
 		var deleteImageUrl sql.NullString
-
-		err = rows.Scan(
-			&product.Id,
-			&product.Name,
-			&product.Description,
-			&imageUrl,
-			&deleteImageUrl,
-			&product.Category,
-			&product.Price,
-		)
+		var imageVariants []byte
+
+		err = rows.Scan(append(
+			[]any{
+				&product.Id,
+				&product.Name,
+				&product.Description,
+				&imageUrl,
+				&deleteImageUrl,
+				&imageVariants,
+				&product.Category,
+				&product.Price,
+				&product.Available,
+			},
+			nutritionFields(&product.Nutrition)...,
+		)...)
 		if err != nil {
 			zap.L().Error("error scanning rows", zap.Error(err))
 			return nil, domain.ErrInternal
@@ -439,21 +810,78 @@ func (r *ProductRepository) GetProducts(ctx context.Context) ([]domain.Product,
 		} else {
 			product.DeleteImageUrl = nil
 		}
+
+		if err = unmarshalImageVariants(imageVariants, &product); err != nil {
+			return nil, err
+		}
 		products = append(products, product)
 	}
+
+	if err = r.attachModifierGroups(ctx, products); err != nil {
+		return nil, err
+	}
+	if err = r.attachTags(ctx, products); err != nil {
+		return nil, err
+	}
+	if err = r.attachGalleries(ctx, products); err != nil {
+		return nil, err
+	}
+	if err = r.attachPricingRules(ctx, products); err != nil {
+		return nil, err
+	}
 	return products, nil
 }
 
-func (r *ProductRepository) GetProductsByCategory(ctx context.Context, categoryId uuid.UUID) ([]domain.Product, error) {
+func (r *ProductRepository) CountProducts(ctx context.Context, dto *domain.GetProductsDTO) (int, error) {
+	var total int
+	if err := r.db.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*) `+productsFilter,
+		productsFilterArgs(dto)...,
+	).Scan(&total); err != nil {
+		zap.L().Error("error counting products", zap.Error(err))
+		return 0, domain.ErrInternal
+	}
+	return total, nil
+}
+
+func (r *ProductRepository) SetProductAvailability(ctx context.Context, id uuid.UUID, available bool) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE products
+		SET available = $1, out_of_stock = FALSE
+		WHERE id = $2`,
+		available,
+		id,
+	)
+	if err != nil {
+		zap.L().Error("error updating product availability", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrProductNotFound
+	}
+	return nil
+}
+
+func (r *ProductRepository) GetArchivedProducts(ctx context.Context) ([]domain.Product, error) {
 	rows, err := r.db.QueryContext(
 		ctx,
-		`SELECT id, name, description, image_url, delete_image_url, price 
-		FROM products
-		WHERE category = $1`,
-		categoryId,
+		`SELECT p.id, p.name, p.description, p.image_url, p.delete_image_url, p.image_variants, p.category, p.price, p.available,
+			p.archived_at, c.archived_at, `+nutritionColumns+`
+		FROM products p
+		JOIN product_categories c ON c.id = p.category
+		WHERE p.archived_at IS NOT NULL OR c.archived_at IS NOT NULL`,
 	)
 	if err != nil {
-		zap.L().Error("error getting products", zap.Error(err))
+		zap.L().Error("error getting archived products", zap.Error(err))
 		return nil, domain.ErrInternal
 	}
 
@@ -466,35 +894,1433 @@ func (r *ProductRepository) GetProductsByCategory(ctx context.Context, categoryI
 	var products []domain.Product
 	for rows.Next() {
 		var product domain.Product
-		var imageUrl sql.NullString
-		var deleteImageUrl sql.NullString
-
-		err = rows.Scan(
-			&product.Id,
-			&product.Name,
-			&product.Description,
-			&imageUrl,
-			&deleteImageUrl,
-			&product.Price,
-		)
-		if err != nil {
+		var imageVariants []byte
+		if err = rows.Scan(append(
+			[]any{
+				&product.Id,
+				&product.Name,
+				&product.Description,
+				&product.ImageUrl,
+				&product.DeleteImageUrl,
+				&imageVariants,
+				&product.Category,
+				&product.Price,
+				&product.Available,
+				&product.ArchivedAt,
+				&product.CategoryArchivedAt,
+			},
+			nutritionFields(&product.Nutrition)...,
+		)...); err != nil {
 			zap.L().Error("error scanning rows", zap.Error(err))
 			return nil, domain.ErrInternal
 		}
-
-		product.Category = categoryId
-		if imageUrl.Valid {
-			product.ImageUrl = &imageUrl.String
-		} else {
-			product.ImageUrl = nil
-		}
-
-		if deleteImageUrl.Valid {
-			product.DeleteImageUrl = &deleteImageUrl.String
-		} else {
-			product.DeleteImageUrl = nil
+		if err = unmarshalImageVariants(imageVariants, &product); err != nil {
+			return nil, err
 		}
 		products = append(products, product)
 	}
+
+	if err = r.attachModifierGroups(ctx, products); err != nil {
+		return nil, err
+	}
+	if err = r.attachTags(ctx, products); err != nil {
+		return nil, err
+	}
+	if err = r.attachGalleries(ctx, products); err != nil {
+		return nil, err
+	}
+	if err = r.attachPricingRules(ctx, products); err != nil {
+		return nil, err
+	}
 	return products, nil
 }
+
+func (r *ProductRepository) SetProductArchived(ctx context.Context, id uuid.UUID, archived bool) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE products
+		SET archived_at = CASE WHEN $1 THEN COALESCE(archived_at, now()) END
+		WHERE id = $2`,
+		archived,
+		id,
+	)
+	if err != nil {
+		zap.L().Error("error archiving product", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrProductNotFound
+	}
+	return nil
+}
+
+// getModifierGroups fetches the modifier groups with their options for the specified products,
+// grouped by product id.
+func getModifierGroups(ctx context.Context, q queryer, productIds []uuid.UUID) (map[uuid.UUID][]domain.ModifierGroup, error) {
+	ids := make([]string, 0, len(productIds))
+	for _, id := range productIds {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT g.id, g.product_id, g.name, g.min_selected, g.max_selected, o.id, o.name, o.price_delta
+		FROM modifier_groups g
+		JOIN modifier_options o ON o.group_id = g.id
+		WHERE g.product_id = ANY($1::uuid[])
+		ORDER BY g.name, o.position`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		zap.L().Error("error getting modifier groups", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	groups := make(map[uuid.UUID][]domain.ModifierGroup)
+	for rows.Next() {
+		var group domain.ModifierGroup
+		var option domain.ModifierOption
+		if err = rows.Scan(
+			&group.Id,
+			&group.ProductId,
+			&group.Name,
+			&group.MinSelected,
+			&group.MaxSelected,
+			&option.Id,
+			&option.Name,
+			&option.PriceDelta,
+		); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		option.GroupId = group.Id
+
+		productGroups := groups[group.ProductId]
+		if last := len(productGroups) - 1; last >= 0 && productGroups[last].Id == group.Id {
+			productGroups[last].Options = append(productGroups[last].Options, option)
+		} else {
+			group.Options = []domain.ModifierOption{option}
+			productGroups = append(productGroups, group)
+		}
+		groups[group.ProductId] = productGroups
+	}
+
+	return groups, nil
+}
+
+// attachModifierGroups loads and sets the modifier groups of the provided products.
+func (r *ProductRepository) attachModifierGroups(ctx context.Context, products []domain.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	productIds := make([]uuid.UUID, 0, len(products))
+	for _, product := range products {
+		productIds = append(productIds, product.Id)
+	}
+
+	groups, err := getModifierGroups(ctx, r.db, productIds)
+	if err != nil {
+		return err
+	}
+
+	for i := range products {
+		products[i].ModifierGroups = groups[products[i].Id]
+	}
+	return nil
+}
+
+var addModifierGroupPqErrorMap = map[string]map[string]error{
+	"23505": {
+		"modifier_groups_product_id_name_key": domain.ErrModifierGroupNameAlreadyInUse,
+	},
+	"23503": {
+		"modifier_groups_product_id_fkey": domain.ErrProductNotFound,
+	},
+}
+
+func (r *ProductRepository) AddModifierGroup(ctx context.Context, group *domain.ModifierGroup) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO modifier_groups(id, product_id, name, min_selected, max_selected)
+		VALUES ($1, $2, $3, $4, $5)`,
+		group.Id,
+		group.ProductId,
+		group.Name,
+		group.MinSelected,
+		group.MaxSelected,
+	)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		if mappedCode, ok := addModifierGroupPqErrorMap[string(pqErr.Code)]; ok {
+			if mappedConstraint, ok := mappedCode[pqErr.Constraint]; ok {
+				return mappedConstraint
+			}
+		}
+
+		zap.L().Error("unexpected pq error", zap.Error(pqErr))
+		return domain.ErrInternal
+	} else if err != nil {
+		zap.L().Error("error adding modifier group", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	for position, option := range group.Options {
+		if _, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO modifier_options(id, group_id, name, price_delta, position)
+			VALUES ($1, $2, $3, $4, $5)`,
+			option.Id,
+			option.GroupId,
+			option.Name,
+			option.PriceDelta,
+			position,
+		); err != nil {
+			zap.L().Error("error adding modifier option", zap.Error(err))
+			return domain.ErrInternal
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ProductRepository) DeleteModifierGroup(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM modifier_groups WHERE id = $1", id)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return domain.ErrModifierGroupInUse
+	} else if err != nil {
+		zap.L().Error("error deleting modifier group", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrModifierGroupNotFound
+	}
+	return nil
+}
+
+func (r *ProductRepository) getTags(ctx context.Context, productIds []uuid.UUID) (map[uuid.UUID][]domain.Tag, error) {
+	ids := make([]string, 0, len(productIds))
+	for _, id := range productIds {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT pt.product_id, t.id, t.name, t.kind
+		FROM product_tags pt
+		JOIN tags t ON t.id = pt.tag_id
+		WHERE pt.product_id = ANY($1::uuid[])
+		ORDER BY t.kind, t.name`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		zap.L().Error("error getting product tags", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	tags := make(map[uuid.UUID][]domain.Tag)
+	for rows.Next() {
+		var productId uuid.UUID
+		var tag domain.Tag
+		if err = rows.Scan(&productId, &tag.Id, &tag.Name, &tag.Kind); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		tags[productId] = append(tags[productId], tag)
+	}
+
+	return tags, nil
+}
+
+// attachTags loads and sets the tags of the provided products.
+func (r *ProductRepository) attachTags(ctx context.Context, products []domain.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	productIds := make([]uuid.UUID, 0, len(products))
+	for _, product := range products {
+		productIds = append(productIds, product.Id)
+	}
+
+	tags, err := r.getTags(ctx, productIds)
+	if err != nil {
+		return err
+	}
+
+	for i := range products {
+		products[i].Tags = tags[products[i].Id]
+	}
+	return nil
+}
+
+func (r *ProductRepository) GetTags(ctx context.Context) ([]domain.Tag, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT id, name, kind FROM tags ORDER BY kind, name")
+	if err != nil {
+		zap.L().Error("error getting tags", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var tags []domain.Tag
+	for rows.Next() {
+		var tag domain.Tag
+		if err = rows.Scan(&tag.Id, &tag.Name, &tag.Kind); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+func (r *ProductRepository) AddTag(ctx context.Context, tag *domain.Tag) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		"INSERT INTO tags(id, name, kind) VALUES ($1, $2, $3)",
+		tag.Id,
+		tag.Name,
+		tag.Kind,
+	)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return domain.ErrTagNameAlreadyInUse
+	} else if err != nil {
+		zap.L().Error("error adding tag", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ProductRepository) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	var kind domain.TagKind
+	err := r.db.QueryRowContext(
+		ctx,
+		`WITH deleted AS (
+			DELETE FROM tags WHERE id = $1 AND kind = 'dietary' RETURNING kind
+		)
+		SELECT kind FROM deleted
+		UNION ALL
+		SELECT kind FROM tags WHERE id = $1 AND kind <> 'dietary'`,
+		id,
+	).Scan(&kind)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrTagNotFound
+	} else if err != nil {
+		zap.L().Error("error deleting tag", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if kind != domain.Dietary {
+		return domain.ErrAllergenTagNotDeletable
+	}
+	return nil
+}
+
+var setProductTagsPqErrorMap = map[string]map[string]error{
+	"23503": {
+		"product_tags_product_id_fkey": domain.ErrProductNotFound,
+		"product_tags_tag_id_fkey":     domain.ErrTagNotFound,
+	},
+}
+
+func (r *ProductRepository) SetProductTags(ctx context.Context, productId uuid.UUID, tagIds []uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	var exists bool
+	if err = tx.QueryRowContext(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM products WHERE id = $1)",
+		productId,
+	).Scan(&exists); err != nil {
+		zap.L().Error("error checking product", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if !exists {
+		return domain.ErrProductNotFound
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM product_tags WHERE product_id = $1", productId); err != nil {
+		zap.L().Error("error deleting product tags", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	for _, tagId := range tagIds {
+		_, err = tx.ExecContext(
+			ctx,
+			"INSERT INTO product_tags(product_id, tag_id) VALUES ($1, $2)",
+			productId,
+			tagId,
+		)
+
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			if mappedCode, ok := setProductTagsPqErrorMap[string(pqErr.Code)]; ok {
+				if mappedConstraint, ok := mappedCode[pqErr.Constraint]; ok {
+					return mappedConstraint
+				}
+			}
+
+			zap.L().Error("unexpected pq error", zap.Error(pqErr))
+			return domain.ErrInternal
+		} else if err != nil {
+			zap.L().Error("error adding product tag", zap.Error(err))
+			return domain.ErrInternal
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ProductRepository) GetProductTranslations(ctx context.Context, productId uuid.UUID) ([]domain.ProductTranslation, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT product_id, locale, name, description
+		FROM product_translations
+		WHERE product_id = $1
+		ORDER BY locale`,
+		productId,
+	)
+	if err != nil {
+		zap.L().Error("error getting product translations", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var translations []domain.ProductTranslation
+	for rows.Next() {
+		var translation domain.ProductTranslation
+		if err = rows.Scan(
+			&translation.ProductId,
+			&translation.Locale,
+			&translation.Name,
+			&translation.Description,
+		); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		translations = append(translations, translation)
+	}
+
+	return translations, nil
+}
+
+func (r *ProductRepository) SetProductTranslation(ctx context.Context, translation *domain.ProductTranslation) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO product_translations(product_id, locale, name, description)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (product_id, locale) DO UPDATE
+		SET name = excluded.name, description = excluded.description`,
+		translation.ProductId,
+		translation.Locale,
+		translation.Name,
+		translation.Description,
+	)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return domain.ErrProductNotFound
+	} else if err != nil {
+		zap.L().Error("error setting product translation", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ProductRepository) DeleteProductTranslation(ctx context.Context, productId uuid.UUID, locale string) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		"DELETE FROM product_translations WHERE product_id = $1 AND locale = $2",
+		productId,
+		locale,
+	)
+	if err != nil {
+		zap.L().Error("error deleting product translation", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrTranslationNotFound
+	}
+	return nil
+}
+
+func (r *ProductRepository) GetCategoryTranslations(ctx context.Context, categoryId uuid.UUID) ([]domain.CategoryTranslation, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT category_id, locale, name
+		FROM category_translations
+		WHERE category_id = $1
+		ORDER BY locale`,
+		categoryId,
+	)
+	if err != nil {
+		zap.L().Error("error getting category translations", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var translations []domain.CategoryTranslation
+	for rows.Next() {
+		var translation domain.CategoryTranslation
+		if err = rows.Scan(&translation.CategoryId, &translation.Locale, &translation.Name); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		translations = append(translations, translation)
+	}
+
+	return translations, nil
+}
+
+func (r *ProductRepository) SetCategoryTranslation(ctx context.Context, translation *domain.CategoryTranslation) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO category_translations(category_id, locale, name)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (category_id, locale) DO UPDATE
+		SET name = excluded.name`,
+		translation.CategoryId,
+		translation.Locale,
+		translation.Name,
+	)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return domain.ErrProductCategoryNotFound
+	} else if err != nil {
+		zap.L().Error("error setting category translation", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ProductRepository) DeleteCategoryTranslation(ctx context.Context, categoryId uuid.UUID, locale string) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		"DELETE FROM category_translations WHERE category_id = $1 AND locale = $2",
+		categoryId,
+		locale,
+	)
+	if err != nil {
+		zap.L().Error("error deleting category translation", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrTranslationNotFound
+	}
+	return nil
+}
+
+// scheduleWindowContains is an SQL condition that holds when the schedule window w contains
+// the weekday bound to $5 and the time of day bound to $6. It mirrors domain.ScheduleWindow.Contains.
+const scheduleWindowContains = `(w.start_time < w.end_time
+		AND w.weekday = $5 AND $6::time >= w.start_time AND $6::time < w.end_time)
+	OR (w.start_time >= w.end_time AND (
+		(w.weekday = $5 AND $6::time >= w.start_time)
+		OR ((w.weekday + 1) % 7 = $5 AND $6::time < w.end_time)
+	))`
+
+func (r *ProductRepository) GetProductSchedule(ctx context.Context, productId uuid.UUID) ([]domain.ScheduleWindow, error) {
+	return r.getScheduleWindows(
+		ctx,
+		`SELECT weekday, to_char(start_time, 'HH24:MI'), to_char(end_time, 'HH24:MI')
+		FROM product_schedule_windows
+		WHERE product_id = $1
+		ORDER BY weekday, start_time`,
+		productId,
+	)
+}
+
+func (r *ProductRepository) GetCategorySchedule(ctx context.Context, categoryId uuid.UUID) ([]domain.ScheduleWindow, error) {
+	return r.getScheduleWindows(
+		ctx,
+		`SELECT weekday, to_char(start_time, 'HH24:MI'), to_char(end_time, 'HH24:MI')
+		FROM category_schedule_windows
+		WHERE category_id = $1
+		ORDER BY weekday, start_time`,
+		categoryId,
+	)
+}
+
+// getScheduleWindows fetches schedule windows with the provided query.
+func (r *ProductRepository) getScheduleWindows(ctx context.Context, query string, id uuid.UUID) ([]domain.ScheduleWindow, error) {
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		zap.L().Error("error getting schedule windows", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var windows []domain.ScheduleWindow
+	for rows.Next() {
+		var window domain.ScheduleWindow
+		if err = rows.Scan(&window.Weekday, &window.Start, &window.End); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		windows = append(windows, window)
+	}
+
+	return windows, nil
+}
+
+func (r *ProductRepository) SetProductSchedule(ctx context.Context, productId uuid.UUID, windows []domain.ScheduleWindow) error {
+	return r.setScheduleWindows(
+		ctx,
+		productId,
+		windows,
+		"SELECT EXISTS(SELECT 1 FROM products WHERE id = $1)",
+		domain.ErrProductNotFound,
+		"DELETE FROM product_schedule_windows WHERE product_id = $1",
+		"INSERT INTO product_schedule_windows(product_id, weekday, start_time, end_time) VALUES ($1, $2, $3, $4)",
+	)
+}
+
+func (r *ProductRepository) SetCategorySchedule(ctx context.Context, categoryId uuid.UUID, windows []domain.ScheduleWindow) error {
+	return r.setScheduleWindows(
+		ctx,
+		categoryId,
+		windows,
+		"SELECT EXISTS(SELECT 1 FROM product_categories WHERE id = $1)",
+		domain.ErrProductCategoryNotFound,
+		"DELETE FROM category_schedule_windows WHERE category_id = $1",
+		"INSERT INTO category_schedule_windows(category_id, weekday, start_time, end_time) VALUES ($1, $2, $3, $4)",
+	)
+}
+
+// setScheduleWindows replaces the schedule windows of a product or a category in a single transaction.
+func (r *ProductRepository) setScheduleWindows(
+	ctx context.Context,
+	id uuid.UUID,
+	windows []domain.ScheduleWindow,
+	existsQuery string,
+	notFoundErr error,
+	deleteQuery string,
+	insertQuery string,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	var exists bool
+	if err = tx.QueryRowContext(ctx, existsQuery, id).Scan(&exists); err != nil {
+		zap.L().Error("error checking schedule owner", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if !exists {
+		return notFoundErr
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteQuery, id); err != nil {
+		zap.L().Error("error deleting schedule windows", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	for _, window := range windows {
+		_, err = tx.ExecContext(ctx, insertQuery, id, int(window.Weekday), window.Start, window.End)
+
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return domain.ErrDuplicateScheduleWindow
+		} else if err != nil {
+			zap.L().Error("error adding schedule window", zap.Error(err))
+			return domain.ErrInternal
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// pricingRuleWindows is an SQL expression aggregating the windows w of a pricing rule into a JSON array.
+const pricingRuleWindows = `COALESCE(
+		jsonb_agg(
+			jsonb_build_object('weekday', w.weekday, 'start', to_char(w.start_time, 'HH24:MI'), 'end', to_char(w.end_time, 'HH24:MI'))
+			ORDER BY w.weekday, w.start_time
+		) FILTER (WHERE w.rule_id IS NOT NULL),
+		'[]'::jsonb
+	)`
+
+// scanPricingRule scans a pricing rule selected together with pricingRuleWindows.
+func scanPricingRule(scan func(dest ...any) error, dest ...any) (domain.PricingRule, error) {
+	var rule domain.PricingRule
+	var windows []byte
+	if err := scan(append(dest,
+		&rule.Id,
+		&rule.Name,
+		&rule.ProductId,
+		&rule.CategoryId,
+		&rule.Kind,
+		&rule.Value,
+		&windows,
+	)...); err != nil {
+		zap.L().Error("error scanning rows", zap.Error(err))
+		return rule, domain.ErrInternal
+	}
+
+	if err := json.Unmarshal(windows, &rule.Windows); err != nil {
+		zap.L().Error("error decoding pricing rule windows", zap.Error(err))
+		return rule, domain.ErrInternal
+	}
+	return rule, nil
+}
+
+// getPricingRules fetches the pricing rules of the products and of their categories.
+func (r *ProductRepository) getPricingRules(ctx context.Context, productIds []uuid.UUID) (map[uuid.UUID][]domain.PricingRule, error) {
+	ids := make([]string, 0, len(productIds))
+	for _, id := range productIds {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT p.id, r.id, r.name, r.product_id, r.category_id, r.kind, r.value, `+pricingRuleWindows+`
+		FROM products p
+		JOIN pricing_rules r ON r.product_id = p.id OR r.category_id = p.category
+		LEFT JOIN pricing_rule_windows w ON w.rule_id = r.id
+		WHERE p.id = ANY($1::uuid[])
+		GROUP BY p.id, r.id
+		ORDER BY r.name`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		zap.L().Error("error getting pricing rules", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	rules := make(map[uuid.UUID][]domain.PricingRule)
+	for rows.Next() {
+		var productId uuid.UUID
+		rule, err := scanPricingRule(rows.Scan, &productId)
+		if err != nil {
+			return nil, err
+		}
+		rules[productId] = append(rules[productId], rule)
+	}
+
+	return rules, nil
+}
+
+// attachPricingRules loads and sets the pricing rules of the provided products.
+func (r *ProductRepository) attachPricingRules(ctx context.Context, products []domain.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	productIds := make([]uuid.UUID, 0, len(products))
+	for _, product := range products {
+		productIds = append(productIds, product.Id)
+	}
+
+	rules, err := r.getPricingRules(ctx, productIds)
+	if err != nil {
+		return err
+	}
+
+	for i := range products {
+		products[i].PricingRules = rules[products[i].Id]
+	}
+	return nil
+}
+
+func (r *ProductRepository) GetPricingRules(ctx context.Context) ([]domain.PricingRule, error) {
+	return getAllPricingRules(ctx, r.db)
+}
+
+// getAllPricingRules fetches all pricing rules ordered by name.
+func getAllPricingRules(ctx context.Context, q queryer) ([]domain.PricingRule, error) {
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT r.id, r.name, r.product_id, r.category_id, r.kind, r.value, `+pricingRuleWindows+`
+		FROM pricing_rules r
+		LEFT JOIN pricing_rule_windows w ON w.rule_id = r.id
+		GROUP BY r.id
+		ORDER BY r.name`,
+	)
+	if err != nil {
+		zap.L().Error("error getting pricing rules", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var rules []domain.PricingRule
+	for rows.Next() {
+		rule, err := scanPricingRule(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+var addPricingRulePqErrorMap = map[string]map[string]error{
+	"23505": {
+		"pricing_rules_name_key":    domain.ErrPricingRuleNameAlreadyInUse,
+		"pricing_rule_windows_pkey": domain.ErrDuplicateScheduleWindow,
+	},
+	"23503": {
+		"pricing_rules_product_id_fkey":  domain.ErrProductNotFound,
+		"pricing_rules_category_id_fkey": domain.ErrProductCategoryNotFound,
+	},
+}
+
+func (r *ProductRepository) AddPricingRule(ctx context.Context, rule *domain.PricingRule) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	mapErr := func(err error) error {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			if mappedCode, ok := addPricingRulePqErrorMap[string(pqErr.Code)]; ok {
+				if mappedConstraint, ok := mappedCode[pqErr.Constraint]; ok {
+					return mappedConstraint
+				}
+			}
+
+			zap.L().Error("unexpected pq error", zap.Error(pqErr))
+			return domain.ErrInternal
+		}
+
+		zap.L().Error("error adding pricing rule", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO pricing_rules(id, name, product_id, category_id, kind, value)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		rule.Id,
+		rule.Name,
+		rule.ProductId,
+		rule.CategoryId,
+		rule.Kind,
+		rule.Value,
+	); err != nil {
+		return mapErr(err)
+	}
+
+	for _, window := range rule.Windows {
+		if _, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO pricing_rule_windows(rule_id, weekday, start_time, end_time)
+			VALUES ($1, $2, $3, $4)`,
+			rule.Id,
+			int(window.Weekday),
+			window.Start,
+			window.End,
+		); err != nil {
+			return mapErr(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ProductRepository) DeletePricingRule(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM pricing_rules WHERE id = $1", id)
+	if err != nil {
+		zap.L().Error("error deleting pricing rule", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrPricingRuleNotFound
+	}
+	return nil
+}
+
+func (r *ProductRepository) GetMenu(ctx context.Context) (*domain.Menu, error) {
+	return getMenu(ctx, r.db)
+}
+
+// getMenu fetches all product categories and products, including archived ones, ordered by name.
+func getMenu(ctx context.Context, q queryer) (*domain.Menu, error) {
+	categoryRows, err := q.QueryContext(
+		ctx,
+		`SELECT id, name, archived_at, created_at FROM product_categories ORDER BY name`,
+	)
+	if err != nil {
+		zap.L().Error("error getting menu categories", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := categoryRows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var categories []domain.ProductCategory
+	for categoryRows.Next() {
+		var category domain.ProductCategory
+		if err = categoryRows.Scan(&category.Id, &category.Name, &category.ArchivedAt, &category.CreatedAt); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		categories = append(categories, category)
+	}
+
+	productRows, err := q.QueryContext(
+		ctx,
+		`SELECT id, name, description, image_url, delete_image_url, category, price, available, archived_at, created_at
+		FROM products
+		ORDER BY name`,
+	)
+	if err != nil {
+		zap.L().Error("error getting menu products", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := productRows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var products []domain.Product
+	for productRows.Next() {
+		var product domain.Product
+		if err = productRows.Scan(
+			&product.Id,
+			&product.Name,
+			&product.Description,
+			&product.ImageUrl,
+			&product.DeleteImageUrl,
+			&product.Category,
+			&product.Price,
+			&product.Available,
+			&product.ArchivedAt,
+			&product.CreatedAt,
+		); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		products = append(products, product)
+	}
+
+	return domain.NewMenu(categories, products), nil
+}
+
+var upsertMenuPqErrorMap = map[string]map[string]error{
+	"23505": {
+		"product_categories_name_key": domain.ErrProductCategoryNameAlreadyInUse,
+		"products_name_key":           domain.ErrProductNameAlreadyInUse,
+	},
+}
+
+func (r *ProductRepository) UpsertMenu(ctx context.Context, menu *domain.Menu) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	if err = upsertMenu(ctx, tx, menu); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// upsertMenu creates or updates the categories and products of the menu by id inside the transaction.
+func upsertMenu(ctx context.Context, tx *sql.Tx, menu *domain.Menu) error {
+	mapErr := func(err error) error {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) {
+			if mappedCode, ok := upsertMenuPqErrorMap[string(pqErr.Code)]; ok {
+				if mappedConstraint, ok := mappedCode[pqErr.Constraint]; ok {
+					return mappedConstraint
+				}
+			}
+
+			zap.L().Error("unexpected pq error", zap.Error(pqErr))
+			return domain.ErrInternal
+		}
+
+		zap.L().Error("error upserting menu", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	for _, category := range menu.Categories {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO product_categories(id, name)
+			VALUES ($1, $2)
+			ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name`,
+			category.Id,
+			category.Name,
+		); err != nil {
+			return mapErr(err)
+		}
+	}
+
+	for _, product := range menu.Products {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO products(id, name, description, category, price, available)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (id) DO UPDATE
+			SET name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			category = EXCLUDED.category,
+			price = EXCLUDED.price,
+			available = EXCLUDED.available`,
+			product.Id,
+			product.Name,
+			product.Description,
+			product.Category,
+			product.Price,
+			product.Available,
+		); err != nil {
+			return mapErr(err)
+		}
+
+		// An imported image url replaces the primary image. The delete url and variants only
+		// belong to the image they were made for, so the imported image has none. The replaced
+		// image isn't deleted, because the menu versions still refer to it.
+		if product.ImageUrl != nil {
+			if _, err := replacePrimaryImage(ctx, tx, &domain.ProductImage{
+				Id:        uuid.New(),
+				ProductId: product.Id,
+				Url:       *product.ImageUrl,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *ProductRepository) GetMenuDraft(ctx context.Context) (*domain.MenuDraft, error) {
+	var draft domain.MenuDraft
+	var rows []byte
+	err := r.db.QueryRowContext(ctx, "SELECT rows, created_at, updated_at FROM menu_draft").
+		Scan(&rows, &draft.CreatedAt, &draft.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrMenuDraftNotFound
+	} else if err != nil {
+		zap.L().Error("error getting menu draft", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	if err = json.Unmarshal(rows, &draft.Rows); err != nil {
+		zap.L().Error("error decoding menu draft rows", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return &draft, nil
+}
+
+func (r *ProductRepository) HasMenuDraft(ctx context.Context) (bool, error) {
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM menu_draft)").Scan(&exists); err != nil {
+		zap.L().Error("error checking menu draft", zap.Error(err))
+		return false, domain.ErrInternal
+	}
+	return exists, nil
+}
+
+func (r *ProductRepository) SaveMenuDraft(ctx context.Context, draft *domain.MenuDraft) error {
+	rows, err := json.Marshal(draft.Rows)
+	if err != nil {
+		zap.L().Error("error encoding menu draft rows", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if err = r.db.QueryRowContext(
+		ctx,
+		`INSERT INTO menu_draft(rows)
+		VALUES ($1)
+		ON CONFLICT (id) DO UPDATE SET rows = EXCLUDED.rows, updated_at = now()
+		RETURNING created_at, updated_at`,
+		rows,
+	).Scan(&draft.CreatedAt, &draft.UpdatedAt); err != nil {
+		zap.L().Error("error saving menu draft", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ProductRepository) DeleteMenuDraft(ctx context.Context) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM menu_draft")
+	if err != nil {
+		zap.L().Error("error deleting menu draft", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if rows == 0 {
+		return domain.ErrMenuDraftNotFound
+	}
+	return nil
+}
+
+func (r *ProductRepository) GetMenuVersions(ctx context.Context) ([]domain.MenuVersion, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT number, restored_from, published_at FROM menu_versions ORDER BY number DESC`,
+	)
+	if err != nil {
+		zap.L().Error("error getting menu versions", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var versions []domain.MenuVersion
+	for rows.Next() {
+		var version domain.MenuVersion
+		if err = rows.Scan(&version.Number, &version.RestoredFrom, &version.PublishedAt); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+func (r *ProductRepository) GetMenuVersion(ctx context.Context, number int) (*domain.MenuVersion, error) {
+	var version domain.MenuVersion
+	var rows, details []byte
+	err := r.db.QueryRowContext(
+		ctx,
+		`SELECT number, rows, details, restored_from, published_at FROM menu_versions WHERE number = $1`,
+		number,
+	).Scan(&version.Number, &rows, &details, &version.RestoredFrom, &version.PublishedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrMenuVersionNotFound
+	} else if err != nil {
+		zap.L().Error("error getting menu version", zap.Int("number", number), zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	if err = json.Unmarshal(rows, &version.Rows); err != nil {
+		zap.L().Error("error decoding menu version rows", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	if details != nil {
+		version.Details = &domain.MenuDetails{}
+		if err = json.Unmarshal(details, version.Details); err != nil {
+			zap.L().Error("error decoding menu version details", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+	}
+	return &version, nil
+}
+
+func (r *ProductRepository) PublishMenu(ctx context.Context, draft *domain.MenuDraft, resolve port.MenuResolver) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	// Publishes are serialized so that versions get consecutive numbers, and the categories and products
+	// can't change between reading the live menu and publishing, while orders can still reference them.
+	if _, err = tx.ExecContext(ctx, "LOCK TABLE menu_versions IN EXCLUSIVE MODE"); err != nil {
+		zap.L().Error("error locking menu versions", zap.Error(err))
+		return domain.ErrInternal
+	}
+	if _, err = tx.ExecContext(ctx, "LOCK TABLE product_categories, products IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		zap.L().Error("error locking menu", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	var createdBefore *time.Time
+	if draft != nil {
+		result, err := tx.ExecContext(ctx, "DELETE FROM menu_draft WHERE updated_at = $1", draft.UpdatedAt)
+		if err != nil {
+			zap.L().Error("error deleting menu draft", zap.Error(err))
+			return domain.ErrInternal
+		}
+
+		rows, err := result.RowsAffected()
+		if err != nil {
+			zap.L().Error("error getting rows affected", zap.Error(err))
+			return domain.ErrInternal
+		}
+
+		if rows == 0 {
+			return domain.ErrMenuDraftChanged
+		}
+		createdBefore = &draft.CreatedAt
+	}
+
+	current, err := getMenu(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	menu, version, err := resolve(current)
+	if err != nil {
+		return err
+	}
+
+	var published bool
+	if err = tx.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM menu_versions)").Scan(&published); err != nil {
+		zap.L().Error("error checking menu versions", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if live := current.Rows(); !published && len(live) > 0 {
+		if err = insertMenuVersion(ctx, tx, domain.NewMenuVersion(live, nil)); err != nil {
+			return err
+		}
+	}
+
+	if err = upsertMenu(ctx, tx, menu); err != nil {
+		return err
+	}
+
+	if version.RestoredFrom != nil && version.Details != nil {
+		if err = restoreMenuDetails(ctx, tx, version.Details); err != nil {
+			return err
+		}
+	}
+
+	categoryIds := make([]string, 0, len(menu.Categories))
+	for _, category := range menu.Categories {
+		categoryIds = append(categoryIds, category.Id.String())
+	}
+	productIds := make([]string, 0, len(menu.Products))
+	for _, product := range menu.Products {
+		productIds = append(productIds, product.Id.String())
+	}
+
+	for _, query := range []struct {
+		statement string
+		ids       []string
+	}{
+		{
+			statement: `UPDATE product_categories
+			SET archived_at = CASE WHEN id = ANY($1::uuid[]) THEN NULL ELSE now() END
+			WHERE (archived_at IS NULL AND NOT id = ANY($1::uuid[]) AND ($2::timestamptz IS NULL OR created_at <= $2))
+			OR (archived_at IS NOT NULL AND id = ANY($1::uuid[]))`,
+			ids: categoryIds,
+		},
+		{
+			statement: `UPDATE products
+			SET archived_at = CASE WHEN id = ANY($1::uuid[]) THEN NULL ELSE now() END
+			WHERE (archived_at IS NULL AND NOT id = ANY($1::uuid[]) AND ($2::timestamptz IS NULL OR created_at <= $2))
+			OR (archived_at IS NOT NULL AND id = ANY($1::uuid[]))`,
+			ids: productIds,
+		},
+	} {
+		if _, err = tx.ExecContext(ctx, query.statement, pq.Array(query.ids), createdBefore); err != nil {
+			zap.L().Error("error archiving unpublished menu entries", zap.Error(err))
+			return domain.ErrInternal
+		}
+	}
+
+	if err = insertMenuVersion(ctx, tx, version); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// insertMenuVersion captures the details of the version rows and saves the version with the next number
+// inside the transaction, then sets its number and publication time. The caller must hold the lock on menu_versions.
+func insertMenuVersion(ctx context.Context, tx *sql.Tx, version *domain.MenuVersion) error {
+	rows, err := json.Marshal(version.Rows)
+	if err != nil {
+		zap.L().Error("error encoding menu version rows", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if version.Details, err = captureMenuDetails(ctx, tx, version.Rows); err != nil {
+		return err
+	}
+
+	details, err := json.Marshal(version.Details)
+	if err != nil {
+		zap.L().Error("error encoding menu version details", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if err = tx.QueryRowContext(
+		ctx,
+		`INSERT INTO menu_versions(number, rows, details, restored_from)
+		SELECT COALESCE(MAX(number), 0) + 1, $1, $2, $3 FROM menu_versions
+		RETURNING number, published_at`,
+		rows,
+		details,
+		version.RestoredFrom,
+	).Scan(&version.Number, &version.PublishedAt); err != nil {
+		zap.L().Error("error saving menu version", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
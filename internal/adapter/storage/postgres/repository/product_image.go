@@ -0,0 +1,435 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"restaurant/internal/core/domain"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// queryer is implemented by *sql.DB and *sql.Tx.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// getProductImages fetches the gallery images matching the filter on product_images i by product id, in gallery order.
+func getProductImages(ctx context.Context, q queryer, filter string, args ...any) (map[uuid.UUID][]domain.ProductImage, error) {
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT i.id, i.product_id, i.position, i.alt_text, i.url, i.delete_url, i.variants
+		FROM product_images i
+		WHERE `+filter+`
+		ORDER BY i.product_id, i.position`,
+		args...,
+	)
+	if err != nil {
+		zap.L().Error("error getting product images", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	images := make(map[uuid.UUID][]domain.ProductImage)
+	for rows.Next() {
+		var image domain.ProductImage
+		var variants []byte
+		if err = rows.Scan(
+			&image.Id,
+			&image.ProductId,
+			&image.Position,
+			&image.AltText,
+			&image.Url,
+			&image.DeleteUrl,
+			&variants,
+		); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+
+		if variants != nil {
+			if err = json.Unmarshal(variants, &image.Variants); err != nil {
+				zap.L().Error("error unmarshalling image variants", zap.Error(err))
+				return nil, domain.ErrInternal
+			}
+		}
+		images[image.ProductId] = append(images[image.ProductId], image)
+	}
+
+	return images, nil
+}
+
+// getProductGalleries fetches the gallery images of the products by product id.
+func getProductGalleries(ctx context.Context, q queryer, productIds []uuid.UUID) (map[uuid.UUID][]domain.ProductImage, error) {
+	ids := make([]string, 0, len(productIds))
+	for _, id := range productIds {
+		ids = append(ids, id.String())
+	}
+	return getProductImages(ctx, q, "i.product_id = ANY($1::uuid[])", pq.Array(ids))
+}
+
+// attachGalleries loads and sets the gallery images of the provided products.
+func (r *ProductRepository) attachGalleries(ctx context.Context, products []domain.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	productIds := make([]uuid.UUID, 0, len(products))
+	for _, product := range products {
+		productIds = append(productIds, product.Id)
+	}
+
+	galleries, err := getProductGalleries(ctx, r.db, productIds)
+	if err != nil {
+		return err
+	}
+
+	for i := range products {
+		products[i].Gallery = galleries[products[i].Id]
+	}
+	return nil
+}
+
+// lockProduct locks the product row until the end of the transaction, so changes of
+// its gallery don't interleave.
+func lockProduct(ctx context.Context, tx *sql.Tx, productId uuid.UUID) error {
+	var id uuid.UUID
+	err := tx.QueryRowContext(ctx, "SELECT id FROM products WHERE id = $1 FOR UPDATE", productId).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrProductNotFound
+	} else if err != nil {
+		zap.L().Error("error locking product", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// syncPrimaryImage copies the first gallery image to the image columns of the product,
+// clearing them when the gallery is empty.
+func syncPrimaryImage(ctx context.Context, tx *sql.Tx, productId uuid.UUID) error {
+	if _, err := tx.ExecContext(
+		ctx,
+		`UPDATE products
+		SET (image_url, delete_image_url, image_variants) = (
+			SELECT url, delete_url, variants
+			FROM product_images
+			WHERE product_id = $1
+			ORDER BY position
+			LIMIT 1
+		)
+		WHERE id = $1`,
+		productId,
+	); err != nil {
+		zap.L().Error("error updating product primary image", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+// marshalImageVariants encodes the variants for the variants columns, which are NULL for images without variants.
+func marshalImageVariants(images domain.ImageSet) (any, error) {
+	if len(images) == 0 {
+		return nil, nil
+	}
+
+	variants, err := json.Marshal(images)
+	if err != nil {
+		zap.L().Error("error marshalling image variants", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return variants, nil
+}
+
+// replacePrimaryImage points the primary gallery image of the product to another image, keeping
+// its id and alt text, or adds the image when the gallery is empty. It returns the replaced image,
+// which is nil when the gallery was empty or the url stays the same.
+func replacePrimaryImage(ctx context.Context, tx *sql.Tx, image *domain.ProductImage) (*domain.ProductImage, error) {
+	variants, err := marshalImageVariants(image.Variants)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := getProductImages(
+		ctx,
+		tx,
+		`i.id = (SELECT id FROM product_images WHERE product_id = $1 ORDER BY position LIMIT 1)`,
+		image.ProductId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var primary *domain.ProductImage
+	if gallery := images[image.ProductId]; len(gallery) > 0 {
+		primary = &gallery[0]
+	}
+
+	switch {
+	case primary == nil:
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO product_images(id, product_id, position, alt_text, url, delete_url, variants)
+			VALUES ($1, $2, 0, $3, $4, $5, $6)`,
+			image.Id,
+			image.ProductId,
+			image.AltText,
+			image.Url,
+			image.DeleteUrl,
+			variants,
+		)
+	case primary.Url == image.Url:
+		return nil, nil
+	default:
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE product_images
+			SET url = $1,
+			delete_url = $2,
+			variants = $3
+			WHERE id = $4`,
+			image.Url,
+			image.DeleteUrl,
+			variants,
+			primary.Id,
+		)
+	}
+	if err != nil {
+		zap.L().Error("error saving product primary image", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	return primary, syncPrimaryImage(ctx, tx, image.ProductId)
+}
+
+func (r *ProductRepository) UpdateProductImage(ctx context.Context, productId uuid.UUID, images domain.ImageSet) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	if err = lockProduct(ctx, tx, productId); err != nil {
+		return err
+	}
+
+	if err = claimImageUploads(ctx, tx, images.DeleteUrls()); err != nil {
+		return err
+	}
+
+	replaced, err := replacePrimaryImage(ctx, tx, domain.NewProductImage(uuid.New(), productId, "", images))
+	if err != nil {
+		return err
+	}
+	if replaced != nil {
+		if err = scheduleImageDeletions(ctx, tx, replaced.StoredImages()); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ProductRepository) AddProductImage(ctx context.Context, image *domain.ProductImage) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	if err = lockProduct(ctx, tx, image.ProductId); err != nil {
+		return err
+	}
+
+	var count, position int
+	if err = tx.QueryRowContext(
+		ctx,
+		`SELECT COUNT(*), COALESCE(MAX(position) + 1, 0)
+		FROM product_images
+		WHERE product_id = $1`,
+		image.ProductId,
+	).Scan(&count, &position); err != nil {
+		zap.L().Error("error counting product images", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if count >= domain.MaxProductImages {
+		return domain.ErrProductGalleryFull
+	}
+
+	variants, err := marshalImageVariants(image.Variants)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO product_images(id, product_id, position, alt_text, url, delete_url, variants)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		image.Id,
+		image.ProductId,
+		position,
+		image.AltText,
+		image.Url,
+		image.DeleteUrl,
+		variants,
+	); err != nil {
+		zap.L().Error("error adding product image", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if err = claimImageUploads(ctx, tx, image.DeleteUrls()); err != nil {
+		return err
+	}
+
+	if count == 0 {
+		if err = syncPrimaryImage(ctx, tx, image.ProductId); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	image.Position = position
+	return nil
+}
+
+func (r *ProductRepository) ReorderProductImages(ctx context.Context, productId uuid.UUID, imageIds []uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	if err = lockProduct(ctx, tx, productId); err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(imageIds))
+	for _, id := range imageIds {
+		ids = append(ids, id.String())
+	}
+
+	// Positions follow the order of the ids. The ids must match the gallery exactly,
+	// which holds when every image is updated and there are as many ids as images.
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE product_images i
+		SET position = o.position - 1
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, position)
+		WHERE i.id = o.id AND i.product_id = $1`,
+		productId,
+		pq.Array(ids),
+	)
+	if err != nil {
+		zap.L().Error("error reordering product images", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		zap.L().Error("error getting rows affected", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	var count int
+	if err = tx.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM product_images WHERE product_id = $1",
+		productId,
+	).Scan(&count); err != nil {
+		zap.L().Error("error counting product images", zap.Error(err))
+		return domain.ErrInternal
+	}
+
+	if int(updated) != len(imageIds) || count != len(imageIds) {
+		return domain.ErrInvalidProductImageOrder
+	}
+
+	if err = syncPrimaryImage(ctx, tx, productId); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return domain.ErrInternal
+	}
+	return nil
+}
+
+func (r *ProductRepository) DeleteProductImage(ctx context.Context, productId, imageId uuid.UUID) (*domain.ProductImage, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			zap.L().Warn("error rolling back transaction", zap.Error(rollbackErr))
+		}
+	}()
+
+	if err = lockProduct(ctx, tx, productId); err != nil {
+		return nil, err
+	}
+
+	images, err := getProductImages(ctx, tx, "i.id = $1 AND i.product_id = $2", imageId, productId)
+	if err != nil {
+		return nil, err
+	}
+	if len(images[productId]) == 0 {
+		return nil, domain.ErrProductImageNotFound
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM product_images WHERE id = $1", imageId); err != nil {
+		zap.L().Error("error deleting product image", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	if err = scheduleImageDeletions(ctx, tx, images[productId][0].StoredImages()); err != nil {
+		return nil, err
+	}
+
+	if err = syncPrimaryImage(ctx, tx, productId); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		zap.L().Error("error committing transaction", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return &images[productId][0], nil
+}
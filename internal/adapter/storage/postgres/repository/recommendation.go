@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"restaurant/internal/core/domain"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RecommendationRepository implements port.RecommendationRepository and provides access to postgres.
+type RecommendationRepository struct {
+	db *sql.DB
+}
+
+// NewRecommendationRepository creates a new RecommendationRepository instance.
+func NewRecommendationRepository(db *sql.DB) *RecommendationRepository {
+	return &RecommendationRepository{
+		db: db,
+	}
+}
+
+func (r *RecommendationRepository) GetRecommendations(ctx context.Context, since time.Time, minSessions, limit int) ([]domain.Recommendation, error) {
+	// Every product counts once per session however often it was ordered there. Bundle
+	// components are left out, because they are always ordered together. Paid sessions
+	// are only found in their history.
+	rows, err := r.db.QueryContext(
+		ctx,
+		`WITH session_products AS (
+			SELECT session_id, product_id
+			FROM ordered_products
+			WHERE ordered_bundle_id IS NULL AND created_at >= $1
+			UNION
+			SELECT session_id, product_id
+			FROM paid_session_products
+			WHERE ordered_at >= $1
+		), pairs AS (
+			SELECT a.product_id, b.product_id AS companion_id, count(*) AS sessions
+			FROM session_products a
+			JOIN session_products b ON b.session_id = a.session_id AND b.product_id <> a.product_id
+			GROUP BY a.product_id, b.product_id
+			HAVING count(*) >= $2
+		), ranked AS (
+			SELECT pairs.product_id,
+				pairs.companion_id,
+				pairs.sessions,
+				row_number() OVER (PARTITION BY pairs.product_id ORDER BY pairs.sessions DESC, p.name, p.id) AS companion_rank
+			FROM pairs
+			JOIN products p ON p.id = pairs.companion_id
+			JOIN product_categories c ON c.id = p.category
+			WHERE p.available AND p.archived_at IS NULL AND c.archived_at IS NULL
+		)
+		SELECT product_id, companion_id, sessions
+		FROM ranked
+		WHERE companion_rank <= $3
+		ORDER BY product_id, companion_rank`,
+		since,
+		minSessions,
+		limit,
+	)
+	if err != nil {
+		zap.L().Error("error getting recommendations", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			zap.L().Warn("error closing rows", zap.Error(closeErr))
+		}
+	}()
+
+	var recommendations []domain.Recommendation
+	for rows.Next() {
+		var recommendation domain.Recommendation
+		if err = rows.Scan(
+			&recommendation.ProductId,
+			&recommendation.CompanionId,
+			&recommendation.Sessions,
+		); err != nil {
+			zap.L().Error("error scanning rows", zap.Error(err))
+			return nil, domain.ErrInternal
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+
+	if err = rows.Err(); err != nil {
+		zap.L().Error("error getting recommendations", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	return recommendations, nil
+}
@@ -72,3 +72,36 @@ VALUES ('e1e1e1e1-e1e1-e1e1-e1e1-e1e1e1e1e1e1', 'French Fries', 'Crispy golden f
         '55555555-5555-5555-5555-555555555555', 3.40),
        ('e5e5e5e5-e5e5-e5e5-e5e5-e5e5e5e5e5e5', 'Onion Rings', 'Crispy battered onion rings fried to golden brown.',
         NULL, NULL, '55555555-5555-5555-5555-555555555555', 3.60);
+
+-- Insert Modifier Groups
+INSERT INTO modifier_groups (id, product_id, name, min_selected, max_selected)
+VALUES ('f1f1f1f1-f1f1-f1f1-f1f1-f1f1f1f1f1f1', 'b2b2b2b2-b2b2-b2b2-b2b2-b2b2b2b2b2b2', 'Doneness', 1, 1),
+       ('f2f2f2f2-f2f2-f2f2-f2f2-f2f2f2f2f2f2', 'b2b2b2b2-b2b2-b2b2-b2b2-b2b2b2b2b2b2', 'Extras', 0, 2);
+
+-- Insert Modifier Options
+INSERT INTO modifier_options (id, group_id, name, price_delta, position)
+VALUES ('f1000000-0000-0000-0000-000000000001', 'f1f1f1f1-f1f1-f1f1-f1f1-f1f1f1f1f1f1', 'Rare', 0, 0),
+       ('f1000000-0000-0000-0000-000000000002', 'f1f1f1f1-f1f1-f1f1-f1f1-f1f1f1f1f1f1', 'Medium', 0, 1),
+       ('f1000000-0000-0000-0000-000000000003', 'f1f1f1f1-f1f1-f1f1-f1f1-f1f1f1f1f1f1', 'Well Done', 0, 2),
+       ('f2000000-0000-0000-0000-000000000001', 'f2f2f2f2-f2f2-f2f2-f2f2-f2f2f2f2f2f2', 'Extra Cheese', 1.50, 0),
+       ('f2000000-0000-0000-0000-000000000002', 'f2f2f2f2-f2f2-f2f2-f2f2-f2f2f2f2f2f2', 'Pepper Sauce', 2.00, 1);
+
+-- Insert Dietary Tags
+INSERT INTO tags (id, name, kind)
+VALUES ('a0000000-0000-0000-0000-000000000001', 'vegan', 'dietary'),
+       ('a0000000-0000-0000-0000-000000000002', 'vegetarian', 'dietary');
+
+-- Insert Product Tags
+INSERT INTO product_tags (product_id, tag_id)
+SELECT p.id, t.id
+FROM (VALUES ('d4d4d4d4-d4d4-d4d4-d4d4-d4d4d4d4d4d4'::uuid, 'milk'),
+             ('d4d4d4d4-d4d4-d4d4-d4d4-d4d4d4d4d4d4'::uuid, 'vegetarian'),
+             ('d5d5d5d5-d5d5-d5d5-d5d5-d5d5d5d5d5d5'::uuid, 'vegan'),
+             ('e1e1e1e1-e1e1-e1e1-e1e1-e1e1e1e1e1e1'::uuid, 'vegan'),
+             ('e2e2e2e2-e2e2-e2e2-e2e2-e2e2e2e2e2e2'::uuid, 'vegan'),
+             ('e2e2e2e2-e2e2-e2e2-e2e2-e2e2e2e2e2e2'::uuid, 'mustard'),
+             ('e4e4e4e4-e4e4-e4e4-e4e4-e4e4e4e4e4e4'::uuid, 'milk'),
+             ('e4e4e4e4-e4e4-e4e4-e4e4-e4e4e4e4e4e4'::uuid, 'vegetarian'),
+             ('e5e5e5e5-e5e5-e5e5-e5e5-e5e5e5e5e5e5'::uuid, 'gluten'),
+             ('e5e5e5e5-e5e5-e5e5-e5e5-e5e5e5e5e5e5'::uuid, 'vegetarian')) AS p(id, tag)
+JOIN tags t ON t.name = p.tag;
@@ -0,0 +1,299 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"restaurant/internal/adapter/config"
+	"restaurant/internal/core/domain"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ImageRepository implements port.ImageRepository on top of the S3 API, so it works with
+// AWS S3 as well as compatible storages like MinIO. The object key is the last part of
+// the image url, which lets the delete url be mapped back to the object.
+type ImageRepository struct {
+	endpoint  string
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	urlPrefix string
+}
+
+// NewImageRepository creates a new ImageRepository instance.
+func NewImageRepository(storageConfig *config.StorageConfig) *ImageRepository {
+	return &ImageRepository{
+		endpoint:  storageConfig.ImagesS3Endpoint,
+		region:    storageConfig.ImagesS3Region,
+		bucket:    storageConfig.ImagesS3Bucket,
+		accessKey: storageConfig.ImagesS3AccessKey,
+		secretKey: storageConfig.ImagesS3SecretKey,
+		urlPrefix: storageConfig.ImagesS3PublicUrl + "/",
+	}
+}
+
+func (r *ImageRepository) SaveImage(ctx context.Context, data io.Reader) (*domain.Image, error) {
+	// The whole image is needed up front, because the signature covers a hash of the body.
+	body, err := io.ReadAll(data)
+	if err != nil {
+		zap.L().Error("error reading image", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+
+	contentType, extension, err := domain.DetectImageType(body)
+	if err != nil {
+		return nil, err
+	}
+
+	key := "restaurant-" + uuid.New().String() + extension
+	req, err := r.newRequest(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	if err = r.do(req, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	url := r.urlPrefix + key
+	return &domain.Image{
+		Url:       url,
+		DeleteUrl: url,
+	}, nil
+}
+
+func (r *ImageRepository) DeleteImage(ctx context.Context, deleteUrl string) error {
+	// Images uploaded to another storage before switching to S3 can't be deleted here.
+	key, ok := strings.CutPrefix(deleteUrl, r.urlPrefix)
+	if !ok || key == "" || key != path.Base(key) {
+		zap.L().Warn("skipping deletion of image outside the bucket", zap.String("url", deleteUrl))
+		return nil
+	}
+
+	req, err := r.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	// Deleting a missing object isn't an error, S3 answers 204 either way and some
+	// compatible storages answer 404.
+	return r.do(req, http.StatusNoContent, http.StatusOK, http.StatusNotFound)
+}
+
+// listObjectsResponse is the part of the ListObjectsV2 response the repository uses.
+type listObjectsResponse struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (r *ImageRepository) ListImages(ctx context.Context) ([]domain.StoredImage, error) {
+	var images []domain.StoredImage
+	continuationToken := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", "restaurant-")
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		req, err := r.newRequest(ctx, http.MethodGet, "?"+canonicalQuery(query), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var listResponse listObjectsResponse
+		if err = r.doDecode(req, &listResponse); err != nil {
+			return nil, err
+		}
+
+		for _, object := range listResponse.Contents {
+			url := r.urlPrefix + object.Key
+			images = append(images, domain.StoredImage{
+				Image: domain.Image{
+					Url:       url,
+					DeleteUrl: url,
+				},
+				UploadedAt: object.LastModified,
+			})
+		}
+
+		if !listResponse.IsTruncated || listResponse.NextContinuationToken == "" {
+			return images, nil
+		}
+		continuationToken = listResponse.NextContinuationToken
+	}
+}
+
+// newRequest creates a signed path-style request for an object of the bucket. The key may be
+// a query string alone for requests to the bucket itself.
+func (r *ImageRepository) newRequest(ctx context.Context, method, key string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, r.endpoint+"/"+r.bucket+"/"+key, bytes.NewReader(body))
+	if err != nil {
+		zap.L().Error("error creating request", zap.Error(err))
+		return nil, domain.ErrInternal
+	}
+	if body == nil {
+		req.Body = http.NoBody
+	}
+
+	sum := sha256.Sum256(body)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sum[:]))
+	req.Header.Set("X-Amz-Date", time.Now().UTC().Format("20060102T150405Z"))
+	return req, nil
+}
+
+// do signs and executes the request and checks that it was answered with one of the expected statuses.
+func (r *ImageRepository) do(req *http.Request, statuses ...int) error {
+	return r.execute(req, nil, statuses...)
+}
+
+// doDecode signs and executes the request and decodes the XML body of a successful response into v.
+func (r *ImageRepository) doDecode(req *http.Request, v any) error {
+	return r.execute(req, v, http.StatusOK)
+}
+
+func (r *ImageRepository) execute(req *http.Request, v any, statuses ...int) error {
+	req.Header.Set("Authorization", r.authorization(req))
+
+	httpResponse, err := http.DefaultClient.Do(req)
+	if err != nil {
+		zap.L().Error("error executing request", zap.Error(err))
+		return domain.ErrInternal
+	}
+	defer func() {
+		if closeErr := httpResponse.Body.Close(); closeErr != nil {
+			zap.L().Warn("error closing response body", zap.Error(closeErr))
+		}
+	}()
+
+	for _, status := range statuses {
+		if httpResponse.StatusCode != status {
+			continue
+		}
+		if v != nil {
+			if err = xml.NewDecoder(httpResponse.Body).Decode(v); err != nil {
+				zap.L().Error("error decoding response", zap.Error(err))
+				return domain.ErrInternal
+			}
+		}
+		return nil
+	}
+
+	message, _ := io.ReadAll(io.LimitReader(httpResponse.Body, 1024))
+	zap.L().Error(
+		"error executing request",
+		zap.String("method", req.Method),
+		zap.String("url", req.URL.String()),
+		zap.Int("status_code", httpResponse.StatusCode),
+		zap.ByteString("response", message),
+	)
+	return domain.ErrInternal
+}
+
+// authorization returns the AWS Signature Version 4 Authorization header of the request,
+// signing the host and all headers set on the request.
+func (r *ImageRepository) authorization(req *http.Request) string {
+	amzDate := req.Header.Get("X-Amz-Date")
+	date := amzDate[:8]
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		req.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+
+	scope := date + "/" + r.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+r.secretKey), date)
+	key = hmacSHA256(key, r.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	return "AWS4-HMAC-SHA256 Credential=" + r.accessKey + "/" + scope +
+		", SignedHeaders=" + signedHeaders +
+		", Signature=" + signature
+}
+
+// canonicalQuery encodes the query sorted by key and value as the signature requires.
+func canonicalQuery(query url.Values) string {
+	type pair struct {
+		key   string
+		value string
+	}
+	pairs := make([]pair, 0, len(query))
+	for key, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, pair{key: uriEncode(key), value: uriEncode(value)})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].key != pairs[j].key {
+			return pairs[i].key < pairs[j].key
+		}
+		return pairs[i].value < pairs[j].value
+	})
+
+	encoded := make([]string, 0, len(pairs))
+	for _, p := range pairs {
+		encoded = append(encoded, p.key+"="+p.value)
+	}
+	return strings.Join(encoded, "&")
+}
+
+// uriEncode percent-encodes everything but the unreserved characters.
+func uriEncode(s string) string {
+	// url.QueryEscape encodes spaces as "+", which S3 expects as "%20".
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
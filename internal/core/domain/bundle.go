@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BundleSlot is an entity representing a choice a guest makes when ordering a bundle,
+// either any product of a category or one of specific products.
+type BundleSlot struct {
+	Id         uuid.UUID
+	Name       string
+	CategoryId *uuid.UUID
+	ProductIds []uuid.UUID
+}
+
+// NewBundleSlot creates a new BundleSlot instance.
+func NewBundleSlot(id uuid.UUID, name string, categoryId *uuid.UUID, productIds []uuid.UUID) *BundleSlot {
+	return &BundleSlot{
+		Id:         id,
+		Name:       name,
+		CategoryId: categoryId,
+		ProductIds: productIds,
+	}
+}
+
+// Allows checks if the product can be chosen for the slot.
+func (s *BundleSlot) Allows(product *Product) bool {
+	if s.CategoryId != nil {
+		return product.Category == *s.CategoryId
+	}
+	return slices.Contains(s.ProductIds, product.Id)
+}
+
+// Bundle is an entity representing a combo of products sold at its own price.
+type Bundle struct {
+	Id          uuid.UUID
+	Name        string
+	Description string
+	Price       decimal.Decimal
+	Available   bool
+	Slots       []BundleSlot
+}
+
+// NewBundle creates a new Bundle instance.
+func NewBundle(id uuid.UUID, name, description string, price decimal.Decimal, available bool, slots []BundleSlot) *Bundle {
+	return &Bundle{
+		Id:          id,
+		Name:        name,
+		Description: description,
+		Price:       price,
+		Available:   available,
+		Slots:       slots,
+	}
+}
+
+// AddBundleSlotDTO is a DTO for adding a slot to a bundle.
+type AddBundleSlotDTO struct {
+	Name       string
+	CategoryId *uuid.UUID
+	ProductIds []uuid.UUID
+}
+
+// NewAddBundleSlotDTO creates a new AddBundleSlotDTO instance.
+func NewAddBundleSlotDTO(name string, categoryId *uuid.UUID, productIds []uuid.UUID) *AddBundleSlotDTO {
+	return &AddBundleSlotDTO{
+		Name:       name,
+		CategoryId: categoryId,
+		ProductIds: productIds,
+	}
+}
+
+// AddBundleDTO is a DTO for adding a bundle.
+type AddBundleDTO struct {
+	Name        string
+	Description string
+	Price       decimal.Decimal
+	Slots       []AddBundleSlotDTO
+}
+
+// NewAddBundleDTO creates a new AddBundleDTO instance.
+func NewAddBundleDTO(name, description string, price decimal.Decimal, slots []AddBundleSlotDTO) *AddBundleDTO {
+	return &AddBundleDTO{
+		Name:        name,
+		Description: description,
+		Price:       price,
+		Slots:       slots,
+	}
+}
+
+// BundleChoiceDTO is a DTO for the product chosen for a bundle slot.
+type BundleChoiceDTO struct {
+	SlotId    uuid.UUID
+	ProductId uuid.UUID
+	OptionIds []uuid.UUID
+	Note      *string
+}
+
+// NewBundleChoiceDTO creates a new BundleChoiceDTO instance.
+func NewBundleChoiceDTO(slotId, productId uuid.UUID, optionIds []uuid.UUID, note *string) *BundleChoiceDTO {
+	return &BundleChoiceDTO{
+		SlotId:    slotId,
+		ProductId: productId,
+		OptionIds: optionIds,
+		Note:      note,
+	}
+}
+
+// OrderBundleDTO is a DTO for ordering a bundle.
+type OrderBundleDTO struct {
+	BundleId  uuid.UUID
+	SessionId uuid.UUID
+	Quantity  int
+	Choices   []BundleChoiceDTO
+}
+
+// NewOrderBundleDTO creates a new OrderBundleDTO instance.
+func NewOrderBundleDTO(bundleId, sessionId uuid.UUID, quantity int, choices []BundleChoiceDTO) *OrderBundleDTO {
+	return &OrderBundleDTO{
+		BundleId:  bundleId,
+		SessionId: sessionId,
+		Quantity:  quantity,
+		Choices:   choices,
+	}
+}
+
+// OrderedBundle represents an ordered bundle entity. The bundle name and price are a snapshot
+// taken when it was ordered, the components are the ordered products prepared by the kitchen.
+type OrderedBundle struct {
+	Id         uuid.UUID
+	BundleId   uuid.UUID
+	SessionId  uuid.UUID
+	Name       string
+	Price      decimal.Decimal
+	Quantity   int
+	Components []OrderedProduct
+	CreatedAt  time.Time
+}
+
+// NewOrderedBundle creates a new OrderedBundle instance.
+func NewOrderedBundle(
+	id, bundleId, sessionId uuid.UUID,
+	name string,
+	price decimal.Decimal,
+	quantity int,
+	components []OrderedProduct,
+) *OrderedBundle {
+	return &OrderedBundle{
+		Id:         id,
+		BundleId:   bundleId,
+		SessionId:  sessionId,
+		Name:       name,
+		Price:      price,
+		Quantity:   quantity,
+		Components: components,
+	}
+}
+
+// BillBundleItem represents an ordered bundle on a bill. The total price includes the price
+// of modifier options selected for its components.
+type BillBundleItem struct {
+	Name       string
+	Price      decimal.Decimal
+	Quantity   int
+	Components []string
+	TotalPrice decimal.Decimal
+}
@@ -24,6 +24,18 @@ var (
 	// ErrInvalidImageFormat indicates provided image format is not valid.
 	ErrInvalidImageFormat = errors.New("invalid image format")
 
+	// ErrImageListingUnsupported indicates the image storage can't list the images it keeps.
+	ErrImageListingUnsupported = errors.New("image listing is not supported")
+
+	// ErrImageTooLarge indicates an uploaded image exceeds the maximum file size or dimensions.
+	ErrImageTooLarge = errors.New("image is too large")
+
+	// ErrImageRequired indicates an upload request without an image.
+	ErrImageRequired = errors.New("image is required")
+
+	// ErrCorruptImage indicates an uploaded image in a supported format that can't be decoded.
+	ErrCorruptImage = errors.New("image is corrupt")
+
 	// ErrProductCategoryNameAlreadyInUse indicates a product category name is already in use.
 	ErrProductCategoryNameAlreadyInUse = errors.New("product category is already in use")
 
@@ -39,6 +51,39 @@ var (
 	// ErrCategoryHasLinkedProducts indicates an attempt to delete a product category that has linked products.
 	ErrCategoryHasLinkedProducts = errors.New("category has linked products")
 
+	// ErrProductArchived indicates an attempt to order a product that is archived or belongs to an archived category.
+	ErrProductArchived = errors.New("product is archived")
+
+	// ErrProductUnavailable indicates an attempt to order a product that is currently out of stock.
+	ErrProductUnavailable = errors.New("product is unavailable")
+
+	// ErrProductOutOfSchedule indicates an attempt to order a product outside its availability schedule.
+	ErrProductOutOfSchedule = errors.New("product is not available at this time")
+
+	// ErrDuplicateScheduleWindow indicates two schedule windows start on the same weekday and time.
+	ErrDuplicateScheduleWindow = errors.New("duplicate schedule window")
+
+	// ErrInvalidScheduleWindow indicates a schedule window with an unknown weekday or a malformed time of day.
+	ErrInvalidScheduleWindow = errors.New("invalid schedule window")
+
+	// ErrProductImageNotFound indicates an image couldn't be found in the product gallery.
+	ErrProductImageNotFound = errors.New("product image not found")
+
+	// ErrProductGalleryFull indicates an attempt to add an image to a gallery that has the maximum number of images.
+	ErrProductGalleryFull = errors.New("product gallery is full")
+
+	// ErrInvalidProductImageOrder indicates a gallery order that doesn't list every image of the product exactly once.
+	ErrInvalidProductImageOrder = errors.New("invalid product image order")
+
+	// ErrInvalidNutrition indicates nutrition facts that are out of range or inconsistent.
+	ErrInvalidNutrition = errors.New("invalid nutrition")
+
+	// ErrInvalidMaxCalories indicates a calories filter that isn't a non-negative number.
+	ErrInvalidMaxCalories = errors.New("invalid max calories")
+
+	// ErrProductHasOrderHistory indicates an attempt to delete a product that was already ordered.
+	ErrProductHasOrderHistory = errors.New("product has order history")
+
 	// ErrOrderSessionNotFound indicates order session couldn't be found.
 	ErrOrderSessionNotFound = errors.New("order session not found")
 
@@ -51,6 +96,114 @@ var (
 	// ErrOrderedProductNotPending indicates users tries to delete a product that is not pending
 	ErrOrderedProductNotPending = errors.New("ordered product not pending")
 
+	// ErrOrderedProductInBundle indicates an attempt to delete a single component of an ordered bundle,
+	// which is billed as a whole.
+	ErrOrderedProductInBundle = errors.New("ordered product is part of a bundle")
+
+	// ErrModifierGroupNotFound indicates a modifier group couldn't be found.
+	ErrModifierGroupNotFound = errors.New("modifier group not found")
+
+	// ErrModifierGroupNameAlreadyInUse indicates a modifier group name is already in use for the product.
+	ErrModifierGroupNameAlreadyInUse = errors.New("modifier group name is already in use")
+
+	// ErrModifierGroupInUse indicates an attempt to delete a modifier group whose options were already ordered.
+	ErrModifierGroupInUse = errors.New("modifier group is in use")
+
+	// ErrInvalidModifierSelection indicates the selected options don't match the modifier groups of the product.
+	ErrInvalidModifierSelection = errors.New("invalid modifier selection")
+
+	// ErrInvalidQuantity indicates an ordered quantity is not a positive number.
+	ErrInvalidQuantity = errors.New("invalid quantity")
+
+	// ErrEmptyCart indicates a cart was submitted without any items.
+	ErrEmptyCart = errors.New("cart is empty")
+
+	// ErrPricingRuleNotFound indicates a pricing rule couldn't be found.
+	ErrPricingRuleNotFound = errors.New("pricing rule not found")
+
+	// ErrPricingRuleNameAlreadyInUse indicates a pricing rule name is already in use.
+	ErrPricingRuleNameAlreadyInUse = errors.New("pricing rule name is already in use")
+
+	// ErrInvalidPricingRule indicates a pricing rule has an invalid scope or value.
+	ErrInvalidPricingRule = errors.New("invalid pricing rule")
+
+	// ErrTagNotFound indicates a tag couldn't be found.
+	ErrTagNotFound = errors.New("tag not found")
+
+	// ErrTagNameAlreadyInUse indicates a tag name is already in use.
+	ErrTagNameAlreadyInUse = errors.New("tag name is already in use")
+
+	// ErrAllergenTagNotDeletable indicates an attempt to delete one of the predefined allergen tags.
+	ErrAllergenTagNotDeletable = errors.New("allergen tag can't be deleted")
+
+	// ErrInvalidLocale indicates a locale isn't a valid BCP 47 language tag.
+	ErrInvalidLocale = errors.New("invalid locale")
+
+	// ErrInvalidProductSort indicates an unknown product listing order.
+	ErrInvalidProductSort = errors.New("invalid product sort")
+
+	// ErrInvalidPagination indicates a limit or offset outside the allowed range.
+	ErrInvalidPagination = errors.New("invalid pagination")
+
+	// ErrInvalidMenuFormat indicates a menu import or export in an unsupported or malformed format.
+	ErrInvalidMenuFormat = errors.New("invalid menu format")
+
+	// ErrMenuDraftNotFound indicates there are no staged menu changes.
+	ErrMenuDraftNotFound = errors.New("menu draft not found")
+
+	// ErrInvalidMenuDraft indicates an attempt to publish a draft with invalid rows.
+	ErrInvalidMenuDraft = errors.New("invalid menu draft")
+
+	// ErrMenuDraftPending indicates a direct change to the categories or products while a menu draft is staged,
+	// which publishing the draft would overwrite.
+	ErrMenuDraftPending = errors.New("menu draft is pending")
+
+	// ErrMenuDraftChanged indicates the draft was saved again or discarded while it was being published.
+	ErrMenuDraftChanged = errors.New("menu draft was changed")
+
+	// ErrMenuVersionNotFound indicates a menu version couldn't be found.
+	ErrMenuVersionNotFound = errors.New("menu version not found")
+
+	// ErrMenuVersionConflict indicates a menu version can't be restored because its names are now used by other entries.
+	ErrMenuVersionConflict = errors.New("menu version conflicts with the current menu")
+
+	// ErrInvalidCursor indicates a pagination cursor that wasn't issued by a previous page.
+	ErrInvalidCursor = errors.New("invalid cursor")
+
+	// ErrTranslationNotFound indicates a translation couldn't be found.
+	ErrTranslationNotFound = errors.New("translation not found")
+
+	// ErrIngredientNotFound indicates an ingredient couldn't be found.
+	ErrIngredientNotFound = errors.New("ingredient not found")
+
+	// ErrIngredientNameAlreadyInUse indicates an ingredient name is already in use.
+	ErrIngredientNameAlreadyInUse = errors.New("ingredient name is already in use")
+
+	// ErrIngredientInUse indicates an attempt to delete an ingredient that is used by recipes.
+	ErrIngredientInUse = errors.New("ingredient is used by recipes")
+
+	// ErrInvalidStockCount indicates a stock count is negative.
+	ErrInvalidStockCount = errors.New("invalid stock count")
+
+	// ErrInsufficientStock indicates a stock change would make the on-hand quantity of an ingredient negative.
+	ErrInsufficientStock = errors.New("insufficient stock")
+
+	// ErrBundleNotFound indicates a bundle couldn't be found.
+	ErrBundleNotFound = errors.New("bundle not found")
+
+	// ErrBundleNameAlreadyInUse indicates a bundle name is already in use.
+	ErrBundleNameAlreadyInUse = errors.New("bundle name is already in use")
+
+	// ErrInvalidBundle indicates a bundle without slots or a slot that doesn't reference
+	// either a category or specific products.
+	ErrInvalidBundle = errors.New("invalid bundle")
+
+	// ErrBundleUnavailable indicates an attempt to order a bundle that is switched off.
+	ErrBundleUnavailable = errors.New("bundle is unavailable")
+
+	// ErrInvalidBundleSelection indicates bundle choices that don't pick exactly one allowed product for every slot.
+	ErrInvalidBundleSelection = errors.New("invalid bundle selection")
+
 	// ErrProductsAreIncomplete indicates an user tires to get a bill, when there are still uncompleted products.
 	ErrProductsAreIncomplete = errors.New("products are incomplete")
 )
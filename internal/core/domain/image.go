@@ -1,7 +1,127 @@
 package domain
 
+import "bytes"
+
+// MaxImageDimension is the largest accepted width and height of an uploaded image in pixels.
+const MaxImageDimension = 6000
+
+// imageTypes lists the supported image formats with the file extensions they are saved with
+// and the signatures their files start with.
+var imageTypes = []struct {
+	contentType string
+	extension   string
+	matches     func(head []byte) bool
+}{
+	{
+		contentType: "image/jpeg",
+		extension:   ".jpg",
+		matches: func(head []byte) bool {
+			return bytes.HasPrefix(head, []byte("\xff\xd8\xff"))
+		},
+	},
+	{
+		contentType: "image/png",
+		extension:   ".png",
+		matches: func(head []byte) bool {
+			return bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n"))
+		},
+	},
+	{
+		contentType: "image/webp",
+		extension:   ".webp",
+		// The RIFF header holds the file size between the two tags.
+		matches: func(head []byte) bool {
+			return len(head) >= 14 && string(head[:4]) == "RIFF" && string(head[8:14]) == "WEBPVP"
+		},
+	},
+}
+
 // Image represents an image entity.
 type Image struct {
 	Url       string
 	DeleteUrl string
 }
+
+// DetectImageType detects the content type of an image from the signature at its start and returns it
+// with the file extension for it. It returns ErrInvalidImageFormat for unsupported formats.
+func DetectImageType(head []byte) (contentType, extension string, err error) {
+	for _, imageType := range imageTypes {
+		if imageType.matches(head) {
+			return imageType.contentType, imageType.extension, nil
+		}
+	}
+	return "", "", ErrInvalidImageFormat
+}
+
+// ImageVariant is a size an uploaded image is resized to.
+type ImageVariant string
+
+const (
+	ThumbnailImageVariant ImageVariant = "thumbnail"
+	MediumImageVariant    ImageVariant = "medium"
+	LargeImageVariant     ImageVariant = "large"
+)
+
+// ImageVariants lists the variants from the smallest to the largest.
+var ImageVariants = []ImageVariant{ThumbnailImageVariant, MediumImageVariant, LargeImageVariant}
+
+// MaxEdge returns the length in pixels the longest edge of the image is scaled down to.
+// Smaller images keep their size.
+func (v ImageVariant) MaxEdge() int {
+	switch v {
+	case ThumbnailImageVariant:
+		return 200
+	case MediumImageVariant:
+		return 640
+	default:
+		return 1280
+	}
+}
+
+// ProcessedImage is an encoded image variant ready to be saved.
+type ProcessedImage struct {
+	Variant ImageVariant
+	Data    []byte
+}
+
+// NewProcessedImage creates a new ProcessedImage instance.
+func NewProcessedImage(variant ImageVariant, data []byte) *ProcessedImage {
+	return &ProcessedImage{
+		Variant: variant,
+		Data:    data,
+	}
+}
+
+// ImageSet holds the saved variants of an image by variant.
+type ImageSet map[ImageVariant]Image
+
+// Main returns the image shown where no particular size is asked for, which is the largest variant.
+func (s ImageSet) Main() (Image, bool) {
+	image, ok := s[LargeImageVariant]
+	return image, ok
+}
+
+// Images returns all variants from the smallest to the largest.
+func (s ImageSet) Images() []Image {
+	images := make([]Image, 0, len(s))
+	for _, variant := range ImageVariants {
+		if image, ok := s[variant]; ok {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+// DeleteUrls returns the delete urls of all variants.
+func (s ImageSet) DeleteUrls() []string {
+	return deleteUrls(s.Images())
+}
+
+// deleteUrls returns the delete urls of the images.
+func deleteUrls(images []Image) []string {
+	urls := make([]string, 0, len(images))
+	for _, image := range images {
+		urls = append(urls, image.DeleteUrl)
+	}
+	return urls
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// PendingUploadTimeout is how long an uploaded image may stay unlinked from a product
+	// before it is considered abandoned and deleted.
+	PendingUploadTimeout = 15 * time.Minute
+
+	// maxImageOperationRetryDelay caps the exponential backoff of failed image operations.
+	maxImageOperationRetryDelay = 24 * time.Hour
+)
+
+// ImageOperationKind describes why an image operation was recorded.
+type ImageOperationKind string
+
+const (
+	// UploadImageOperation is an uploaded image that isn't linked to a product yet. Linking the image
+	// completes the operation, otherwise the image is deleted once the operation is due.
+	UploadImageOperation ImageOperationKind = "upload"
+	// DeleteImageOperation is an image that is no longer used and waits to be deleted.
+	DeleteImageOperation ImageOperationKind = "delete"
+)
+
+// ImageOperation is an entity representing an image that has to be deleted from the image
+// storage once it is due. Failed operations are retried until they succeed.
+type ImageOperation struct {
+	Id   uuid.UUID
+	Kind ImageOperationKind
+	// Url is the url the image is shown under, which menu versions and the draft refer to it by.
+	Url           string
+	DeleteUrl     string
+	Attempts      int
+	LastError     *string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+}
+
+// NewImageOperation creates a new ImageOperation instance.
+func NewImageOperation(kind ImageOperationKind, image Image, nextAttemptAt time.Time) *ImageOperation {
+	return &ImageOperation{
+		Id:            uuid.New(),
+		Kind:          kind,
+		Url:           image.Url,
+		DeleteUrl:     image.DeleteUrl,
+		NextAttemptAt: nextAttemptAt,
+	}
+}
+
+// NewImageOperations creates an operation of the kind for every image.
+func NewImageOperations(kind ImageOperationKind, images []Image, nextAttemptAt time.Time) []ImageOperation {
+	operations := make([]ImageOperation, 0, len(images))
+	for _, image := range images {
+		operations = append(operations, *NewImageOperation(kind, image, nextAttemptAt))
+	}
+	return operations
+}
+
+// IsUsed reports whether the image of the operation is among the used urls.
+func (o *ImageOperation) IsUsed(used map[string]struct{}) bool {
+	if _, ok := used[o.Url]; ok {
+		return true
+	}
+	_, ok := used[o.DeleteUrl]
+	return ok
+}
+
+// RetryDelay returns how long to wait before attempting a failed operation again. It doubles
+// with every attempt, starting at a minute and capped at a day.
+func (o *ImageOperation) RetryDelay() time.Duration {
+	delay := time.Minute
+	for attempt := 1; attempt < o.Attempts && delay < maxImageOperationRetryDelay; attempt++ {
+		delay *= 2
+	}
+	return min(delay, maxImageOperationRetryDelay)
+}
+
+// StoredImage is an image found in the image storage.
+type StoredImage struct {
+	Image
+	UploadedAt time.Time
+}
@@ -0,0 +1,37 @@
+package domain_test
+
+import (
+	"restaurant/internal/core/domain"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectImageType(t *testing.T) {
+	tests := []struct {
+		name                string
+		head                []byte
+		expectedContentType string
+		expectedExtension   string
+		expectedError       error
+	}{
+		{name: "jpeg", head: []byte("\xff\xd8\xff\xe0"), expectedContentType: "image/jpeg", expectedExtension: ".jpg"},
+		{name: "png", head: []byte("\x89PNG\r\n\x1a\n"), expectedContentType: "image/png", expectedExtension: ".png"},
+		{name: "webp", head: []byte("RIFF\x00\x00\x00\x00WEBPVP8L"), expectedContentType: "image/webp", expectedExtension: ".webp"},
+		{name: "riff without webp", head: []byte("RIFF\x00\x00\x00\x00WAVEfmt "), expectedError: domain.ErrInvalidImageFormat},
+		{name: "truncated webp", head: []byte("RIFF\x00\x00\x00\x00WEBP"), expectedError: domain.ErrInvalidImageFormat},
+		{name: "truncated png", head: []byte("\x89PNG"), expectedError: domain.ErrInvalidImageFormat},
+		{name: "empty", head: nil, expectedError: domain.ErrInvalidImageFormat},
+		{name: "gif", head: []byte("GIF89a"), expectedError: domain.ErrInvalidImageFormat},
+		{name: "text", head: []byte("not an image"), expectedError: domain.ErrInvalidImageFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentType, extension, err := domain.DetectImageType(tt.head)
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedContentType, contentType)
+			require.Equal(t, tt.expectedExtension, extension)
+		})
+	}
+}
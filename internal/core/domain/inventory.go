@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Ingredient is an entity representing a stocked ingredient.
+type Ingredient struct {
+	Id     uuid.UUID
+	Name   string
+	Unit   string
+	OnHand decimal.Decimal
+}
+
+// NewIngredient creates a new Ingredient instance.
+func NewIngredient(id uuid.UUID, name, unit string, onHand decimal.Decimal) *Ingredient {
+	return &Ingredient{
+		Id:     id,
+		Name:   name,
+		Unit:   unit,
+		OnHand: onHand,
+	}
+}
+
+// AddIngredientDTO is a DTO for adding an ingredient.
+type AddIngredientDTO struct {
+	Name   string
+	Unit   string
+	OnHand decimal.Decimal
+}
+
+// NewAddIngredientDTO creates a new AddIngredientDTO instance.
+func NewAddIngredientDTO(name, unit string, onHand decimal.Decimal) *AddIngredientDTO {
+	return &AddIngredientDTO{
+		Name:   name,
+		Unit:   unit,
+		OnHand: onHand,
+	}
+}
+
+// RecipeItem represents the amount of an ingredient needed to prepare a product.
+type RecipeItem struct {
+	ProductId    uuid.UUID
+	IngredientId uuid.UUID
+	Amount       decimal.Decimal
+}
+
+// NewRecipeItem creates a new RecipeItem instance.
+func NewRecipeItem(productId, ingredientId uuid.UUID, amount decimal.Decimal) *RecipeItem {
+	return &RecipeItem{
+		ProductId:    productId,
+		IngredientId: ingredientId,
+		Amount:       amount,
+	}
+}
+
+// AvailabilityChange is a product that became available or unavailable because of the stock of its ingredients.
+type AvailabilityChange struct {
+	ProductId uuid.UUID
+	Available bool
+}
+
+// StockMovementKind is an enum for stock movement kinds.
+type StockMovementKind string
+
+const (
+	StockAdjustment  StockMovementKind = "adjustment"
+	StockCount       StockMovementKind = "count"
+	StockConsumption StockMovementKind = "consumption"
+)
+
+// StockMovement represents a change of the on-hand quantity of an ingredient.
+type StockMovement struct {
+	Id           uuid.UUID
+	IngredientId uuid.UUID
+	Kind         StockMovementKind
+	Delta        decimal.Decimal
+	Reason       *string
+	CreatedAt    time.Time
+}
+
+// StockAdjustmentDTO is a DTO for adjusting the stock of an ingredient by a relative amount.
+type StockAdjustmentDTO struct {
+	IngredientId uuid.UUID
+	Delta        decimal.Decimal
+	Reason       *string
+}
+
+// NewStockAdjustmentDTO creates a new StockAdjustmentDTO instance.
+func NewStockAdjustmentDTO(ingredientId uuid.UUID, delta decimal.Decimal, reason *string) *StockAdjustmentDTO {
+	return &StockAdjustmentDTO{
+		IngredientId: ingredientId,
+		Delta:        delta,
+		Reason:       reason,
+	}
+}
+
+// StockCountDTO is a DTO for recording a physical stock count of an ingredient.
+type StockCountDTO struct {
+	IngredientId uuid.UUID
+	Counted      decimal.Decimal
+	Reason       *string
+}
+
+// NewStockCountDTO creates a new StockCountDTO instance.
+func NewStockCountDTO(ingredientId uuid.UUID, counted decimal.Decimal, reason *string) *StockCountDTO {
+	return &StockCountDTO{
+		IngredientId: ingredientId,
+		Counted:      counted,
+		Reason:       reason,
+	}
+}
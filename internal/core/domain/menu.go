@@ -0,0 +1,269 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// maxMenuPrice is the first price that doesn't fit into the price columns.
+var maxMenuPrice = decimal.NewFromInt(1_000_000)
+
+// Menu is the full set of product categories and products, including archived ones.
+type Menu struct {
+	Categories []ProductCategory
+	Products   []Product
+}
+
+// NewMenu creates a new Menu instance.
+func NewMenu(categories []ProductCategory, products []Product) *Menu {
+	return &Menu{
+		Categories: categories,
+		Products:   products,
+	}
+}
+
+// Rows converts the categories and products of the menu that are not archived into rows,
+// one per product or category without products.
+func (m *Menu) Rows() []MenuRow {
+	productsByCategory := make(map[uuid.UUID][]Product, len(m.Categories))
+	for _, product := range m.Products {
+		if product.ArchivedAt == nil {
+			productsByCategory[product.Category] = append(productsByCategory[product.Category], product)
+		}
+	}
+
+	rows := make([]MenuRow, 0, len(m.Products))
+	for _, category := range m.Categories {
+		if category.ArchivedAt != nil {
+			continue
+		}
+
+		products := productsByCategory[category.Id]
+		if len(products) == 0 {
+			rows = append(rows, *NewMenuRow(&category.Id, category.Name, nil, "", "", decimal.Zero, false, nil))
+			continue
+		}
+		for _, product := range products {
+			rows = append(rows, *NewMenuRow(
+				&category.Id,
+				category.Name,
+				&product.Id,
+				product.Name,
+				product.Description,
+				product.Price,
+				product.Available,
+				product.ImageUrl,
+			))
+		}
+	}
+	return rows
+}
+
+// MenuRow is a single line of an exported or imported menu. Every product has its own row
+// with its category, and a row without a product name describes a category without products.
+// Ids are optional on import, rows without them are matched to existing entries by name.
+type MenuRow struct {
+	CategoryId   *uuid.UUID
+	CategoryName string
+	ProductId    *uuid.UUID
+	ProductName  string
+	Description  string
+	Price        decimal.Decimal
+	Available    bool
+	ImageUrl     *string
+}
+
+// NewMenuRow creates a new MenuRow instance.
+func NewMenuRow(
+	categoryId *uuid.UUID,
+	categoryName string,
+	productId *uuid.UUID,
+	productName, description string,
+	price decimal.Decimal,
+	available bool,
+	imageUrl *string,
+) *MenuRow {
+	return &MenuRow{
+		CategoryId:   categoryId,
+		CategoryName: categoryName,
+		ProductId:    productId,
+		ProductName:  productName,
+		Description:  description,
+		Price:        price,
+		Available:    available,
+		ImageUrl:     imageUrl,
+	}
+}
+
+// HasProduct checks if the row describes a product and not only a category.
+func (r *MenuRow) HasProduct() bool {
+	return r.ProductId != nil || r.ProductName != ""
+}
+
+// Validate checks the row on its own and returns a message for every invalid field.
+func (r *MenuRow) Validate() []string {
+	var messages []string
+	if n := utf8.RuneCountInString(r.CategoryName); n < 4 || n > 100 {
+		messages = append(messages, "categoryName must be between 4 and 100 characters.")
+	}
+	if !r.HasProduct() {
+		return messages
+	}
+
+	if n := utf8.RuneCountInString(r.ProductName); n < 3 || n > 100 {
+		messages = append(messages, "productName must be between 3 and 100 characters.")
+	}
+	if utf8.RuneCountInString(r.Description) < 15 {
+		messages = append(messages, "description must be at least 15 characters.")
+	}
+	if !r.Price.IsPositive() || r.Price.GreaterThanOrEqual(maxMenuPrice) || !r.Price.Equal(r.Price.Round(2)) {
+		messages = append(messages, fmt.Sprintf("price must be positive, below %s and have at most 2 decimal places.", maxMenuPrice))
+	}
+	if r.ImageUrl != nil && utf8.RuneCountInString(*r.ImageUrl) > 200 {
+		messages = append(messages, "imageUrl must be at most 200 characters.")
+	}
+	return messages
+}
+
+// ImportMenuDTO is a DTO for importing a menu.
+type ImportMenuDTO struct {
+	Rows   []MenuRow
+	DryRun bool
+}
+
+// NewImportMenuDTO creates a new ImportMenuDTO instance.
+func NewImportMenuDTO(rows []MenuRow, dryRun bool) *ImportMenuDTO {
+	return &ImportMenuDTO{
+		Rows:   rows,
+		DryRun: dryRun,
+	}
+}
+
+// MenuRowError holds the validation errors of a single imported row.
+// Rows are numbered from 1 in the order they were imported.
+type MenuRowError struct {
+	Row      int
+	Messages []string
+}
+
+// NewMenuRowError creates a new MenuRowError instance.
+func NewMenuRowError(row int, messages ...string) *MenuRowError {
+	return &MenuRowError{
+		Row:      row,
+		Messages: messages,
+	}
+}
+
+// MenuImportReport is the outcome of a menu import. The menu is only changed
+// when it isn't a dry run and there are no errors.
+type MenuImportReport struct {
+	DryRun            bool
+	Applied           bool
+	CategoriesCreated int
+	CategoriesUpdated int
+	ProductsCreated   int
+	ProductsUpdated   int
+	Errors            []MenuRowError
+}
+
+// NewMenuImportReport creates a new MenuImportReport instance.
+func NewMenuImportReport(dryRun bool) *MenuImportReport {
+	return &MenuImportReport{
+		DryRun: dryRun,
+	}
+}
+
+// AddError records validation errors of a row, merging them with earlier errors of the same row.
+func (r *MenuImportReport) AddError(row int, messages ...string) {
+	for i := range r.Errors {
+		if r.Errors[i].Row == row {
+			r.Errors[i].Messages = append(r.Errors[i].Messages, messages...)
+			return
+		}
+	}
+	r.Errors = append(r.Errors, *NewMenuRowError(row, messages...))
+}
+
+// MenuDraft is an entity representing staged menu changes that guests don't see until they are published.
+// While a draft is staged, categories and products can only be changed through it.
+type MenuDraft struct {
+	Rows []MenuRow
+	// CreatedAt is when the draft was first saved. Publishing it only archives the live entries that
+	// existed by then, so entries created afterwards aren't lost.
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewMenuDraft creates a new MenuDraft instance.
+func NewMenuDraft(rows []MenuRow) *MenuDraft {
+	return &MenuDraft{
+		Rows: rows,
+	}
+}
+
+// MenuPreview shows the menu a draft would publish and how it differs from the live menu.
+type MenuPreview struct {
+	Rows               []MenuRow
+	Report             *MenuImportReport
+	CategoriesArchived int
+	ProductsArchived   int
+}
+
+// NewMenuPreview creates a new MenuPreview instance.
+func NewMenuPreview(rows []MenuRow, report *MenuImportReport, categoriesArchived, productsArchived int) *MenuPreview {
+	return &MenuPreview{
+		Rows:               rows,
+		Report:             report,
+		CategoriesArchived: categoriesArchived,
+		ProductsArchived:   productsArchived,
+	}
+}
+
+// MenuVersion is an entity representing a published menu. Versions are numbered from 1
+// and keep the published rows and details, so the menu can be rolled back to them.
+type MenuVersion struct {
+	Number int
+	Rows   []MenuRow
+	// Details are captured when the version is published. Versions published before details
+	// were kept have none, rolling back to them leaves the current details as they are.
+	Details      *MenuDetails
+	RestoredFrom *int
+	PublishedAt  time.Time
+}
+
+// NewMenuVersion creates a new MenuVersion instance. The number is assigned when the version is published.
+func NewMenuVersion(rows []MenuRow, restoredFrom *int) *MenuVersion {
+	return &MenuVersion{
+		Rows:         rows,
+		RestoredFrom: restoredFrom,
+	}
+}
+
+// MenuDetails is everything a menu version keeps besides its rows: the details of its categories
+// and products and all pricing rules.
+type MenuDetails struct {
+	Categories   []CategoryDetails
+	Products     []ProductDetails
+	PricingRules []PricingRule
+}
+
+// CategoryDetails are the parts of a category that a menu version keeps besides its row.
+type CategoryDetails struct {
+	CategoryId   uuid.UUID
+	Schedule     []ScheduleWindow
+	Translations []CategoryTranslation
+}
+
+// ProductDetails are the parts of a product that a menu version keeps besides its row.
+type ProductDetails struct {
+	ProductId      uuid.UUID
+	Nutrition      Nutrition
+	TagIds         []uuid.UUID
+	Schedule       []ScheduleWindow
+	Translations   []ProductTranslation
+	ModifierGroups []ModifierGroup
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ModifierOption is an entity representing a selectable option of a modifier group.
+type ModifierOption struct {
+	Id         uuid.UUID
+	GroupId    uuid.UUID
+	Name       string
+	PriceDelta decimal.Decimal
+}
+
+// NewModifierOption creates a new ModifierOption instance.
+func NewModifierOption(id, groupId uuid.UUID, name string, priceDelta decimal.Decimal) *ModifierOption {
+	return &ModifierOption{
+		Id:         id,
+		GroupId:    groupId,
+		Name:       name,
+		PriceDelta: priceDelta,
+	}
+}
+
+// ModifierGroup is an entity representing a group of options a product can be customized with.
+type ModifierGroup struct {
+	Id          uuid.UUID
+	ProductId   uuid.UUID
+	Name        string
+	MinSelected int
+	MaxSelected int
+	Options     []ModifierOption
+}
+
+// NewModifierGroup creates a new ModifierGroup instance.
+func NewModifierGroup(id, productId uuid.UUID, name string, minSelected, maxSelected int, options []ModifierOption) *ModifierGroup {
+	return &ModifierGroup{
+		Id:          id,
+		ProductId:   productId,
+		Name:        name,
+		MinSelected: minSelected,
+		MaxSelected: maxSelected,
+		Options:     options,
+	}
+}
+
+// AddModifierOptionDTO is a DTO for adding an option to a modifier group.
+type AddModifierOptionDTO struct {
+	Name       string
+	PriceDelta decimal.Decimal
+}
+
+// NewAddModifierOptionDTO creates a new AddModifierOptionDTO instance.
+func NewAddModifierOptionDTO(name string, priceDelta decimal.Decimal) *AddModifierOptionDTO {
+	return &AddModifierOptionDTO{
+		Name:       name,
+		PriceDelta: priceDelta,
+	}
+}
+
+// AddModifierGroupDTO is a DTO for adding a modifier group to a product.
+type AddModifierGroupDTO struct {
+	ProductId   uuid.UUID
+	Name        string
+	MinSelected int
+	MaxSelected int
+	Options     []AddModifierOptionDTO
+}
+
+// NewAddModifierGroupDTO creates a new AddModifierGroupDTO instance.
+func NewAddModifierGroupDTO(productId uuid.UUID, name string, minSelected, maxSelected int, options []AddModifierOptionDTO) *AddModifierGroupDTO {
+	return &AddModifierGroupDTO{
+		ProductId:   productId,
+		Name:        name,
+		MinSelected: minSelected,
+		MaxSelected: maxSelected,
+		Options:     options,
+	}
+}
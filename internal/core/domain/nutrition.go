@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// MaxCalories is the largest accepted energy of a portion in kcal.
+	MaxCalories = 10_000
+	// MaxPortionSizeLength is the longest accepted portion size description.
+	MaxPortionSizeLength = 50
+)
+
+// maxNutrientGrams is the first amount of a nutrient in grams that doesn't fit into the nutrition columns.
+var maxNutrientGrams = decimal.NewFromInt(10_000)
+
+// Nutrition holds the nutrition facts of a portion of a product. Every fact is optional,
+// unknown facts are nil.
+type Nutrition struct {
+	// Calories is the energy of the portion in kcal.
+	Calories *int
+	// Protein, Fat, Carbohydrates, Sugar and Salt are amounts in grams.
+	Protein       *decimal.Decimal
+	Fat           *decimal.Decimal
+	Carbohydrates *decimal.Decimal
+	Sugar         *decimal.Decimal
+	Salt          *decimal.Decimal
+	// PortionSize describes the portion the facts refer to, e.g. "250 g" or "0.5 l".
+	PortionSize *string
+}
+
+// NewNutrition creates a new Nutrition instance.
+func NewNutrition(calories *int, protein, fat, carbohydrates, sugar, salt *decimal.Decimal, portionSize *string) *Nutrition {
+	return &Nutrition{
+		Calories:      calories,
+		Protein:       protein,
+		Fat:           fat,
+		Carbohydrates: carbohydrates,
+		Sugar:         sugar,
+		Salt:          salt,
+		PortionSize:   portionSize,
+	}
+}
+
+// IsEmpty checks if none of the nutrition facts is known.
+func (n *Nutrition) IsEmpty() bool {
+	return n.Calories == nil &&
+		n.Protein == nil &&
+		n.Fat == nil &&
+		n.Carbohydrates == nil &&
+		n.Sugar == nil &&
+		n.Salt == nil &&
+		n.PortionSize == nil
+}
+
+// Validate checks that the facts are within range and consistent. It returns ErrInvalidNutrition otherwise.
+func (n *Nutrition) Validate() error {
+	if n.Calories != nil && (*n.Calories < 0 || *n.Calories > MaxCalories) {
+		return ErrInvalidNutrition
+	}
+	for _, grams := range []*decimal.Decimal{n.Protein, n.Fat, n.Carbohydrates, n.Sugar, n.Salt} {
+		if grams != nil && (grams.IsNegative() || grams.GreaterThanOrEqual(maxNutrientGrams)) {
+			return ErrInvalidNutrition
+		}
+	}
+	// Sugars are a part of the carbohydrates.
+	if n.Sugar != nil && n.Carbohydrates != nil && n.Sugar.GreaterThan(*n.Carbohydrates) {
+		return ErrInvalidNutrition
+	}
+	if n.PortionSize != nil {
+		if length := utf8.RuneCountInString(*n.PortionSize); length == 0 || length > MaxPortionSizeLength {
+			return ErrInvalidNutrition
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package domain_test
+
+import (
+	"restaurant/internal/core/domain"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNutrition_Validate(t *testing.T) {
+	intPtr := func(value int) *int { return &value }
+	grams := func(value string) *decimal.Decimal {
+		parsed := decimal.RequireFromString(value)
+		return &parsed
+	}
+	portion := func(value string) *string { return &value }
+
+	tests := []struct {
+		name          string
+		nutrition     domain.Nutrition
+		expectedError error
+	}{
+		{name: "empty", nutrition: domain.Nutrition{}},
+		{
+			name: "complete",
+			nutrition: *domain.NewNutrition(
+				intPtr(650), grams("32.5"), grams("28"), grams("61.2"), grams("9.4"), grams("2.35"), portion("350 g"),
+			),
+		},
+		{name: "negative calories", nutrition: domain.Nutrition{Calories: intPtr(-1)}, expectedError: domain.ErrInvalidNutrition},
+		{name: "too many calories", nutrition: domain.Nutrition{Calories: intPtr(domain.MaxCalories + 1)}, expectedError: domain.ErrInvalidNutrition},
+		{name: "negative fat", nutrition: domain.Nutrition{Fat: grams("-0.1")}, expectedError: domain.ErrInvalidNutrition},
+		{name: "too much salt", nutrition: domain.Nutrition{Salt: grams("10000")}, expectedError: domain.ErrInvalidNutrition},
+		{
+			name:          "more sugar than carbohydrates",
+			nutrition:     domain.Nutrition{Carbohydrates: grams("10"), Sugar: grams("10.5")},
+			expectedError: domain.ErrInvalidNutrition,
+		},
+		{name: "sugar without carbohydrates", nutrition: domain.Nutrition{Sugar: grams("10.5")}},
+		{name: "empty portion size", nutrition: domain.Nutrition{PortionSize: portion("")}, expectedError: domain.ErrInvalidNutrition},
+		{
+			name:          "long portion size",
+			nutrition:     domain.Nutrition{PortionSize: portion(strings.Repeat("g", domain.MaxPortionSizeLength+1))},
+			expectedError: domain.ErrInvalidNutrition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.nutrition.Validate(), tt.expectedError)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -20,6 +22,7 @@ type OrderSession struct {
 	Id          uuid.UUID
 	TableNumber int
 	Status      OrderSessionStatus
+	CreatedAt   time.Time
 }
 
 // NewSession creates a new OrderSession instance.
@@ -46,15 +49,60 @@ type OrderedProduct struct {
 	ProductId      uuid.UUID
 	OrderSessionID uuid.UUID
 	Status         OrderedProductStatus
+	Options        []ModifierOption
+	Quantity       int
+	Note           *string
+	ProductName    string
+	UnitPrice      decimal.Decimal
+	// PricingRuleName is the name of the pricing rule that set UnitPrice, if any.
+	PricingRuleName *string
+	// OrderedBundleId is the ordered bundle the product is a component of, if any.
+	OrderedBundleId *uuid.UUID
+	CreatedAt       time.Time
 }
 
 // NewOrderedProduct creates a new OrderedProduct instance.
-func NewOrderedProduct(id, productId, orderSessionID uuid.UUID, status OrderedProductStatus) *OrderedProduct {
+func NewOrderedProduct(
+	id, productId, orderSessionID uuid.UUID,
+	status OrderedProductStatus,
+	options []ModifierOption,
+	quantity int,
+	note *string,
+	productName string,
+	unitPrice decimal.Decimal,
+	pricingRuleName *string,
+) *OrderedProduct {
 	return &OrderedProduct{
-		Id:             id,
-		ProductId:      productId,
-		OrderSessionID: orderSessionID,
-		Status:         status,
+		Id:              id,
+		ProductId:       productId,
+		OrderSessionID:  orderSessionID,
+		Status:          status,
+		Options:         options,
+		Quantity:        quantity,
+		Note:            note,
+		ProductName:     productName,
+		UnitPrice:       unitPrice,
+		PricingRuleName: pricingRuleName,
+	}
+}
+
+// OrderProductDTO is a DTO for ordering a product.
+type OrderProductDTO struct {
+	ProductId uuid.UUID
+	SessionId uuid.UUID
+	OptionIds []uuid.UUID
+	Quantity  int
+	Note      *string
+}
+
+// NewOrderProductDTO creates a new OrderProductDTO instance.
+func NewOrderProductDTO(productId, sessionId uuid.UUID, optionIds []uuid.UUID, quantity int, note *string) *OrderProductDTO {
+	return &OrderProductDTO{
+		ProductId: productId,
+		SessionId: sessionId,
+		OptionIds: optionIds,
+		Quantity:  quantity,
+		Note:      note,
 	}
 }
 
@@ -77,20 +125,95 @@ func NewUpdateOrderSessionDTO(id uuid.UUID, newTableNumber *int, newStatus *Orde
 // Bill represents a bill entity.
 type Bill struct {
 	Items     []BillItem
+	Bundles   []BillBundleItem
 	FullPrice decimal.Decimal
 }
 
 // NewBill creates a new Bill instance.
-func NewBill(items []BillItem, fullPrice decimal.Decimal) *Bill {
+func NewBill(items []BillItem, bundles []BillBundleItem, fullPrice decimal.Decimal) *Bill {
 	return &Bill{
 		Items:     items,
+		Bundles:   bundles,
 		FullPrice: fullPrice,
 	}
 }
 
 // BillItem represent a bill item entity.
 type BillItem struct {
-	Product    Product
-	Quantity   int
-	TotalPrice decimal.Decimal
+	Product         Product
+	Options         []ModifierOption
+	Quantity        int
+	TotalPrice      decimal.Decimal
+	PricingRuleName *string
+}
+
+// GetSessionsDTO is a DTO for getting a page of order sessions, from the newest to the oldest.
+type GetSessionsDTO struct {
+	Status        *OrderSessionStatus
+	TableNumber   *int
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Cursor        *Cursor
+	Limit         int
+}
+
+// NewGetSessionsDTO creates a new GetSessionsDTO instance.
+func NewGetSessionsDTO(
+	status *OrderSessionStatus,
+	tableNumber *int,
+	createdAfter, createdBefore *time.Time,
+	cursor *Cursor,
+	limit int,
+) *GetSessionsDTO {
+	return &GetSessionsDTO{
+		Status:        status,
+		TableNumber:   tableNumber,
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+		Cursor:        cursor,
+		Limit:         limit,
+	}
+}
+
+// OrderSessionPage is a page of order sessions with the cursor of the next page, if there is one.
+type OrderSessionPage struct {
+	Sessions   []OrderSession
+	NextCursor *Cursor
+}
+
+// GetOrderedProductsDTO is a DTO for getting a page of ordered products, from the newest to the oldest.
+type GetOrderedProductsDTO struct {
+	Status        *OrderedProductStatus
+	SessionId     *uuid.UUID
+	TableNumber   *int
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Cursor        *Cursor
+	Limit         int
+}
+
+// NewGetOrderedProductsDTO creates a new GetOrderedProductsDTO instance.
+func NewGetOrderedProductsDTO(
+	status *OrderedProductStatus,
+	sessionId *uuid.UUID,
+	tableNumber *int,
+	createdAfter, createdBefore *time.Time,
+	cursor *Cursor,
+	limit int,
+) *GetOrderedProductsDTO {
+	return &GetOrderedProductsDTO{
+		Status:        status,
+		SessionId:     sessionId,
+		TableNumber:   tableNumber,
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+		Cursor:        cursor,
+		Limit:         limit,
+	}
+}
+
+// OrderedProductPage is a page of ordered products with the cursor of the next page, if there is one.
+type OrderedProductPage struct {
+	OrderedProducts []OrderedProduct
+	NextCursor      *Cursor
 }
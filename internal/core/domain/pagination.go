@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultOrdersLimit is the page size of order listings used when none is requested.
+	DefaultOrdersLimit = 50
+	// MaxOrdersLimit is the largest page size of order listings that can be requested.
+	MaxOrdersLimit = 100
+)
+
+// Cursor points at the last item of a page of a listing ordered from the newest to the oldest item.
+type Cursor struct {
+	CreatedAt time.Time
+	Id        uuid.UUID
+}
+
+// NewCursor creates a new Cursor instance.
+func NewCursor(createdAt time.Time, id uuid.UUID) *Cursor {
+	return &Cursor{
+		CreatedAt: createdAt,
+		Id:        id,
+	}
+}
+
+// String encodes the cursor into an opaque token.
+func (c *Cursor) String() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.Id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor decodes a token created by Cursor.String.
+func ParseCursor(token string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	createdAt, id, found := strings.Cut(string(raw), "|")
+	if !found {
+		return nil, ErrInvalidCursor
+	}
+
+	cursor := &Cursor{}
+	if cursor.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Id, err = uuid.Parse(id); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return cursor, nil
+}
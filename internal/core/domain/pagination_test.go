@@ -0,0 +1,37 @@
+package domain_test
+
+import (
+	"restaurant/internal/core/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCursor(t *testing.T) {
+	cursor := domain.NewCursor(time.Date(2024, time.January, 1, 18, 30, 0, 123456000, time.UTC), uuid.New())
+
+	tests := []struct {
+		name          string
+		token         string
+		expected      *domain.Cursor
+		expectedError error
+	}{
+		{name: "round trip", token: cursor.String(), expected: cursor},
+		{name: "not base64", token: "%%%", expectedError: domain.ErrInvalidCursor},
+		{name: "missing separator", token: "bm90LWEtY3Vyc29y", expectedError: domain.ErrInvalidCursor},
+		{name: "empty", token: "", expectedError: domain.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := domain.ParseCursor(tt.token)
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expected != nil {
+				require.True(t, tt.expected.CreatedAt.Equal(parsed.CreatedAt))
+				require.Equal(t, tt.expected.Id, parsed.Id)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PriceAdjustmentKind is an enum for the ways a pricing rule changes a price.
+type PriceAdjustmentKind string
+
+const (
+	// PercentageDiscount takes a percentage off the regular price.
+	PercentageDiscount PriceAdjustmentKind = "percentage"
+	// FixedPrice replaces the regular price.
+	FixedPrice PriceAdjustmentKind = "fixed_price"
+)
+
+// PricingRule is an entity representing a price adjustment of a product or of all products in a category
+// during its windows. A rule without windows is always active.
+type PricingRule struct {
+	Id         uuid.UUID
+	Name       string
+	ProductId  *uuid.UUID
+	CategoryId *uuid.UUID
+	Kind       PriceAdjustmentKind
+	Value      decimal.Decimal
+	Windows    []ScheduleWindow
+}
+
+// NewPricingRule creates a new PricingRule instance.
+func NewPricingRule(
+	id uuid.UUID,
+	name string,
+	productId, categoryId *uuid.UUID,
+	kind PriceAdjustmentKind,
+	value decimal.Decimal,
+	windows []ScheduleWindow,
+) *PricingRule {
+	return &PricingRule{
+		Id:         id,
+		Name:       name,
+		ProductId:  productId,
+		CategoryId: categoryId,
+		Kind:       kind,
+		Value:      value,
+		Windows:    windows,
+	}
+}
+
+// Apply returns the price after the rule adjustment, rounded to cents.
+func (r *PricingRule) Apply(price decimal.Decimal) decimal.Decimal {
+	switch r.Kind {
+	case PercentageDiscount:
+		discount := price.Mul(r.Value).Div(decimal.NewFromInt(100))
+		return price.Sub(discount).Round(2)
+	case FixedPrice:
+		return r.Value
+	default:
+		return price
+	}
+}
+
+// BestPricingRule returns the rule active at t that results in the lowest price,
+// or nil when none of the rules is active.
+func BestPricingRule(rules []PricingRule, price decimal.Decimal, t time.Time) (*PricingRule, error) {
+	var best *PricingRule
+	for i := range rules {
+		active, err := IsWithinSchedule(rules[i].Windows, t)
+		if err != nil {
+			return nil, err
+		}
+		if !active {
+			continue
+		}
+		if best == nil || rules[i].Apply(price).LessThan(best.Apply(price)) {
+			best = &rules[i]
+		}
+	}
+	return best, nil
+}
+
+// AddPricingRuleDTO is a DTO for adding a pricing rule.
+type AddPricingRuleDTO struct {
+	Name       string
+	ProductId  *uuid.UUID
+	CategoryId *uuid.UUID
+	Kind       PriceAdjustmentKind
+	Value      decimal.Decimal
+	Windows    []ScheduleWindow
+}
+
+// NewAddPricingRuleDTO creates a new AddPricingRuleDTO instance.
+func NewAddPricingRuleDTO(
+	name string,
+	productId, categoryId *uuid.UUID,
+	kind PriceAdjustmentKind,
+	value decimal.Decimal,
+	windows []ScheduleWindow,
+) *AddPricingRuleDTO {
+	return &AddPricingRuleDTO{
+		Name:       name,
+		ProductId:  productId,
+		CategoryId: categoryId,
+		Kind:       kind,
+		Value:      value,
+		Windows:    windows,
+	}
+}
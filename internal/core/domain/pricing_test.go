@@ -0,0 +1,69 @@
+package domain_test
+
+import (
+	"restaurant/internal/core/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBestPricingRule(t *testing.T) {
+	// 2024-01-01 is a Monday.
+	at := func(hour int) time.Time {
+		return time.Date(2024, time.January, 1, hour, 0, 0, 0, time.UTC)
+	}
+	price := decimal.NewFromFloat(12.5)
+	happyHour := *domain.NewPricingRule(
+		uuid.New(), "Happy Hour", nil, nil, domain.PercentageDiscount, decimal.NewFromInt(20),
+		[]domain.ScheduleWindow{*domain.NewScheduleWindow(time.Monday, "17:00", "19:00")},
+	)
+	lunch := *domain.NewPricingRule(
+		uuid.New(), "Lunch", nil, nil, domain.FixedPrice, decimal.NewFromInt(9),
+		[]domain.ScheduleWindow{*domain.NewScheduleWindow(time.Monday, "12:00", "18:00")},
+	)
+	always := *domain.NewPricingRule(uuid.New(), "Always", nil, nil, domain.PercentageDiscount, decimal.NewFromInt(5), nil)
+
+	tests := []struct {
+		name          string
+		rules         []domain.PricingRule
+		at            time.Time
+		expectedRule  string
+		expectedPrice decimal.Decimal
+	}{
+		{name: "no rules", at: at(17), expectedPrice: price},
+		{name: "outside windows", rules: []domain.PricingRule{happyHour, lunch}, at: at(10), expectedPrice: price},
+		{name: "rule without windows", rules: []domain.PricingRule{always}, at: at(10), expectedRule: "Always", expectedPrice: decimal.NewFromFloat(11.88)},
+		{name: "single active rule", rules: []domain.PricingRule{happyHour, lunch}, at: at(18), expectedRule: "Happy Hour", expectedPrice: decimal.NewFromInt(10)},
+		{name: "lowest price wins", rules: []domain.PricingRule{happyHour, lunch, always}, at: at(17), expectedRule: "Lunch", expectedPrice: decimal.NewFromInt(9)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := domain.Product{Price: price}
+			rule, err := domain.BestPricingRule(tt.rules, price, tt.at)
+			require.NoError(t, err)
+			product.ActivePricingRule = rule
+			if tt.expectedRule == "" {
+				require.Nil(t, product.ActivePricingRule)
+			} else {
+				require.NotNil(t, product.ActivePricingRule)
+				require.Equal(t, tt.expectedRule, product.ActivePricingRule.Name)
+			}
+			require.True(t, tt.expectedPrice.Equal(product.CurrentPrice()), product.CurrentPrice().String())
+		})
+	}
+}
+
+func TestBestPricingRule_InvalidWindow(t *testing.T) {
+	broken := *domain.NewPricingRule(
+		uuid.New(), "Broken", nil, nil, domain.PercentageDiscount, decimal.NewFromInt(10),
+		[]domain.ScheduleWindow{*domain.NewScheduleWindow(time.Monday, "noon", "18:00")},
+	)
+
+	rule, err := domain.BestPricingRule([]domain.PricingRule{broken}, decimal.NewFromInt(10), time.Now())
+	require.ErrorIs(t, err, domain.ErrInvalidScheduleWindow)
+	require.Nil(t, rule)
+}
@@ -1,14 +1,19 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
 // ProductCategory is an entity representing a product.
 type ProductCategory struct {
-	Id   uuid.UUID
-	Name string
+	Id         uuid.UUID
+	Name       string
+	ArchivedAt *time.Time
+	// CreatedAt is only loaded with the menu.
+	CreatedAt time.Time
 }
 
 // NewProductCategory creates a new ProductCategory instance.
@@ -40,12 +45,35 @@ type Product struct {
 	Description    string
 	ImageUrl       *string
 	DeleteImageUrl *string
+	// ImageVariants are the resized copies of the image. ImageUrl and DeleteImageUrl point
+	// to the large variant, images uploaded before variants existed have none.
+	ImageVariants ImageSet
+	// Gallery are all images of the product. The image fields above are a copy of the first one.
+	Gallery        []ProductImage
 	Category       uuid.UUID
 	Price          decimal.Decimal
+	Available      bool
+	Nutrition      Nutrition
+	ModifierGroups []ModifierGroup
+	Tags           []Tag
+	// Schedule and CategorySchedule restrict when the product can be ordered.
+	// They are only loaded for a single product.
+	Schedule         []ScheduleWindow
+	CategorySchedule []ScheduleWindow
+	// PricingRules are the rules of the product and its category. ActivePricingRule is
+	// the one that applies at the moment the product is listed or ordered.
+	PricingRules      []PricingRule
+	ActivePricingRule *PricingRule
+	ArchivedAt        *time.Time
+	// CategoryArchivedAt is when the category of the product was archived, which hides the product
+	// as well. It is only loaded with a single product and the archived products.
+	CategoryArchivedAt *time.Time
+	// CreatedAt is only loaded with the menu.
+	CreatedAt time.Time
 }
 
 // NewProduct creates a new Product instance.
-func NewProduct(id uuid.UUID, name, description string, imageUrl, deleteImageUrl *string, category uuid.UUID, price decimal.Decimal) *Product {
+func NewProduct(id uuid.UUID, name, description string, imageUrl, deleteImageUrl *string, category uuid.UUID, price decimal.Decimal, available bool) *Product {
 	return &Product{
 		Id:             id,
 		Name:           name,
@@ -54,7 +82,32 @@ func NewProduct(id uuid.UUID, name, description string, imageUrl, deleteImageUrl
 		DeleteImageUrl: deleteImageUrl,
 		Category:       category,
 		Price:          price,
+		Available:      available,
+	}
+}
+
+// StoredImages returns every stored copy of all images of the product.
+func (p *Product) StoredImages() []Image {
+	if len(p.Gallery) == 0 {
+		return p.PrimaryStoredImages()
+	}
+
+	var images []Image
+	for _, image := range p.Gallery {
+		images = append(images, image.StoredImages()...)
 	}
+	return images
+}
+
+// PrimaryStoredImages returns every stored copy of the primary image.
+func (p *Product) PrimaryStoredImages() []Image {
+	if len(p.ImageVariants) > 0 {
+		return p.ImageVariants.Images()
+	}
+	if p.ImageUrl != nil && p.DeleteImageUrl != nil {
+		return []Image{{Url: *p.ImageUrl, DeleteUrl: *p.DeleteImageUrl}}
+	}
+	return nil
 }
 
 // AddProductDTO is a DTO for adding a product.
@@ -63,15 +116,17 @@ type AddProductDTO struct {
 	Description string
 	Category    uuid.UUID
 	Price       decimal.Decimal
+	Nutrition   Nutrition
 }
 
 // NewAddProductDTO creates a new AddProductDTO instance.
-func NewAddProductDTO(name, description string, category uuid.UUID, price decimal.Decimal) *AddProductDTO {
+func NewAddProductDTO(name, description string, category uuid.UUID, price decimal.Decimal, nutrition Nutrition) *AddProductDTO {
 	return &AddProductDTO{
 		Name:        name,
 		Description: description,
 		Category:    category,
 		Price:       price,
+		Nutrition:   nutrition,
 	}
 }
 
@@ -82,16 +137,19 @@ type UpdateProductDTO struct {
 	Description *string
 	Category    *uuid.UUID
 	Price       *decimal.Decimal
+	// Nutrition replaces all nutrition facts of the product, facts missing from it are cleared.
+	Nutrition *Nutrition
 }
 
 // NewUpdateProductDTO creates a new UpdateProductDTO instance.
-func NewUpdateProductDTO(id uuid.UUID, name, description *string, category *uuid.UUID, price *decimal.Decimal) *UpdateProductDTO {
+func NewUpdateProductDTO(id uuid.UUID, name, description *string, category *uuid.UUID, price *decimal.Decimal, nutrition *Nutrition) *UpdateProductDTO {
 	return &UpdateProductDTO{
 		Id:          id,
 		Name:        name,
 		Description: description,
 		Category:    category,
 		Price:       price,
+		Nutrition:   nutrition,
 	}
 }
 
@@ -109,14 +167,96 @@ func NewDeleteProductDTO(productId, categoryId *uuid.UUID) *DeleteProductDTO {
 	}
 }
 
+// ProductSort is an enum for the orderings of a product listing.
+type ProductSort string
+
+const (
+	// SortByName orders products by their name.
+	SortByName ProductSort = "name"
+	// SortByPrice orders products by their current price, after the active pricing rule.
+	SortByPrice ProductSort = "price"
+	// SortByCategory orders products by the name of their category and then by their name.
+	SortByCategory ProductSort = "category"
+	// SortByRelevance orders products by how well they match the search query.
+	SortByRelevance ProductSort = "relevance"
+)
+
+// MaxProductsLimit is the largest page size that can be requested.
+const MaxProductsLimit = 100
+
 // GetProductsDTO is a DTO for getting products.
 type GetProductsDTO struct {
-	CategoryId *uuid.UUID
+	// Ids only lists the products with these ids when it isn't nil.
+	Ids         []uuid.UUID
+	CategoryId  *uuid.UUID
+	IncludeTags []string
+	ExcludeTags []string
+	Locales     []string
+	// Search is a full-text query matched against product names and descriptions and their translation
+	// in the preferred locale.
+	Search string
+	// MaxCalories only lists products with at most these kcal per portion.
+	// Products without known calories are left out when it is set.
+	MaxCalories *int
+	Sort        ProductSort
+	Descending  bool
+	// Limit is the page size, all products are listed when it is 0.
+	Limit  int
+	Offset int
+	// At is the moment in the restaurant timezone used to filter products by their schedules.
+	At time.Time
 }
 
 // NewGetProductsDTO creates a new GetProductsDTO instance.
-func NewGetProductsDTO(categoryId *uuid.UUID) *GetProductsDTO {
+func NewGetProductsDTO(
+	categoryId *uuid.UUID,
+	includeTags, excludeTags, locales []string,
+	search string,
+	maxCalories *int,
+	sort ProductSort,
+	descending bool,
+	limit, offset int,
+) *GetProductsDTO {
 	return &GetProductsDTO{
-		CategoryId: categoryId,
+		CategoryId:  categoryId,
+		IncludeTags: includeTags,
+		ExcludeTags: excludeTags,
+		Locales:     locales,
+		Search:      search,
+		MaxCalories: maxCalories,
+		Sort:        sort,
+		Descending:  descending,
+		Limit:       limit,
+		Offset:      offset,
+	}
+}
+
+// NewGetProductsByIdsDTO creates a GetProductsDTO listing the products with the ids as the public menu does.
+func NewGetProductsByIdsDTO(ids []uuid.UUID, locales []string) *GetProductsDTO {
+	return &GetProductsDTO{
+		Ids:     ids,
+		Locales: locales,
+	}
+}
+
+// ProductPage is a page of a product listing together with the number of all matching products.
+type ProductPage struct {
+	Products []Product
+	Total    int
+}
+
+// NewProductPage creates a new ProductPage instance.
+func NewProductPage(products []Product, total int) *ProductPage {
+	return &ProductPage{
+		Products: products,
+		Total:    total,
+	}
+}
+
+// CurrentPrice returns the price after the active pricing rule.
+func (p *Product) CurrentPrice() decimal.Decimal {
+	if p.ActivePricingRule == nil {
+		return p.Price
 	}
+	return p.ActivePricingRule.Apply(p.Price)
 }
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// MaxProductImages is the largest number of images in the gallery of a product.
+const MaxProductImages = 10
+
+// ProductImage is an entity representing an image of a product gallery. Images are shown
+// by ascending position and the first one is the primary image of the product.
+type ProductImage struct {
+	Id        uuid.UUID
+	ProductId uuid.UUID
+	Position  int
+	AltText   string
+	Url       string
+	// DeleteUrl and Variants are missing for images imported by url.
+	DeleteUrl *string
+	Variants  ImageSet
+}
+
+// NewProductImage creates a new ProductImage instance for the saved variants of an image.
+// The position is assigned when the image is added to the gallery.
+func NewProductImage(id, productId uuid.UUID, altText string, images ImageSet) *ProductImage {
+	image, _ := images.Main()
+	return &ProductImage{
+		Id:        id,
+		ProductId: productId,
+		AltText:   altText,
+		Url:       image.Url,
+		DeleteUrl: &image.DeleteUrl,
+		Variants:  images,
+	}
+}
+
+// StoredImages returns every stored copy of the image.
+func (i *ProductImage) StoredImages() []Image {
+	if len(i.Variants) > 0 {
+		return i.Variants.Images()
+	}
+	if i.DeleteUrl != nil {
+		return []Image{{Url: i.Url, DeleteUrl: *i.DeleteUrl}}
+	}
+	return nil
+}
+
+// DeleteUrls returns the delete urls of every stored copy of the image.
+func (i *ProductImage) DeleteUrls() []string {
+	return deleteUrls(i.StoredImages())
+}
+
+// AddProductImageDTO is a DTO for adding an image to a product gallery.
+type AddProductImageDTO struct {
+	ProductId uuid.UUID
+	AltText   string
+	Data      io.Reader
+}
+
+// NewAddProductImageDTO creates a new AddProductImageDTO instance.
+func NewAddProductImageDTO(productId uuid.UUID, altText string, data io.Reader) *AddProductImageDTO {
+	return &AddProductImageDTO{
+		ProductId: productId,
+		AltText:   altText,
+		Data:      data,
+	}
+}
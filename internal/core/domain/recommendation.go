@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxRecommendations is the number of companions recommended for a product.
+	MaxRecommendations = 5
+	// MinRecommendationSessions is how many sessions must have ordered two products together
+	// before one is recommended for the other, so a single order doesn't become a suggestion.
+	MinRecommendationSessions = 2
+	// RecommendationHistory is how far back the order history is used, so recommendations
+	// follow the current menu and habits of guests.
+	RecommendationHistory = 90 * 24 * time.Hour
+)
+
+// Recommendation is a value object representing a product that is often ordered
+// in the same session as another product.
+type Recommendation struct {
+	// ProductId is the product the companion is recommended for.
+	ProductId   uuid.UUID
+	CompanionId uuid.UUID
+	// Sessions is the number of sessions that ordered both products.
+	Sessions int
+}
+
+// RecommendedProduct is a recommended companion with its product data as the public menu lists it.
+type RecommendedProduct struct {
+	Product
+	// Sessions is the number of sessions that ordered both products.
+	Sessions int
+}
+
+// NewRecommendedProduct creates a new RecommendedProduct instance.
+func NewRecommendedProduct(product Product, sessions int) *RecommendedProduct {
+	return &RecommendedProduct{
+		Product:  product,
+		Sessions: sessions,
+	}
+}
+
+// GroupRecommendations groups recommendations by the product they are recommended for,
+// keeping their order.
+func GroupRecommendations(recommendations []Recommendation) map[uuid.UUID][]Recommendation {
+	grouped := make(map[uuid.UUID][]Recommendation)
+	for _, recommendation := range recommendations {
+		grouped[recommendation.ProductId] = append(grouped[recommendation.ProductId], recommendation)
+	}
+	return grouped
+}
@@ -0,0 +1,93 @@
+package domain
+
+import "time"
+
+// TimeOfDayLayout is the layout of the start and end times of schedule windows.
+const TimeOfDayLayout = "15:04"
+
+// ScheduleWindow is a weekly time range in which a product or a category can be ordered.
+// A window that ends at or before its start continues past midnight into the next day.
+type ScheduleWindow struct {
+	Weekday time.Weekday
+	Start   string
+	End     string
+}
+
+// NewScheduleWindow creates a new ScheduleWindow instance.
+func NewScheduleWindow(weekday time.Weekday, start, end string) *ScheduleWindow {
+	return &ScheduleWindow{
+		Weekday: weekday,
+		Start:   start,
+		End:     end,
+	}
+}
+
+// Validate checks the weekday and that the start and end times are formatted in TimeOfDayLayout.
+func (w *ScheduleWindow) Validate() error {
+	if w.Weekday < time.Sunday || w.Weekday > time.Saturday {
+		return ErrInvalidScheduleWindow
+	}
+	if _, err := minuteOfDay(w.Start); err != nil {
+		return err
+	}
+	_, err := minuteOfDay(w.End)
+	return err
+}
+
+// Contains reports whether t falls inside the window.
+func (w *ScheduleWindow) Contains(t time.Time) (bool, error) {
+	start, err := minuteOfDay(w.Start)
+	if err != nil {
+		return false, err
+	}
+	end, err := minuteOfDay(w.End)
+	if err != nil {
+		return false, err
+	}
+	minute := t.Hour()*60 + t.Minute()
+
+	if start < end {
+		return w.Weekday == t.Weekday() && minute >= start && minute < end, nil
+	}
+	return (w.Weekday == t.Weekday() && minute >= start) ||
+		((w.Weekday+1)%7 == t.Weekday() && minute < end), nil
+}
+
+// ValidateSchedule checks every window of a schedule.
+func ValidateSchedule(windows []ScheduleWindow) error {
+	for _, window := range windows {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsWithinSchedule reports whether t falls inside any of the windows.
+// An empty schedule means there is no time restriction.
+func IsWithinSchedule(windows []ScheduleWindow, t time.Time) (bool, error) {
+	if len(windows) == 0 {
+		return true, nil
+	}
+
+	for _, window := range windows {
+		contains, err := window.Contains(t)
+		if err != nil {
+			return false, err
+		}
+		if contains {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// minuteOfDay converts a time of day in TimeOfDayLayout into minutes since midnight.
+// It returns ErrInvalidScheduleWindow when the value isn't in that layout.
+func minuteOfDay(value string) (int, error) {
+	t, err := time.Parse(TimeOfDayLayout, value)
+	if err != nil {
+		return 0, ErrInvalidScheduleWindow
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
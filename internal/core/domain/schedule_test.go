@@ -0,0 +1,71 @@
+package domain_test
+
+import (
+	"restaurant/internal/core/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsWithinSchedule(t *testing.T) {
+	// 2024-01-01 is a Monday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, time.January, day, hour, minute, 0, 0, time.UTC)
+	}
+	breakfast := *domain.NewScheduleWindow(time.Monday, "07:00", "11:00")
+	bar := *domain.NewScheduleWindow(time.Monday, "17:00", "02:00")
+
+	tests := []struct {
+		name     string
+		windows  []domain.ScheduleWindow
+		at       time.Time
+		expected bool
+	}{
+		{name: "no schedule", at: at(1, 3, 0), expected: true},
+		{name: "inside window", windows: []domain.ScheduleWindow{breakfast}, at: at(1, 7, 0), expected: true},
+		{name: "end is exclusive", windows: []domain.ScheduleWindow{breakfast}, at: at(1, 11, 0), expected: false},
+		{name: "other weekday", windows: []domain.ScheduleWindow{breakfast}, at: at(2, 8, 0), expected: false},
+		{name: "overnight before midnight", windows: []domain.ScheduleWindow{bar}, at: at(1, 23, 30), expected: true},
+		{name: "overnight after midnight", windows: []domain.ScheduleWindow{bar}, at: at(2, 1, 59), expected: true},
+		{name: "overnight after end", windows: []domain.ScheduleWindow{bar}, at: at(2, 2, 0), expected: false},
+		{name: "overnight on previous day morning", windows: []domain.ScheduleWindow{bar}, at: at(1, 1, 0), expected: false},
+		{name: "any window matches", windows: []domain.ScheduleWindow{breakfast, bar}, at: at(1, 18, 0), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withinSchedule, err := domain.IsWithinSchedule(tt.windows, tt.at)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, withinSchedule)
+		})
+	}
+}
+
+func TestScheduleWindow_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		window        *domain.ScheduleWindow
+		expectedError error
+	}{
+		{name: "valid", window: domain.NewScheduleWindow(time.Sunday, "00:00", "23:59")},
+		{name: "malformed start", window: domain.NewScheduleWindow(time.Monday, "7:00pm", "23:00"), expectedError: domain.ErrInvalidScheduleWindow},
+		{name: "start out of range", window: domain.NewScheduleWindow(time.Monday, "24:00", "23:00"), expectedError: domain.ErrInvalidScheduleWindow},
+		{name: "empty end", window: domain.NewScheduleWindow(time.Monday, "07:00", ""), expectedError: domain.ErrInvalidScheduleWindow},
+		{name: "unknown weekday", window: domain.NewScheduleWindow(time.Weekday(7), "07:00", "11:00"), expectedError: domain.ErrInvalidScheduleWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.window.Validate(), tt.expectedError)
+		})
+	}
+}
+
+func TestIsWithinSchedule_InvalidWindow(t *testing.T) {
+	windows := []domain.ScheduleWindow{*domain.NewScheduleWindow(time.Monday, "07:00", "late")}
+
+	withinSchedule, err := domain.IsWithinSchedule(windows, time.Date(2024, time.January, 1, 8, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, err, domain.ErrInvalidScheduleWindow)
+	require.False(t, withinSchedule)
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "github.com/google/uuid"
+
+// TagKind is an enum for tag kinds.
+type TagKind string
+
+const (
+	Allergen TagKind = "allergen"
+	Dietary  TagKind = "dietary"
+)
+
+// Tag is an entity representing an allergen or a dietary tag attached to products.
+type Tag struct {
+	Id   uuid.UUID
+	Name string
+	Kind TagKind
+}
+
+// NewTag creates a new Tag instance.
+func NewTag(id uuid.UUID, name string, kind TagKind) *Tag {
+	return &Tag{
+		Id:   id,
+		Name: name,
+		Kind: kind,
+	}
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "github.com/google/uuid"
+
+// ProductTranslation represents the name and description of a product in a specific locale.
+type ProductTranslation struct {
+	ProductId   uuid.UUID
+	Locale      string
+	Name        string
+	Description string
+}
+
+// NewProductTranslation creates a new ProductTranslation instance.
+func NewProductTranslation(productId uuid.UUID, locale, name, description string) *ProductTranslation {
+	return &ProductTranslation{
+		ProductId:   productId,
+		Locale:      locale,
+		Name:        name,
+		Description: description,
+	}
+}
+
+// CategoryTranslation represents the name of a product category in a specific locale.
+type CategoryTranslation struct {
+	CategoryId uuid.UUID
+	Locale     string
+	Name       string
+}
+
+// NewCategoryTranslation creates a new CategoryTranslation instance.
+func NewCategoryTranslation(categoryId uuid.UUID, locale, name string) *CategoryTranslation {
+	return &CategoryTranslation{
+		CategoryId: categoryId,
+		Locale:     locale,
+		Name:       name,
+	}
+}
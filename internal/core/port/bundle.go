@@ -0,0 +1,41 @@
+package port
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+
+	"github.com/google/uuid"
+)
+
+// BundleRepository is an interface for interacting with bundles data.
+type BundleRepository interface {
+	// GetBundles fetches all bundles with their slots.
+	GetBundles(ctx context.Context) ([]domain.Bundle, error)
+
+	// GetBundleById fetches a bundle with its slots by id.
+	GetBundleById(ctx context.Context, id uuid.UUID) (*domain.Bundle, error)
+
+	// AddBundle saves a new bundle with its slots.
+	AddBundle(ctx context.Context, bundle *domain.Bundle) error
+
+	// DeleteBundle deletes a bundle by specified id.
+	DeleteBundle(ctx context.Context, id uuid.UUID) error
+
+	// SetBundleAvailability marks a bundle as available or unavailable.
+	SetBundleAvailability(ctx context.Context, id uuid.UUID, available bool) error
+}
+
+// BundleService is an interface for interacting with bundles business logic.
+type BundleService interface {
+	// GetBundles fetches all bundles with their slots.
+	GetBundles(ctx context.Context) ([]domain.Bundle, error)
+
+	// AddBundle validates the slots and creates a new bundle.
+	AddBundle(ctx context.Context, dto *domain.AddBundleDTO) (*domain.Bundle, error)
+
+	// DeleteBundle deletes a bundle by specified id.
+	DeleteBundle(ctx context.Context, id uuid.UUID) error
+
+	// SetBundleAvailability marks a bundle as available or unavailable.
+	SetBundleAvailability(ctx context.Context, id uuid.UUID, available bool) error
+}
@@ -0,0 +1,18 @@
+package port
+
+import "time"
+
+// MenuCacheRepository is an interface for caching public menu listings between menu changes.
+type MenuCacheRepository interface {
+	// Get fetches a cached listing by key.
+	Get(key string) (any, bool)
+
+	// Set caches a listing by key, unless the menu changed after loadedAt when the listing started loading.
+	Set(key string, value any, loadedAt time.Time)
+
+	// Invalidate drops all cached listings after the menu has changed.
+	Invalidate()
+
+	// ModifiedAt returns the last time the cached listings could have changed.
+	ModifiedAt() time.Time
+}
@@ -0,0 +1,9 @@
+package port
+
+import "time"
+
+// Clock is an interface for reading the current time, so schedules and pricing rules can be tested at any moment.
+type Clock interface {
+	// Now returns the current time in the timezone of the restaurant.
+	Now() time.Time
+}
@@ -4,12 +4,65 @@ import (
 	"context"
 	"io"
 	"restaurant/internal/core/domain"
+	"time"
+
+	"github.com/google/uuid"
 )
 
+// ImageRepository is an interface for storing uploaded images.
 type ImageRepository interface {
 	// SaveImage saves an image and returns the URL to the image.
 	SaveImage(ctx context.Context, data io.Reader) (*domain.Image, error)
 
 	// DeleteImage deletes an image.
 	DeleteImage(ctx context.Context, deleteUrl string) error
+
+	// ListImages lists all images saved by the application. It returns domain.ErrImageListingUnsupported
+	// when the storage can't list its images.
+	ListImages(ctx context.Context) ([]domain.StoredImage, error)
+}
+
+// ImageProcessor is an interface for validating uploaded images and preparing them for storage.
+type ImageProcessor interface {
+	// ProcessImage validates an uploaded image and re-encodes it in every variant size without its metadata.
+	// JPEG images are turned upright as their EXIF orientation describes, WebP images are encoded as JPEG or PNG.
+	// The variants are returned in the order of domain.ImageVariants. It returns domain.ErrImageTooLarge for files
+	// or dimensions over the limits, domain.ErrInvalidImageFormat for unsupported formats and domain.ErrCorruptImage
+	// for undecodable images.
+	ProcessImage(ctx context.Context, data io.Reader) ([]domain.ProcessedImage, error)
+}
+
+// ImageOperationRepository is an interface for interacting with pending image operations.
+type ImageOperationRepository interface {
+	// AddImageOperations records new image operations.
+	AddImageOperations(ctx context.Context, operations []domain.ImageOperation) error
+
+	// ClaimImageOperations fetches up to limit due operations, counts the attempt and postpones
+	// them by lease, so no other worker processes them at the same time.
+	ClaimImageOperations(ctx context.Context, limit int, lease time.Duration) ([]domain.ImageOperation, error)
+
+	// CompleteImageOperation removes a successfully processed operation.
+	CompleteImageOperation(ctx context.Context, id uuid.UUID) error
+
+	// FailImageOperation records the error of an operation and when it is attempted again.
+	FailImageOperation(ctx context.Context, id uuid.UUID, message string, nextAttemptAt time.Time) error
+
+	// GetUsedImageUrls fetches the urls and delete urls of all images that are used by products,
+	// menu versions or the menu draft.
+	GetUsedImageUrls(ctx context.Context) (map[string]struct{}, error)
+
+	// GetReferencedImageUrls fetches the urls and delete urls of all images that are used by products
+	// or menu versions or have a pending operation.
+	GetReferencedImageUrls(ctx context.Context) (map[string]struct{}, error)
+}
+
+// ImageCleanupService is an interface for deleting images that are no longer used.
+type ImageCleanupService interface {
+	// ProcessImageOperations deletes the images of all due operations and returns how many were deleted.
+	// Images that are still used are kept and their operations completed. Failed operations are retried later.
+	ProcessImageOperations(ctx context.Context) (int, error)
+
+	// ReconcileImages schedules the deletion of stored images that aren't referenced anywhere
+	// and returns how many were scheduled.
+	ReconcileImages(ctx context.Context) (int, error)
 }
@@ -0,0 +1,61 @@
+package port
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+
+	"github.com/google/uuid"
+)
+
+// InventoryRepository is an interface for interacting with inventory data.
+type InventoryRepository interface {
+	// AddIngredient saves a new ingredient.
+	AddIngredient(ctx context.Context, ingredient *domain.Ingredient) error
+
+	// GetIngredients fetches all ingredients.
+	GetIngredients(ctx context.Context) ([]domain.Ingredient, error)
+
+	// DeleteIngredient deletes an ingredient by specified id.
+	DeleteIngredient(ctx context.Context, id uuid.UUID) error
+
+	// AdjustStock changes the on-hand quantity of an ingredient by a delta and returns the updated ingredient
+	// together with the products whose availability changed because of it. It fails with
+	// domain.ErrInsufficientStock if the quantity would go negative.
+	AdjustStock(ctx context.Context, dto *domain.StockAdjustmentDTO) (*domain.Ingredient, []domain.AvailabilityChange, error)
+
+	// CountStock sets the on-hand quantity of an ingredient to a counted value and returns the updated ingredient
+	// together with the products whose availability changed because of it.
+	CountStock(ctx context.Context, dto *domain.StockCountDTO) (*domain.Ingredient, []domain.AvailabilityChange, error)
+
+	// SetRecipe replaces the recipe of a product.
+	SetRecipe(ctx context.Context, productId uuid.UUID, items []domain.RecipeItem) error
+
+	// GetRecipe fetches the recipe of a product.
+	GetRecipe(ctx context.Context, productId uuid.UUID) ([]domain.RecipeItem, error)
+}
+
+// InventoryService is an interface for interacting with inventory business logic.
+type InventoryService interface {
+	// AddIngredient saves a new ingredient.
+	AddIngredient(ctx context.Context, dto *domain.AddIngredientDTO) (*domain.Ingredient, error)
+
+	// GetIngredients fetches all ingredients.
+	GetIngredients(ctx context.Context) ([]domain.Ingredient, error)
+
+	// DeleteIngredient deletes an ingredient by specified id.
+	DeleteIngredient(ctx context.Context, id uuid.UUID) error
+
+	// AdjustStock changes the on-hand quantity of an ingredient by a delta. Products that run out of
+	// or are back in stock are dropped from the menu cache and announced to all clients.
+	AdjustStock(ctx context.Context, dto *domain.StockAdjustmentDTO) (*domain.Ingredient, error)
+
+	// CountStock records a physical stock count of an ingredient. Products that run out of
+	// or are back in stock are dropped from the menu cache and announced to all clients.
+	CountStock(ctx context.Context, dto *domain.StockCountDTO) (*domain.Ingredient, error)
+
+	// SetRecipe replaces the recipe of a product.
+	SetRecipe(ctx context.Context, productId uuid.UUID, items []domain.RecipeItem) error
+
+	// GetRecipe fetches the recipe of a product.
+	GetRecipe(ctx context.Context, productId uuid.UUID) ([]domain.RecipeItem, error)
+}
@@ -14,7 +14,9 @@ import (
 	io "io"
 	reflect "reflect"
 	domain "restaurant/internal/core/domain"
+	time "time"
 
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -80,6 +82,45 @@ func (c *MockImageRepositoryDeleteImageCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// ListImages mocks base method.
+func (m *MockImageRepository) ListImages(ctx context.Context) ([]domain.StoredImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", ctx)
+	ret0, _ := ret[0].([]domain.StoredImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockImageRepositoryMockRecorder) ListImages(ctx any) *MockImageRepositoryListImagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockImageRepository)(nil).ListImages), ctx)
+	return &MockImageRepositoryListImagesCall{Call: call}
+}
+
+// MockImageRepositoryListImagesCall wrap *gomock.Call
+type MockImageRepositoryListImagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageRepositoryListImagesCall) Return(arg0 []domain.StoredImage, arg1 error) *MockImageRepositoryListImagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageRepositoryListImagesCall) Do(f func(context.Context) ([]domain.StoredImage, error)) *MockImageRepositoryListImagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageRepositoryListImagesCall) DoAndReturn(f func(context.Context) ([]domain.StoredImage, error)) *MockImageRepositoryListImagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SaveImage mocks base method.
 func (m *MockImageRepository) SaveImage(ctx context.Context, data io.Reader) (*domain.Image, error) {
 	m.ctrl.T.Helper()
@@ -118,3 +159,423 @@ func (c *MockImageRepositorySaveImageCall) DoAndReturn(f func(context.Context, i
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockImageProcessor is a mock of ImageProcessor interface.
+type MockImageProcessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageProcessorMockRecorder
+	isgomock struct{}
+}
+
+// MockImageProcessorMockRecorder is the mock recorder for MockImageProcessor.
+type MockImageProcessorMockRecorder struct {
+	mock *MockImageProcessor
+}
+
+// NewMockImageProcessor creates a new mock instance.
+func NewMockImageProcessor(ctrl *gomock.Controller) *MockImageProcessor {
+	mock := &MockImageProcessor{ctrl: ctrl}
+	mock.recorder = &MockImageProcessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageProcessor) EXPECT() *MockImageProcessorMockRecorder {
+	return m.recorder
+}
+
+// ProcessImage mocks base method.
+func (m *MockImageProcessor) ProcessImage(ctx context.Context, data io.Reader) ([]domain.ProcessedImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessImage", ctx, data)
+	ret0, _ := ret[0].([]domain.ProcessedImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessImage indicates an expected call of ProcessImage.
+func (mr *MockImageProcessorMockRecorder) ProcessImage(ctx, data any) *MockImageProcessorProcessImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessImage", reflect.TypeOf((*MockImageProcessor)(nil).ProcessImage), ctx, data)
+	return &MockImageProcessorProcessImageCall{Call: call}
+}
+
+// MockImageProcessorProcessImageCall wrap *gomock.Call
+type MockImageProcessorProcessImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageProcessorProcessImageCall) Return(arg0 []domain.ProcessedImage, arg1 error) *MockImageProcessorProcessImageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageProcessorProcessImageCall) Do(f func(context.Context, io.Reader) ([]domain.ProcessedImage, error)) *MockImageProcessorProcessImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageProcessorProcessImageCall) DoAndReturn(f func(context.Context, io.Reader) ([]domain.ProcessedImage, error)) *MockImageProcessorProcessImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockImageOperationRepository is a mock of ImageOperationRepository interface.
+type MockImageOperationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageOperationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockImageOperationRepositoryMockRecorder is the mock recorder for MockImageOperationRepository.
+type MockImageOperationRepositoryMockRecorder struct {
+	mock *MockImageOperationRepository
+}
+
+// NewMockImageOperationRepository creates a new mock instance.
+func NewMockImageOperationRepository(ctrl *gomock.Controller) *MockImageOperationRepository {
+	mock := &MockImageOperationRepository{ctrl: ctrl}
+	mock.recorder = &MockImageOperationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageOperationRepository) EXPECT() *MockImageOperationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddImageOperations mocks base method.
+func (m *MockImageOperationRepository) AddImageOperations(ctx context.Context, operations []domain.ImageOperation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImageOperations", ctx, operations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddImageOperations indicates an expected call of AddImageOperations.
+func (mr *MockImageOperationRepositoryMockRecorder) AddImageOperations(ctx, operations any) *MockImageOperationRepositoryAddImageOperationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImageOperations", reflect.TypeOf((*MockImageOperationRepository)(nil).AddImageOperations), ctx, operations)
+	return &MockImageOperationRepositoryAddImageOperationsCall{Call: call}
+}
+
+// MockImageOperationRepositoryAddImageOperationsCall wrap *gomock.Call
+type MockImageOperationRepositoryAddImageOperationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageOperationRepositoryAddImageOperationsCall) Return(arg0 error) *MockImageOperationRepositoryAddImageOperationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageOperationRepositoryAddImageOperationsCall) Do(f func(context.Context, []domain.ImageOperation) error) *MockImageOperationRepositoryAddImageOperationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageOperationRepositoryAddImageOperationsCall) DoAndReturn(f func(context.Context, []domain.ImageOperation) error) *MockImageOperationRepositoryAddImageOperationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ClaimImageOperations mocks base method.
+func (m *MockImageOperationRepository) ClaimImageOperations(ctx context.Context, limit int, lease time.Duration) ([]domain.ImageOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimImageOperations", ctx, limit, lease)
+	ret0, _ := ret[0].([]domain.ImageOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimImageOperations indicates an expected call of ClaimImageOperations.
+func (mr *MockImageOperationRepositoryMockRecorder) ClaimImageOperations(ctx, limit, lease any) *MockImageOperationRepositoryClaimImageOperationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimImageOperations", reflect.TypeOf((*MockImageOperationRepository)(nil).ClaimImageOperations), ctx, limit, lease)
+	return &MockImageOperationRepositoryClaimImageOperationsCall{Call: call}
+}
+
+// MockImageOperationRepositoryClaimImageOperationsCall wrap *gomock.Call
+type MockImageOperationRepositoryClaimImageOperationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageOperationRepositoryClaimImageOperationsCall) Return(arg0 []domain.ImageOperation, arg1 error) *MockImageOperationRepositoryClaimImageOperationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageOperationRepositoryClaimImageOperationsCall) Do(f func(context.Context, int, time.Duration) ([]domain.ImageOperation, error)) *MockImageOperationRepositoryClaimImageOperationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageOperationRepositoryClaimImageOperationsCall) DoAndReturn(f func(context.Context, int, time.Duration) ([]domain.ImageOperation, error)) *MockImageOperationRepositoryClaimImageOperationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CompleteImageOperation mocks base method.
+func (m *MockImageOperationRepository) CompleteImageOperation(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteImageOperation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteImageOperation indicates an expected call of CompleteImageOperation.
+func (mr *MockImageOperationRepositoryMockRecorder) CompleteImageOperation(ctx, id any) *MockImageOperationRepositoryCompleteImageOperationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteImageOperation", reflect.TypeOf((*MockImageOperationRepository)(nil).CompleteImageOperation), ctx, id)
+	return &MockImageOperationRepositoryCompleteImageOperationCall{Call: call}
+}
+
+// MockImageOperationRepositoryCompleteImageOperationCall wrap *gomock.Call
+type MockImageOperationRepositoryCompleteImageOperationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageOperationRepositoryCompleteImageOperationCall) Return(arg0 error) *MockImageOperationRepositoryCompleteImageOperationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageOperationRepositoryCompleteImageOperationCall) Do(f func(context.Context, uuid.UUID) error) *MockImageOperationRepositoryCompleteImageOperationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageOperationRepositoryCompleteImageOperationCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockImageOperationRepositoryCompleteImageOperationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FailImageOperation mocks base method.
+func (m *MockImageOperationRepository) FailImageOperation(ctx context.Context, id uuid.UUID, message string, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailImageOperation", ctx, id, message, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailImageOperation indicates an expected call of FailImageOperation.
+func (mr *MockImageOperationRepositoryMockRecorder) FailImageOperation(ctx, id, message, nextAttemptAt any) *MockImageOperationRepositoryFailImageOperationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailImageOperation", reflect.TypeOf((*MockImageOperationRepository)(nil).FailImageOperation), ctx, id, message, nextAttemptAt)
+	return &MockImageOperationRepositoryFailImageOperationCall{Call: call}
+}
+
+// MockImageOperationRepositoryFailImageOperationCall wrap *gomock.Call
+type MockImageOperationRepositoryFailImageOperationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageOperationRepositoryFailImageOperationCall) Return(arg0 error) *MockImageOperationRepositoryFailImageOperationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageOperationRepositoryFailImageOperationCall) Do(f func(context.Context, uuid.UUID, string, time.Time) error) *MockImageOperationRepositoryFailImageOperationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageOperationRepositoryFailImageOperationCall) DoAndReturn(f func(context.Context, uuid.UUID, string, time.Time) error) *MockImageOperationRepositoryFailImageOperationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetReferencedImageUrls mocks base method.
+func (m *MockImageOperationRepository) GetReferencedImageUrls(ctx context.Context) (map[string]struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReferencedImageUrls", ctx)
+	ret0, _ := ret[0].(map[string]struct{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReferencedImageUrls indicates an expected call of GetReferencedImageUrls.
+func (mr *MockImageOperationRepositoryMockRecorder) GetReferencedImageUrls(ctx any) *MockImageOperationRepositoryGetReferencedImageUrlsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReferencedImageUrls", reflect.TypeOf((*MockImageOperationRepository)(nil).GetReferencedImageUrls), ctx)
+	return &MockImageOperationRepositoryGetReferencedImageUrlsCall{Call: call}
+}
+
+// MockImageOperationRepositoryGetReferencedImageUrlsCall wrap *gomock.Call
+type MockImageOperationRepositoryGetReferencedImageUrlsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageOperationRepositoryGetReferencedImageUrlsCall) Return(arg0 map[string]struct{}, arg1 error) *MockImageOperationRepositoryGetReferencedImageUrlsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageOperationRepositoryGetReferencedImageUrlsCall) Do(f func(context.Context) (map[string]struct{}, error)) *MockImageOperationRepositoryGetReferencedImageUrlsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageOperationRepositoryGetReferencedImageUrlsCall) DoAndReturn(f func(context.Context) (map[string]struct{}, error)) *MockImageOperationRepositoryGetReferencedImageUrlsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetUsedImageUrls mocks base method.
+func (m *MockImageOperationRepository) GetUsedImageUrls(ctx context.Context) (map[string]struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsedImageUrls", ctx)
+	ret0, _ := ret[0].(map[string]struct{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsedImageUrls indicates an expected call of GetUsedImageUrls.
+func (mr *MockImageOperationRepositoryMockRecorder) GetUsedImageUrls(ctx any) *MockImageOperationRepositoryGetUsedImageUrlsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsedImageUrls", reflect.TypeOf((*MockImageOperationRepository)(nil).GetUsedImageUrls), ctx)
+	return &MockImageOperationRepositoryGetUsedImageUrlsCall{Call: call}
+}
+
+// MockImageOperationRepositoryGetUsedImageUrlsCall wrap *gomock.Call
+type MockImageOperationRepositoryGetUsedImageUrlsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageOperationRepositoryGetUsedImageUrlsCall) Return(arg0 map[string]struct{}, arg1 error) *MockImageOperationRepositoryGetUsedImageUrlsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageOperationRepositoryGetUsedImageUrlsCall) Do(f func(context.Context) (map[string]struct{}, error)) *MockImageOperationRepositoryGetUsedImageUrlsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageOperationRepositoryGetUsedImageUrlsCall) DoAndReturn(f func(context.Context) (map[string]struct{}, error)) *MockImageOperationRepositoryGetUsedImageUrlsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockImageCleanupService is a mock of ImageCleanupService interface.
+type MockImageCleanupService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageCleanupServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockImageCleanupServiceMockRecorder is the mock recorder for MockImageCleanupService.
+type MockImageCleanupServiceMockRecorder struct {
+	mock *MockImageCleanupService
+}
+
+// NewMockImageCleanupService creates a new mock instance.
+func NewMockImageCleanupService(ctrl *gomock.Controller) *MockImageCleanupService {
+	mock := &MockImageCleanupService{ctrl: ctrl}
+	mock.recorder = &MockImageCleanupServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageCleanupService) EXPECT() *MockImageCleanupServiceMockRecorder {
+	return m.recorder
+}
+
+// ProcessImageOperations mocks base method.
+func (m *MockImageCleanupService) ProcessImageOperations(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessImageOperations", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessImageOperations indicates an expected call of ProcessImageOperations.
+func (mr *MockImageCleanupServiceMockRecorder) ProcessImageOperations(ctx any) *MockImageCleanupServiceProcessImageOperationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessImageOperations", reflect.TypeOf((*MockImageCleanupService)(nil).ProcessImageOperations), ctx)
+	return &MockImageCleanupServiceProcessImageOperationsCall{Call: call}
+}
+
+// MockImageCleanupServiceProcessImageOperationsCall wrap *gomock.Call
+type MockImageCleanupServiceProcessImageOperationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageCleanupServiceProcessImageOperationsCall) Return(arg0 int, arg1 error) *MockImageCleanupServiceProcessImageOperationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageCleanupServiceProcessImageOperationsCall) Do(f func(context.Context) (int, error)) *MockImageCleanupServiceProcessImageOperationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageCleanupServiceProcessImageOperationsCall) DoAndReturn(f func(context.Context) (int, error)) *MockImageCleanupServiceProcessImageOperationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReconcileImages mocks base method.
+func (m *MockImageCleanupService) ReconcileImages(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileImages", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileImages indicates an expected call of ReconcileImages.
+func (mr *MockImageCleanupServiceMockRecorder) ReconcileImages(ctx any) *MockImageCleanupServiceReconcileImagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileImages", reflect.TypeOf((*MockImageCleanupService)(nil).ReconcileImages), ctx)
+	return &MockImageCleanupServiceReconcileImagesCall{Call: call}
+}
+
+// MockImageCleanupServiceReconcileImagesCall wrap *gomock.Call
+type MockImageCleanupServiceReconcileImagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageCleanupServiceReconcileImagesCall) Return(arg0 int, arg1 error) *MockImageCleanupServiceReconcileImagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageCleanupServiceReconcileImagesCall) Do(f func(context.Context) (int, error)) *MockImageCleanupServiceReconcileImagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageCleanupServiceReconcileImagesCall) DoAndReturn(f func(context.Context) (int, error)) *MockImageCleanupServiceReconcileImagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -42,6 +42,44 @@ func (m *MockOrderRepository) EXPECT() *MockOrderRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddOrderedBundle mocks base method.
+func (m *MockOrderRepository) AddOrderedBundle(ctx context.Context, bundle *domain.OrderedBundle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrderedBundle", ctx, bundle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrderedBundle indicates an expected call of AddOrderedBundle.
+func (mr *MockOrderRepositoryMockRecorder) AddOrderedBundle(ctx, bundle any) *MockOrderRepositoryAddOrderedBundleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrderedBundle", reflect.TypeOf((*MockOrderRepository)(nil).AddOrderedBundle), ctx, bundle)
+	return &MockOrderRepositoryAddOrderedBundleCall{Call: call}
+}
+
+// MockOrderRepositoryAddOrderedBundleCall wrap *gomock.Call
+type MockOrderRepositoryAddOrderedBundleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderRepositoryAddOrderedBundleCall) Return(arg0 error) *MockOrderRepositoryAddOrderedBundleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderRepositoryAddOrderedBundleCall) Do(f func(context.Context, *domain.OrderedBundle) error) *MockOrderRepositoryAddOrderedBundleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderRepositoryAddOrderedBundleCall) DoAndReturn(f func(context.Context, *domain.OrderedBundle) error) *MockOrderRepositoryAddOrderedBundleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddOrderedProduct mocks base method.
 func (m *MockOrderRepository) AddOrderedProduct(ctx context.Context, product *domain.OrderedProduct) error {
 	m.ctrl.T.Helper()
@@ -80,6 +118,44 @@ func (c *MockOrderRepositoryAddOrderedProductCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// AddOrderedProducts mocks base method.
+func (m *MockOrderRepository) AddOrderedProducts(ctx context.Context, products []domain.OrderedProduct) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrderedProducts", ctx, products)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrderedProducts indicates an expected call of AddOrderedProducts.
+func (mr *MockOrderRepositoryMockRecorder) AddOrderedProducts(ctx, products any) *MockOrderRepositoryAddOrderedProductsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrderedProducts", reflect.TypeOf((*MockOrderRepository)(nil).AddOrderedProducts), ctx, products)
+	return &MockOrderRepositoryAddOrderedProductsCall{Call: call}
+}
+
+// MockOrderRepositoryAddOrderedProductsCall wrap *gomock.Call
+type MockOrderRepositoryAddOrderedProductsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderRepositoryAddOrderedProductsCall) Return(arg0 error) *MockOrderRepositoryAddOrderedProductsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderRepositoryAddOrderedProductsCall) Do(f func(context.Context, []domain.OrderedProduct) error) *MockOrderRepositoryAddOrderedProductsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderRepositoryAddOrderedProductsCall) DoAndReturn(f func(context.Context, []domain.OrderedProduct) error) *MockOrderRepositoryAddOrderedProductsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddSession mocks base method.
 func (m *MockOrderRepository) AddSession(ctx context.Context, session *domain.OrderSession) error {
 	m.ctrl.T.Helper()
@@ -234,6 +310,84 @@ func (c *MockOrderRepositoryDeleteSessionCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// GetBillFromSession mocks base method.
+func (m *MockOrderRepository) GetBillFromSession(ctx context.Context, id uuid.UUID) (*domain.Bill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBillFromSession", ctx, id)
+	ret0, _ := ret[0].(*domain.Bill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBillFromSession indicates an expected call of GetBillFromSession.
+func (mr *MockOrderRepositoryMockRecorder) GetBillFromSession(ctx, id any) *MockOrderRepositoryGetBillFromSessionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillFromSession", reflect.TypeOf((*MockOrderRepository)(nil).GetBillFromSession), ctx, id)
+	return &MockOrderRepositoryGetBillFromSessionCall{Call: call}
+}
+
+// MockOrderRepositoryGetBillFromSessionCall wrap *gomock.Call
+type MockOrderRepositoryGetBillFromSessionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderRepositoryGetBillFromSessionCall) Return(arg0 *domain.Bill, arg1 error) *MockOrderRepositoryGetBillFromSessionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderRepositoryGetBillFromSessionCall) Do(f func(context.Context, uuid.UUID) (*domain.Bill, error)) *MockOrderRepositoryGetBillFromSessionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderRepositoryGetBillFromSessionCall) DoAndReturn(f func(context.Context, uuid.UUID) (*domain.Bill, error)) *MockOrderRepositoryGetBillFromSessionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetOrderedProducts mocks base method.
+func (m *MockOrderRepository) GetOrderedProducts(ctx context.Context, dto *domain.GetOrderedProductsDTO) ([]domain.OrderedProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderedProducts", ctx, dto)
+	ret0, _ := ret[0].([]domain.OrderedProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderedProducts indicates an expected call of GetOrderedProducts.
+func (mr *MockOrderRepositoryMockRecorder) GetOrderedProducts(ctx, dto any) *MockOrderRepositoryGetOrderedProductsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderedProducts", reflect.TypeOf((*MockOrderRepository)(nil).GetOrderedProducts), ctx, dto)
+	return &MockOrderRepositoryGetOrderedProductsCall{Call: call}
+}
+
+// MockOrderRepositoryGetOrderedProductsCall wrap *gomock.Call
+type MockOrderRepositoryGetOrderedProductsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderRepositoryGetOrderedProductsCall) Return(arg0 []domain.OrderedProduct, arg1 error) *MockOrderRepositoryGetOrderedProductsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderRepositoryGetOrderedProductsCall) Do(f func(context.Context, *domain.GetOrderedProductsDTO) ([]domain.OrderedProduct, error)) *MockOrderRepositoryGetOrderedProductsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderRepositoryGetOrderedProductsCall) DoAndReturn(f func(context.Context, *domain.GetOrderedProductsDTO) ([]domain.OrderedProduct, error)) *MockOrderRepositoryGetOrderedProductsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSessionByID mocks base method.
 func (m *MockOrderRepository) GetSessionByID(ctx context.Context, id uuid.UUID) (*domain.OrderSession, error) {
 	m.ctrl.T.Helper()
@@ -274,18 +428,18 @@ func (c *MockOrderRepositoryGetSessionByIDCall) DoAndReturn(f func(context.Conte
 }
 
 // GetSessions mocks base method.
-func (m *MockOrderRepository) GetSessions(ctx context.Context) ([]domain.OrderSession, error) {
+func (m *MockOrderRepository) GetSessions(ctx context.Context, dto *domain.GetSessionsDTO) ([]domain.OrderSession, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSessions", ctx)
+	ret := m.ctrl.Call(m, "GetSessions", ctx, dto)
 	ret0, _ := ret[0].([]domain.OrderSession)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSessions indicates an expected call of GetSessions.
-func (mr *MockOrderRepositoryMockRecorder) GetSessions(ctx any) *MockOrderRepositoryGetSessionsCall {
+func (mr *MockOrderRepositoryMockRecorder) GetSessions(ctx, dto any) *MockOrderRepositoryGetSessionsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockOrderRepository)(nil).GetSessions), ctx)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockOrderRepository)(nil).GetSessions), ctx, dto)
 	return &MockOrderRepositoryGetSessionsCall{Call: call}
 }
 
@@ -301,25 +455,143 @@ func (c *MockOrderRepositoryGetSessionsCall) Return(arg0 []domain.OrderSession,
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockOrderRepositoryGetSessionsCall) Do(f func(context.Context) ([]domain.OrderSession, error)) *MockOrderRepositoryGetSessionsCall {
+func (c *MockOrderRepositoryGetSessionsCall) Do(f func(context.Context, *domain.GetSessionsDTO) ([]domain.OrderSession, error)) *MockOrderRepositoryGetSessionsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockOrderRepositoryGetSessionsCall) DoAndReturn(f func(context.Context) ([]domain.OrderSession, error)) *MockOrderRepositoryGetSessionsCall {
+func (c *MockOrderRepositoryGetSessionsCall) DoAndReturn(f func(context.Context, *domain.GetSessionsDTO) ([]domain.OrderSession, error)) *MockOrderRepositoryGetSessionsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateSession mocks base method.
-func (m *MockOrderRepository) UpdateSession(ctx context.Context, session *domain.UpdateOrderSessionDTO) error {
+// HasIncompletedOrderedProducts mocks base method.
+func (m *MockOrderRepository) HasIncompletedOrderedProducts(ctx context.Context, id uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSession", ctx, session)
+	ret := m.ctrl.Call(m, "HasIncompletedOrderedProducts", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasIncompletedOrderedProducts indicates an expected call of HasIncompletedOrderedProducts.
+func (mr *MockOrderRepositoryMockRecorder) HasIncompletedOrderedProducts(ctx, id any) *MockOrderRepositoryHasIncompletedOrderedProductsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasIncompletedOrderedProducts", reflect.TypeOf((*MockOrderRepository)(nil).HasIncompletedOrderedProducts), ctx, id)
+	return &MockOrderRepositoryHasIncompletedOrderedProductsCall{Call: call}
+}
+
+// MockOrderRepositoryHasIncompletedOrderedProductsCall wrap *gomock.Call
+type MockOrderRepositoryHasIncompletedOrderedProductsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderRepositoryHasIncompletedOrderedProductsCall) Return(arg0 bool, arg1 error) *MockOrderRepositoryHasIncompletedOrderedProductsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderRepositoryHasIncompletedOrderedProductsCall) Do(f func(context.Context, uuid.UUID) (bool, error)) *MockOrderRepositoryHasIncompletedOrderedProductsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderRepositoryHasIncompletedOrderedProductsCall) DoAndReturn(f func(context.Context, uuid.UUID) (bool, error)) *MockOrderRepositoryHasIncompletedOrderedProductsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MoveOrderedProductsToHistory mocks base method.
+func (m *MockOrderRepository) MoveOrderedProductsToHistory(ctx context.Context, sessionId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveOrderedProductsToHistory", ctx, sessionId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// MoveOrderedProductsToHistory indicates an expected call of MoveOrderedProductsToHistory.
+func (mr *MockOrderRepositoryMockRecorder) MoveOrderedProductsToHistory(ctx, sessionId any) *MockOrderRepositoryMoveOrderedProductsToHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveOrderedProductsToHistory", reflect.TypeOf((*MockOrderRepository)(nil).MoveOrderedProductsToHistory), ctx, sessionId)
+	return &MockOrderRepositoryMoveOrderedProductsToHistoryCall{Call: call}
+}
+
+// MockOrderRepositoryMoveOrderedProductsToHistoryCall wrap *gomock.Call
+type MockOrderRepositoryMoveOrderedProductsToHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderRepositoryMoveOrderedProductsToHistoryCall) Return(arg0 error) *MockOrderRepositoryMoveOrderedProductsToHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderRepositoryMoveOrderedProductsToHistoryCall) Do(f func(context.Context, uuid.UUID) error) *MockOrderRepositoryMoveOrderedProductsToHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderRepositoryMoveOrderedProductsToHistoryCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockOrderRepositoryMoveOrderedProductsToHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateOrderedProductStatus mocks base method.
+func (m *MockOrderRepository) UpdateOrderedProductStatus(ctx context.Context, id uuid.UUID, status domain.OrderedProductStatus) (*domain.OrderedProduct, []domain.AvailabilityChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderedProductStatus", ctx, id, status)
+	ret0, _ := ret[0].(*domain.OrderedProduct)
+	ret1, _ := ret[1].([]domain.AvailabilityChange)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateOrderedProductStatus indicates an expected call of UpdateOrderedProductStatus.
+func (mr *MockOrderRepositoryMockRecorder) UpdateOrderedProductStatus(ctx, id, status any) *MockOrderRepositoryUpdateOrderedProductStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderedProductStatus", reflect.TypeOf((*MockOrderRepository)(nil).UpdateOrderedProductStatus), ctx, id, status)
+	return &MockOrderRepositoryUpdateOrderedProductStatusCall{Call: call}
+}
+
+// MockOrderRepositoryUpdateOrderedProductStatusCall wrap *gomock.Call
+type MockOrderRepositoryUpdateOrderedProductStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderRepositoryUpdateOrderedProductStatusCall) Return(arg0 *domain.OrderedProduct, arg1 []domain.AvailabilityChange, arg2 error) *MockOrderRepositoryUpdateOrderedProductStatusCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderRepositoryUpdateOrderedProductStatusCall) Do(f func(context.Context, uuid.UUID, domain.OrderedProductStatus) (*domain.OrderedProduct, []domain.AvailabilityChange, error)) *MockOrderRepositoryUpdateOrderedProductStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderRepositoryUpdateOrderedProductStatusCall) DoAndReturn(f func(context.Context, uuid.UUID, domain.OrderedProductStatus) (*domain.OrderedProduct, []domain.AvailabilityChange, error)) *MockOrderRepositoryUpdateOrderedProductStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSession mocks base method.
+func (m *MockOrderRepository) UpdateSession(ctx context.Context, session *domain.UpdateOrderSessionDTO) (*domain.OrderSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSession", ctx, session)
+	ret0, _ := ret[0].(*domain.OrderSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateSession indicates an expected call of UpdateSession.
 func (mr *MockOrderRepositoryMockRecorder) UpdateSession(ctx, session any) *MockOrderRepositoryUpdateSessionCall {
 	mr.mock.ctrl.T.Helper()
@@ -333,19 +605,19 @@ type MockOrderRepositoryUpdateSessionCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockOrderRepositoryUpdateSessionCall) Return(arg0 error) *MockOrderRepositoryUpdateSessionCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockOrderRepositoryUpdateSessionCall) Return(arg0 *domain.OrderSession, arg1 error) *MockOrderRepositoryUpdateSessionCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockOrderRepositoryUpdateSessionCall) Do(f func(context.Context, *domain.UpdateOrderSessionDTO) error) *MockOrderRepositoryUpdateSessionCall {
+func (c *MockOrderRepositoryUpdateSessionCall) Do(f func(context.Context, *domain.UpdateOrderSessionDTO) (*domain.OrderSession, error)) *MockOrderRepositoryUpdateSessionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockOrderRepositoryUpdateSessionCall) DoAndReturn(f func(context.Context, *domain.UpdateOrderSessionDTO) error) *MockOrderRepositoryUpdateSessionCall {
+func (c *MockOrderRepositoryUpdateSessionCall) DoAndReturn(f func(context.Context, *domain.UpdateOrderSessionDTO) (*domain.OrderSession, error)) *MockOrderRepositoryUpdateSessionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -414,11 +686,12 @@ func (c *MockOrderServiceCreateSessionCall) DoAndReturn(f func(context.Context)
 }
 
 // DeleteOrderedProduct mocks base method.
-func (m *MockOrderService) DeleteOrderedProduct(ctx context.Context, productId uuid.UUID, isPrivilegedCall bool) error {
+func (m *MockOrderService) DeleteOrderedProduct(ctx context.Context, productId uuid.UUID, isPrivilegedCall bool) (*domain.OrderedProduct, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteOrderedProduct", ctx, productId, isPrivilegedCall)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*domain.OrderedProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteOrderedProduct indicates an expected call of DeleteOrderedProduct.
@@ -434,19 +707,19 @@ type MockOrderServiceDeleteOrderedProductCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockOrderServiceDeleteOrderedProductCall) Return(arg0 error) *MockOrderServiceDeleteOrderedProductCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockOrderServiceDeleteOrderedProductCall) Return(arg0 *domain.OrderedProduct, arg1 error) *MockOrderServiceDeleteOrderedProductCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockOrderServiceDeleteOrderedProductCall) Do(f func(context.Context, uuid.UUID, bool) error) *MockOrderServiceDeleteOrderedProductCall {
+func (c *MockOrderServiceDeleteOrderedProductCall) Do(f func(context.Context, uuid.UUID, bool) (*domain.OrderedProduct, error)) *MockOrderServiceDeleteOrderedProductCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockOrderServiceDeleteOrderedProductCall) DoAndReturn(f func(context.Context, uuid.UUID, bool) error) *MockOrderServiceDeleteOrderedProductCall {
+func (c *MockOrderServiceDeleteOrderedProductCall) DoAndReturn(f func(context.Context, uuid.UUID, bool) (*domain.OrderedProduct, error)) *MockOrderServiceDeleteOrderedProductCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -489,19 +762,97 @@ func (c *MockOrderServiceDeleteSessionCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetBill mocks base method.
+func (m *MockOrderService) GetBill(ctx context.Context, sessionId uuid.UUID) (*domain.Bill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBill", ctx, sessionId)
+	ret0, _ := ret[0].(*domain.Bill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBill indicates an expected call of GetBill.
+func (mr *MockOrderServiceMockRecorder) GetBill(ctx, sessionId any) *MockOrderServiceGetBillCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBill", reflect.TypeOf((*MockOrderService)(nil).GetBill), ctx, sessionId)
+	return &MockOrderServiceGetBillCall{Call: call}
+}
+
+// MockOrderServiceGetBillCall wrap *gomock.Call
+type MockOrderServiceGetBillCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderServiceGetBillCall) Return(arg0 *domain.Bill, arg1 error) *MockOrderServiceGetBillCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderServiceGetBillCall) Do(f func(context.Context, uuid.UUID) (*domain.Bill, error)) *MockOrderServiceGetBillCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderServiceGetBillCall) DoAndReturn(f func(context.Context, uuid.UUID) (*domain.Bill, error)) *MockOrderServiceGetBillCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetOrderedProducts mocks base method.
+func (m *MockOrderService) GetOrderedProducts(ctx context.Context, dto *domain.GetOrderedProductsDTO) (*domain.OrderedProductPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderedProducts", ctx, dto)
+	ret0, _ := ret[0].(*domain.OrderedProductPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderedProducts indicates an expected call of GetOrderedProducts.
+func (mr *MockOrderServiceMockRecorder) GetOrderedProducts(ctx, dto any) *MockOrderServiceGetOrderedProductsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderedProducts", reflect.TypeOf((*MockOrderService)(nil).GetOrderedProducts), ctx, dto)
+	return &MockOrderServiceGetOrderedProductsCall{Call: call}
+}
+
+// MockOrderServiceGetOrderedProductsCall wrap *gomock.Call
+type MockOrderServiceGetOrderedProductsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderServiceGetOrderedProductsCall) Return(arg0 *domain.OrderedProductPage, arg1 error) *MockOrderServiceGetOrderedProductsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderServiceGetOrderedProductsCall) Do(f func(context.Context, *domain.GetOrderedProductsDTO) (*domain.OrderedProductPage, error)) *MockOrderServiceGetOrderedProductsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderServiceGetOrderedProductsCall) DoAndReturn(f func(context.Context, *domain.GetOrderedProductsDTO) (*domain.OrderedProductPage, error)) *MockOrderServiceGetOrderedProductsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSessions mocks base method.
-func (m *MockOrderService) GetSessions(ctx context.Context) ([]domain.OrderSession, error) {
+func (m *MockOrderService) GetSessions(ctx context.Context, dto *domain.GetSessionsDTO) (*domain.OrderSessionPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSessions", ctx)
-	ret0, _ := ret[0].([]domain.OrderSession)
+	ret := m.ctrl.Call(m, "GetSessions", ctx, dto)
+	ret0, _ := ret[0].(*domain.OrderSessionPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSessions indicates an expected call of GetSessions.
-func (mr *MockOrderServiceMockRecorder) GetSessions(ctx any) *MockOrderServiceGetSessionsCall {
+func (mr *MockOrderServiceMockRecorder) GetSessions(ctx, dto any) *MockOrderServiceGetSessionsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockOrderService)(nil).GetSessions), ctx)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockOrderService)(nil).GetSessions), ctx, dto)
 	return &MockOrderServiceGetSessionsCall{Call: call}
 }
 
@@ -511,36 +862,75 @@ type MockOrderServiceGetSessionsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockOrderServiceGetSessionsCall) Return(arg0 []domain.OrderSession, arg1 error) *MockOrderServiceGetSessionsCall {
+func (c *MockOrderServiceGetSessionsCall) Return(arg0 *domain.OrderSessionPage, arg1 error) *MockOrderServiceGetSessionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderServiceGetSessionsCall) Do(f func(context.Context, *domain.GetSessionsDTO) (*domain.OrderSessionPage, error)) *MockOrderServiceGetSessionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderServiceGetSessionsCall) DoAndReturn(f func(context.Context, *domain.GetSessionsDTO) (*domain.OrderSessionPage, error)) *MockOrderServiceGetSessionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OrderBundle mocks base method.
+func (m *MockOrderService) OrderBundle(ctx context.Context, dto *domain.OrderBundleDTO) (*domain.OrderedBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderBundle", ctx, dto)
+	ret0, _ := ret[0].(*domain.OrderedBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderBundle indicates an expected call of OrderBundle.
+func (mr *MockOrderServiceMockRecorder) OrderBundle(ctx, dto any) *MockOrderServiceOrderBundleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderBundle", reflect.TypeOf((*MockOrderService)(nil).OrderBundle), ctx, dto)
+	return &MockOrderServiceOrderBundleCall{Call: call}
+}
+
+// MockOrderServiceOrderBundleCall wrap *gomock.Call
+type MockOrderServiceOrderBundleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderServiceOrderBundleCall) Return(arg0 *domain.OrderedBundle, arg1 error) *MockOrderServiceOrderBundleCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockOrderServiceGetSessionsCall) Do(f func(context.Context) ([]domain.OrderSession, error)) *MockOrderServiceGetSessionsCall {
+func (c *MockOrderServiceOrderBundleCall) Do(f func(context.Context, *domain.OrderBundleDTO) (*domain.OrderedBundle, error)) *MockOrderServiceOrderBundleCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockOrderServiceGetSessionsCall) DoAndReturn(f func(context.Context) ([]domain.OrderSession, error)) *MockOrderServiceGetSessionsCall {
+func (c *MockOrderServiceOrderBundleCall) DoAndReturn(f func(context.Context, *domain.OrderBundleDTO) (*domain.OrderedBundle, error)) *MockOrderServiceOrderBundleCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // OrderProduct mocks base method.
-func (m *MockOrderService) OrderProduct(ctx context.Context, productId, sessionId uuid.UUID) (uuid.UUID, error) {
+func (m *MockOrderService) OrderProduct(ctx context.Context, dto *domain.OrderProductDTO) (*domain.OrderedProduct, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "OrderProduct", ctx, productId, sessionId)
-	ret0, _ := ret[0].(uuid.UUID)
+	ret := m.ctrl.Call(m, "OrderProduct", ctx, dto)
+	ret0, _ := ret[0].(*domain.OrderedProduct)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // OrderProduct indicates an expected call of OrderProduct.
-func (mr *MockOrderServiceMockRecorder) OrderProduct(ctx, productId, sessionId any) *MockOrderServiceOrderProductCall {
+func (mr *MockOrderServiceMockRecorder) OrderProduct(ctx, dto any) *MockOrderServiceOrderProductCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderProduct", reflect.TypeOf((*MockOrderService)(nil).OrderProduct), ctx, productId, sessionId)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderProduct", reflect.TypeOf((*MockOrderService)(nil).OrderProduct), ctx, dto)
 	return &MockOrderServiceOrderProductCall{Call: call}
 }
 
@@ -550,31 +940,148 @@ type MockOrderServiceOrderProductCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockOrderServiceOrderProductCall) Return(arg0 uuid.UUID, arg1 error) *MockOrderServiceOrderProductCall {
+func (c *MockOrderServiceOrderProductCall) Return(arg0 *domain.OrderedProduct, arg1 error) *MockOrderServiceOrderProductCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockOrderServiceOrderProductCall) Do(f func(context.Context, uuid.UUID, uuid.UUID) (uuid.UUID, error)) *MockOrderServiceOrderProductCall {
+func (c *MockOrderServiceOrderProductCall) Do(f func(context.Context, *domain.OrderProductDTO) (*domain.OrderedProduct, error)) *MockOrderServiceOrderProductCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockOrderServiceOrderProductCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID) (uuid.UUID, error)) *MockOrderServiceOrderProductCall {
+func (c *MockOrderServiceOrderProductCall) DoAndReturn(f func(context.Context, *domain.OrderProductDTO) (*domain.OrderedProduct, error)) *MockOrderServiceOrderProductCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateSession mocks base method.
-func (m *MockOrderService) UpdateSession(ctx context.Context, session *domain.UpdateOrderSessionDTO) error {
+// PayBill mocks base method.
+func (m *MockOrderService) PayBill(ctx context.Context, sessionId uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSession", ctx, session)
+	ret := m.ctrl.Call(m, "PayBill", ctx, sessionId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// PayBill indicates an expected call of PayBill.
+func (mr *MockOrderServiceMockRecorder) PayBill(ctx, sessionId any) *MockOrderServicePayBillCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayBill", reflect.TypeOf((*MockOrderService)(nil).PayBill), ctx, sessionId)
+	return &MockOrderServicePayBillCall{Call: call}
+}
+
+// MockOrderServicePayBillCall wrap *gomock.Call
+type MockOrderServicePayBillCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderServicePayBillCall) Return(arg0 error) *MockOrderServicePayBillCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderServicePayBillCall) Do(f func(context.Context, uuid.UUID) error) *MockOrderServicePayBillCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderServicePayBillCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockOrderServicePayBillCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SubmitCart mocks base method.
+func (m *MockOrderService) SubmitCart(ctx context.Context, sessionId uuid.UUID, items []domain.OrderProductDTO) ([]domain.OrderedProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCart", ctx, sessionId, items)
+	ret0, _ := ret[0].([]domain.OrderedProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitCart indicates an expected call of SubmitCart.
+func (mr *MockOrderServiceMockRecorder) SubmitCart(ctx, sessionId, items any) *MockOrderServiceSubmitCartCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCart", reflect.TypeOf((*MockOrderService)(nil).SubmitCart), ctx, sessionId, items)
+	return &MockOrderServiceSubmitCartCall{Call: call}
+}
+
+// MockOrderServiceSubmitCartCall wrap *gomock.Call
+type MockOrderServiceSubmitCartCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderServiceSubmitCartCall) Return(arg0 []domain.OrderedProduct, arg1 error) *MockOrderServiceSubmitCartCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderServiceSubmitCartCall) Do(f func(context.Context, uuid.UUID, []domain.OrderProductDTO) ([]domain.OrderedProduct, error)) *MockOrderServiceSubmitCartCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderServiceSubmitCartCall) DoAndReturn(f func(context.Context, uuid.UUID, []domain.OrderProductDTO) ([]domain.OrderedProduct, error)) *MockOrderServiceSubmitCartCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateOrderedProductStatus mocks base method.
+func (m *MockOrderService) UpdateOrderedProductStatus(ctx context.Context, id uuid.UUID, status domain.OrderedProductStatus) (*domain.OrderedProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderedProductStatus", ctx, id, status)
+	ret0, _ := ret[0].(*domain.OrderedProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrderedProductStatus indicates an expected call of UpdateOrderedProductStatus.
+func (mr *MockOrderServiceMockRecorder) UpdateOrderedProductStatus(ctx, id, status any) *MockOrderServiceUpdateOrderedProductStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderedProductStatus", reflect.TypeOf((*MockOrderService)(nil).UpdateOrderedProductStatus), ctx, id, status)
+	return &MockOrderServiceUpdateOrderedProductStatusCall{Call: call}
+}
+
+// MockOrderServiceUpdateOrderedProductStatusCall wrap *gomock.Call
+type MockOrderServiceUpdateOrderedProductStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockOrderServiceUpdateOrderedProductStatusCall) Return(arg0 *domain.OrderedProduct, arg1 error) *MockOrderServiceUpdateOrderedProductStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockOrderServiceUpdateOrderedProductStatusCall) Do(f func(context.Context, uuid.UUID, domain.OrderedProductStatus) (*domain.OrderedProduct, error)) *MockOrderServiceUpdateOrderedProductStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockOrderServiceUpdateOrderedProductStatusCall) DoAndReturn(f func(context.Context, uuid.UUID, domain.OrderedProductStatus) (*domain.OrderedProduct, error)) *MockOrderServiceUpdateOrderedProductStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSession mocks base method.
+func (m *MockOrderService) UpdateSession(ctx context.Context, session *domain.UpdateOrderSessionDTO) (*domain.OrderSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSession", ctx, session)
+	ret0, _ := ret[0].(*domain.OrderSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateSession indicates an expected call of UpdateSession.
 func (mr *MockOrderServiceMockRecorder) UpdateSession(ctx, session any) *MockOrderServiceUpdateSessionCall {
 	mr.mock.ctrl.T.Helper()
@@ -588,19 +1095,19 @@ type MockOrderServiceUpdateSessionCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockOrderServiceUpdateSessionCall) Return(arg0 error) *MockOrderServiceUpdateSessionCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockOrderServiceUpdateSessionCall) Return(arg0 *domain.OrderSession, arg1 error) *MockOrderServiceUpdateSessionCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockOrderServiceUpdateSessionCall) Do(f func(context.Context, *domain.UpdateOrderSessionDTO) error) *MockOrderServiceUpdateSessionCall {
+func (c *MockOrderServiceUpdateSessionCall) Do(f func(context.Context, *domain.UpdateOrderSessionDTO) (*domain.OrderSession, error)) *MockOrderServiceUpdateSessionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockOrderServiceUpdateSessionCall) DoAndReturn(f func(context.Context, *domain.UpdateOrderSessionDTO) error) *MockOrderServiceUpdateSessionCall {
+func (c *MockOrderServiceUpdateSessionCall) DoAndReturn(f func(context.Context, *domain.UpdateOrderSessionDTO) (*domain.OrderSession, error)) *MockOrderServiceUpdateSessionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -14,6 +14,7 @@ import (
 	io "io"
 	reflect "reflect"
 	domain "restaurant/internal/core/domain"
+	port "restaurant/internal/core/port"
 
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -81,6 +82,82 @@ func (c *MockProductRepositoryAddCategoryCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// AddModifierGroup mocks base method.
+func (m *MockProductRepository) AddModifierGroup(ctx context.Context, group *domain.ModifierGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddModifierGroup", ctx, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddModifierGroup indicates an expected call of AddModifierGroup.
+func (mr *MockProductRepositoryMockRecorder) AddModifierGroup(ctx, group any) *MockProductRepositoryAddModifierGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddModifierGroup", reflect.TypeOf((*MockProductRepository)(nil).AddModifierGroup), ctx, group)
+	return &MockProductRepositoryAddModifierGroupCall{Call: call}
+}
+
+// MockProductRepositoryAddModifierGroupCall wrap *gomock.Call
+type MockProductRepositoryAddModifierGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryAddModifierGroupCall) Return(arg0 error) *MockProductRepositoryAddModifierGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryAddModifierGroupCall) Do(f func(context.Context, *domain.ModifierGroup) error) *MockProductRepositoryAddModifierGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryAddModifierGroupCall) DoAndReturn(f func(context.Context, *domain.ModifierGroup) error) *MockProductRepositoryAddModifierGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddPricingRule mocks base method.
+func (m *MockProductRepository) AddPricingRule(ctx context.Context, rule *domain.PricingRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPricingRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPricingRule indicates an expected call of AddPricingRule.
+func (mr *MockProductRepositoryMockRecorder) AddPricingRule(ctx, rule any) *MockProductRepositoryAddPricingRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPricingRule", reflect.TypeOf((*MockProductRepository)(nil).AddPricingRule), ctx, rule)
+	return &MockProductRepositoryAddPricingRuleCall{Call: call}
+}
+
+// MockProductRepositoryAddPricingRuleCall wrap *gomock.Call
+type MockProductRepositoryAddPricingRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryAddPricingRuleCall) Return(arg0 error) *MockProductRepositoryAddPricingRuleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryAddPricingRuleCall) Do(f func(context.Context, *domain.PricingRule) error) *MockProductRepositoryAddPricingRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryAddPricingRuleCall) DoAndReturn(f func(context.Context, *domain.PricingRule) error) *MockProductRepositoryAddPricingRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddProduct mocks base method.
 func (m *MockProductRepository) AddProduct(ctx context.Context, product *domain.Product) error {
 	m.ctrl.T.Helper()
@@ -108,694 +185,3464 @@ func (c *MockProductRepositoryAddProductCall) Return(arg0 error) *MockProductRep
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryAddProductCall) Do(f func(context.Context, *domain.Product) error) *MockProductRepositoryAddProductCall {
+func (c *MockProductRepositoryAddProductCall) Do(f func(context.Context, *domain.Product) error) *MockProductRepositoryAddProductCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryAddProductCall) DoAndReturn(f func(context.Context, *domain.Product) error) *MockProductRepositoryAddProductCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddProductImage mocks base method.
+func (m *MockProductRepository) AddProductImage(ctx context.Context, image *domain.ProductImage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProductImage", ctx, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddProductImage indicates an expected call of AddProductImage.
+func (mr *MockProductRepositoryMockRecorder) AddProductImage(ctx, image any) *MockProductRepositoryAddProductImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductImage", reflect.TypeOf((*MockProductRepository)(nil).AddProductImage), ctx, image)
+	return &MockProductRepositoryAddProductImageCall{Call: call}
+}
+
+// MockProductRepositoryAddProductImageCall wrap *gomock.Call
+type MockProductRepositoryAddProductImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryAddProductImageCall) Return(arg0 error) *MockProductRepositoryAddProductImageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryAddProductImageCall) Do(f func(context.Context, *domain.ProductImage) error) *MockProductRepositoryAddProductImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryAddProductImageCall) DoAndReturn(f func(context.Context, *domain.ProductImage) error) *MockProductRepositoryAddProductImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddTag mocks base method.
+func (m *MockProductRepository) AddTag(ctx context.Context, tag *domain.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTag", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTag indicates an expected call of AddTag.
+func (mr *MockProductRepositoryMockRecorder) AddTag(ctx, tag any) *MockProductRepositoryAddTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockProductRepository)(nil).AddTag), ctx, tag)
+	return &MockProductRepositoryAddTagCall{Call: call}
+}
+
+// MockProductRepositoryAddTagCall wrap *gomock.Call
+type MockProductRepositoryAddTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryAddTagCall) Return(arg0 error) *MockProductRepositoryAddTagCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryAddTagCall) Do(f func(context.Context, *domain.Tag) error) *MockProductRepositoryAddTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryAddTagCall) DoAndReturn(f func(context.Context, *domain.Tag) error) *MockProductRepositoryAddTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CountProducts mocks base method.
+func (m *MockProductRepository) CountProducts(ctx context.Context, dto *domain.GetProductsDTO) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountProducts", ctx, dto)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountProducts indicates an expected call of CountProducts.
+func (mr *MockProductRepositoryMockRecorder) CountProducts(ctx, dto any) *MockProductRepositoryCountProductsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProducts", reflect.TypeOf((*MockProductRepository)(nil).CountProducts), ctx, dto)
+	return &MockProductRepositoryCountProductsCall{Call: call}
+}
+
+// MockProductRepositoryCountProductsCall wrap *gomock.Call
+type MockProductRepositoryCountProductsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryCountProductsCall) Return(arg0 int, arg1 error) *MockProductRepositoryCountProductsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryCountProductsCall) Do(f func(context.Context, *domain.GetProductsDTO) (int, error)) *MockProductRepositoryCountProductsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryCountProductsCall) DoAndReturn(f func(context.Context, *domain.GetProductsDTO) (int, error)) *MockProductRepositoryCountProductsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCategory mocks base method.
+func (m *MockProductRepository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockProductRepositoryMockRecorder) DeleteCategory(ctx, id any) *MockProductRepositoryDeleteCategoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockProductRepository)(nil).DeleteCategory), ctx, id)
+	return &MockProductRepositoryDeleteCategoryCall{Call: call}
+}
+
+// MockProductRepositoryDeleteCategoryCall wrap *gomock.Call
+type MockProductRepositoryDeleteCategoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryDeleteCategoryCall) Return(arg0 error) *MockProductRepositoryDeleteCategoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryDeleteCategoryCall) Do(f func(context.Context, uuid.UUID) error) *MockProductRepositoryDeleteCategoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryDeleteCategoryCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductRepositoryDeleteCategoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCategoryTranslation mocks base method.
+func (m *MockProductRepository) DeleteCategoryTranslation(ctx context.Context, categoryId uuid.UUID, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryTranslation", ctx, categoryId, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategoryTranslation indicates an expected call of DeleteCategoryTranslation.
+func (mr *MockProductRepositoryMockRecorder) DeleteCategoryTranslation(ctx, categoryId, locale any) *MockProductRepositoryDeleteCategoryTranslationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryTranslation", reflect.TypeOf((*MockProductRepository)(nil).DeleteCategoryTranslation), ctx, categoryId, locale)
+	return &MockProductRepositoryDeleteCategoryTranslationCall{Call: call}
+}
+
+// MockProductRepositoryDeleteCategoryTranslationCall wrap *gomock.Call
+type MockProductRepositoryDeleteCategoryTranslationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryDeleteCategoryTranslationCall) Return(arg0 error) *MockProductRepositoryDeleteCategoryTranslationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryDeleteCategoryTranslationCall) Do(f func(context.Context, uuid.UUID, string) error) *MockProductRepositoryDeleteCategoryTranslationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryDeleteCategoryTranslationCall) DoAndReturn(f func(context.Context, uuid.UUID, string) error) *MockProductRepositoryDeleteCategoryTranslationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteMenuDraft mocks base method.
+func (m *MockProductRepository) DeleteMenuDraft(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMenuDraft", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMenuDraft indicates an expected call of DeleteMenuDraft.
+func (mr *MockProductRepositoryMockRecorder) DeleteMenuDraft(ctx any) *MockProductRepositoryDeleteMenuDraftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMenuDraft", reflect.TypeOf((*MockProductRepository)(nil).DeleteMenuDraft), ctx)
+	return &MockProductRepositoryDeleteMenuDraftCall{Call: call}
+}
+
+// MockProductRepositoryDeleteMenuDraftCall wrap *gomock.Call
+type MockProductRepositoryDeleteMenuDraftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryDeleteMenuDraftCall) Return(arg0 error) *MockProductRepositoryDeleteMenuDraftCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryDeleteMenuDraftCall) Do(f func(context.Context) error) *MockProductRepositoryDeleteMenuDraftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryDeleteMenuDraftCall) DoAndReturn(f func(context.Context) error) *MockProductRepositoryDeleteMenuDraftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteModifierGroup mocks base method.
+func (m *MockProductRepository) DeleteModifierGroup(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteModifierGroup", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteModifierGroup indicates an expected call of DeleteModifierGroup.
+func (mr *MockProductRepositoryMockRecorder) DeleteModifierGroup(ctx, id any) *MockProductRepositoryDeleteModifierGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModifierGroup", reflect.TypeOf((*MockProductRepository)(nil).DeleteModifierGroup), ctx, id)
+	return &MockProductRepositoryDeleteModifierGroupCall{Call: call}
+}
+
+// MockProductRepositoryDeleteModifierGroupCall wrap *gomock.Call
+type MockProductRepositoryDeleteModifierGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryDeleteModifierGroupCall) Return(arg0 error) *MockProductRepositoryDeleteModifierGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryDeleteModifierGroupCall) Do(f func(context.Context, uuid.UUID) error) *MockProductRepositoryDeleteModifierGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryDeleteModifierGroupCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductRepositoryDeleteModifierGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePricingRule mocks base method.
+func (m *MockProductRepository) DeletePricingRule(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePricingRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePricingRule indicates an expected call of DeletePricingRule.
+func (mr *MockProductRepositoryMockRecorder) DeletePricingRule(ctx, id any) *MockProductRepositoryDeletePricingRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePricingRule", reflect.TypeOf((*MockProductRepository)(nil).DeletePricingRule), ctx, id)
+	return &MockProductRepositoryDeletePricingRuleCall{Call: call}
+}
+
+// MockProductRepositoryDeletePricingRuleCall wrap *gomock.Call
+type MockProductRepositoryDeletePricingRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryDeletePricingRuleCall) Return(arg0 error) *MockProductRepositoryDeletePricingRuleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryDeletePricingRuleCall) Do(f func(context.Context, uuid.UUID) error) *MockProductRepositoryDeletePricingRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryDeletePricingRuleCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductRepositoryDeletePricingRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteProductById mocks base method.
+func (m *MockProductRepository) DeleteProductById(ctx context.Context, id uuid.UUID) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductById", ctx, id)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductById indicates an expected call of DeleteProductById.
+func (mr *MockProductRepositoryMockRecorder) DeleteProductById(ctx, id any) *MockProductRepositoryDeleteProductByIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductById", reflect.TypeOf((*MockProductRepository)(nil).DeleteProductById), ctx, id)
+	return &MockProductRepositoryDeleteProductByIdCall{Call: call}
+}
+
+// MockProductRepositoryDeleteProductByIdCall wrap *gomock.Call
+type MockProductRepositoryDeleteProductByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryDeleteProductByIdCall) Return(arg0 *domain.Product, arg1 error) *MockProductRepositoryDeleteProductByIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryDeleteProductByIdCall) Do(f func(context.Context, uuid.UUID) (*domain.Product, error)) *MockProductRepositoryDeleteProductByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryDeleteProductByIdCall) DoAndReturn(f func(context.Context, uuid.UUID) (*domain.Product, error)) *MockProductRepositoryDeleteProductByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteProductImage mocks base method.
+func (m *MockProductRepository) DeleteProductImage(ctx context.Context, productId uuid.UUID, imageId uuid.UUID) (*domain.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductImage", ctx, productId, imageId)
+	ret0, _ := ret[0].(*domain.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductImage indicates an expected call of DeleteProductImage.
+func (mr *MockProductRepositoryMockRecorder) DeleteProductImage(ctx, productId, imageId any) *MockProductRepositoryDeleteProductImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductImage", reflect.TypeOf((*MockProductRepository)(nil).DeleteProductImage), ctx, productId, imageId)
+	return &MockProductRepositoryDeleteProductImageCall{Call: call}
+}
+
+// MockProductRepositoryDeleteProductImageCall wrap *gomock.Call
+type MockProductRepositoryDeleteProductImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryDeleteProductImageCall) Return(arg0 *domain.ProductImage, arg1 error) *MockProductRepositoryDeleteProductImageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryDeleteProductImageCall) Do(f func(context.Context, uuid.UUID, uuid.UUID) (*domain.ProductImage, error)) *MockProductRepositoryDeleteProductImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryDeleteProductImageCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID) (*domain.ProductImage, error)) *MockProductRepositoryDeleteProductImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteProductTranslation mocks base method.
+func (m *MockProductRepository) DeleteProductTranslation(ctx context.Context, productId uuid.UUID, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductTranslation", ctx, productId, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductTranslation indicates an expected call of DeleteProductTranslation.
+func (mr *MockProductRepositoryMockRecorder) DeleteProductTranslation(ctx, productId, locale any) *MockProductRepositoryDeleteProductTranslationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductTranslation", reflect.TypeOf((*MockProductRepository)(nil).DeleteProductTranslation), ctx, productId, locale)
+	return &MockProductRepositoryDeleteProductTranslationCall{Call: call}
+}
+
+// MockProductRepositoryDeleteProductTranslationCall wrap *gomock.Call
+type MockProductRepositoryDeleteProductTranslationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryDeleteProductTranslationCall) Return(arg0 error) *MockProductRepositoryDeleteProductTranslationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryDeleteProductTranslationCall) Do(f func(context.Context, uuid.UUID, string) error) *MockProductRepositoryDeleteProductTranslationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryDeleteProductTranslationCall) DoAndReturn(f func(context.Context, uuid.UUID, string) error) *MockProductRepositoryDeleteProductTranslationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteProductsByCategory mocks base method.
+func (m *MockProductRepository) DeleteProductsByCategory(ctx context.Context, categoryId uuid.UUID) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductsByCategory", ctx, categoryId)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductsByCategory indicates an expected call of DeleteProductsByCategory.
+func (mr *MockProductRepositoryMockRecorder) DeleteProductsByCategory(ctx, categoryId any) *MockProductRepositoryDeleteProductsByCategoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductsByCategory", reflect.TypeOf((*MockProductRepository)(nil).DeleteProductsByCategory), ctx, categoryId)
+	return &MockProductRepositoryDeleteProductsByCategoryCall{Call: call}
+}
+
+// MockProductRepositoryDeleteProductsByCategoryCall wrap *gomock.Call
+type MockProductRepositoryDeleteProductsByCategoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryDeleteProductsByCategoryCall) Return(arg0 []domain.Product, arg1 error) *MockProductRepositoryDeleteProductsByCategoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryDeleteProductsByCategoryCall) Do(f func(context.Context, uuid.UUID) ([]domain.Product, error)) *MockProductRepositoryDeleteProductsByCategoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryDeleteProductsByCategoryCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.Product, error)) *MockProductRepositoryDeleteProductsByCategoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteTag mocks base method.
+func (m *MockProductRepository) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockProductRepositoryMockRecorder) DeleteTag(ctx, id any) *MockProductRepositoryDeleteTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockProductRepository)(nil).DeleteTag), ctx, id)
+	return &MockProductRepositoryDeleteTagCall{Call: call}
+}
+
+// MockProductRepositoryDeleteTagCall wrap *gomock.Call
+type MockProductRepositoryDeleteTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryDeleteTagCall) Return(arg0 error) *MockProductRepositoryDeleteTagCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryDeleteTagCall) Do(f func(context.Context, uuid.UUID) error) *MockProductRepositoryDeleteTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryDeleteTagCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductRepositoryDeleteTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetArchivedCategories mocks base method.
+func (m *MockProductRepository) GetArchivedCategories(ctx context.Context) ([]domain.ProductCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedCategories", ctx)
+	ret0, _ := ret[0].([]domain.ProductCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedCategories indicates an expected call of GetArchivedCategories.
+func (mr *MockProductRepositoryMockRecorder) GetArchivedCategories(ctx any) *MockProductRepositoryGetArchivedCategoriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedCategories", reflect.TypeOf((*MockProductRepository)(nil).GetArchivedCategories), ctx)
+	return &MockProductRepositoryGetArchivedCategoriesCall{Call: call}
+}
+
+// MockProductRepositoryGetArchivedCategoriesCall wrap *gomock.Call
+type MockProductRepositoryGetArchivedCategoriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetArchivedCategoriesCall) Return(arg0 []domain.ProductCategory, arg1 error) *MockProductRepositoryGetArchivedCategoriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetArchivedCategoriesCall) Do(f func(context.Context) ([]domain.ProductCategory, error)) *MockProductRepositoryGetArchivedCategoriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetArchivedCategoriesCall) DoAndReturn(f func(context.Context) ([]domain.ProductCategory, error)) *MockProductRepositoryGetArchivedCategoriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetArchivedProducts mocks base method.
+func (m *MockProductRepository) GetArchivedProducts(ctx context.Context) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedProducts", ctx)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedProducts indicates an expected call of GetArchivedProducts.
+func (mr *MockProductRepositoryMockRecorder) GetArchivedProducts(ctx any) *MockProductRepositoryGetArchivedProductsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedProducts", reflect.TypeOf((*MockProductRepository)(nil).GetArchivedProducts), ctx)
+	return &MockProductRepositoryGetArchivedProductsCall{Call: call}
+}
+
+// MockProductRepositoryGetArchivedProductsCall wrap *gomock.Call
+type MockProductRepositoryGetArchivedProductsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetArchivedProductsCall) Return(arg0 []domain.Product, arg1 error) *MockProductRepositoryGetArchivedProductsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetArchivedProductsCall) Do(f func(context.Context) ([]domain.Product, error)) *MockProductRepositoryGetArchivedProductsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetArchivedProductsCall) DoAndReturn(f func(context.Context) ([]domain.Product, error)) *MockProductRepositoryGetArchivedProductsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCategorySchedule mocks base method.
+func (m *MockProductRepository) GetCategorySchedule(ctx context.Context, categoryId uuid.UUID) ([]domain.ScheduleWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategorySchedule", ctx, categoryId)
+	ret0, _ := ret[0].([]domain.ScheduleWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategorySchedule indicates an expected call of GetCategorySchedule.
+func (mr *MockProductRepositoryMockRecorder) GetCategorySchedule(ctx, categoryId any) *MockProductRepositoryGetCategoryScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategorySchedule", reflect.TypeOf((*MockProductRepository)(nil).GetCategorySchedule), ctx, categoryId)
+	return &MockProductRepositoryGetCategoryScheduleCall{Call: call}
+}
+
+// MockProductRepositoryGetCategoryScheduleCall wrap *gomock.Call
+type MockProductRepositoryGetCategoryScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetCategoryScheduleCall) Return(arg0 []domain.ScheduleWindow, arg1 error) *MockProductRepositoryGetCategoryScheduleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetCategoryScheduleCall) Do(f func(context.Context, uuid.UUID) ([]domain.ScheduleWindow, error)) *MockProductRepositoryGetCategoryScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetCategoryScheduleCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.ScheduleWindow, error)) *MockProductRepositoryGetCategoryScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCategoryTranslations mocks base method.
+func (m *MockProductRepository) GetCategoryTranslations(ctx context.Context, categoryId uuid.UUID) ([]domain.CategoryTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTranslations", ctx, categoryId)
+	ret0, _ := ret[0].([]domain.CategoryTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTranslations indicates an expected call of GetCategoryTranslations.
+func (mr *MockProductRepositoryMockRecorder) GetCategoryTranslations(ctx, categoryId any) *MockProductRepositoryGetCategoryTranslationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTranslations", reflect.TypeOf((*MockProductRepository)(nil).GetCategoryTranslations), ctx, categoryId)
+	return &MockProductRepositoryGetCategoryTranslationsCall{Call: call}
+}
+
+// MockProductRepositoryGetCategoryTranslationsCall wrap *gomock.Call
+type MockProductRepositoryGetCategoryTranslationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetCategoryTranslationsCall) Return(arg0 []domain.CategoryTranslation, arg1 error) *MockProductRepositoryGetCategoryTranslationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetCategoryTranslationsCall) Do(f func(context.Context, uuid.UUID) ([]domain.CategoryTranslation, error)) *MockProductRepositoryGetCategoryTranslationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetCategoryTranslationsCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.CategoryTranslation, error)) *MockProductRepositoryGetCategoryTranslationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMenu mocks base method.
+func (m *MockProductRepository) GetMenu(ctx context.Context) (*domain.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMenu", ctx)
+	ret0, _ := ret[0].(*domain.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMenu indicates an expected call of GetMenu.
+func (mr *MockProductRepositoryMockRecorder) GetMenu(ctx any) *MockProductRepositoryGetMenuCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenu", reflect.TypeOf((*MockProductRepository)(nil).GetMenu), ctx)
+	return &MockProductRepositoryGetMenuCall{Call: call}
+}
+
+// MockProductRepositoryGetMenuCall wrap *gomock.Call
+type MockProductRepositoryGetMenuCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetMenuCall) Return(arg0 *domain.Menu, arg1 error) *MockProductRepositoryGetMenuCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetMenuCall) Do(f func(context.Context) (*domain.Menu, error)) *MockProductRepositoryGetMenuCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetMenuCall) DoAndReturn(f func(context.Context) (*domain.Menu, error)) *MockProductRepositoryGetMenuCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMenuDraft mocks base method.
+func (m *MockProductRepository) GetMenuDraft(ctx context.Context) (*domain.MenuDraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMenuDraft", ctx)
+	ret0, _ := ret[0].(*domain.MenuDraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMenuDraft indicates an expected call of GetMenuDraft.
+func (mr *MockProductRepositoryMockRecorder) GetMenuDraft(ctx any) *MockProductRepositoryGetMenuDraftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenuDraft", reflect.TypeOf((*MockProductRepository)(nil).GetMenuDraft), ctx)
+	return &MockProductRepositoryGetMenuDraftCall{Call: call}
+}
+
+// MockProductRepositoryGetMenuDraftCall wrap *gomock.Call
+type MockProductRepositoryGetMenuDraftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetMenuDraftCall) Return(arg0 *domain.MenuDraft, arg1 error) *MockProductRepositoryGetMenuDraftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetMenuDraftCall) Do(f func(context.Context) (*domain.MenuDraft, error)) *MockProductRepositoryGetMenuDraftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetMenuDraftCall) DoAndReturn(f func(context.Context) (*domain.MenuDraft, error)) *MockProductRepositoryGetMenuDraftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMenuVersion mocks base method.
+func (m *MockProductRepository) GetMenuVersion(ctx context.Context, number int) (*domain.MenuVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMenuVersion", ctx, number)
+	ret0, _ := ret[0].(*domain.MenuVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMenuVersion indicates an expected call of GetMenuVersion.
+func (mr *MockProductRepositoryMockRecorder) GetMenuVersion(ctx, number any) *MockProductRepositoryGetMenuVersionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenuVersion", reflect.TypeOf((*MockProductRepository)(nil).GetMenuVersion), ctx, number)
+	return &MockProductRepositoryGetMenuVersionCall{Call: call}
+}
+
+// MockProductRepositoryGetMenuVersionCall wrap *gomock.Call
+type MockProductRepositoryGetMenuVersionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetMenuVersionCall) Return(arg0 *domain.MenuVersion, arg1 error) *MockProductRepositoryGetMenuVersionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetMenuVersionCall) Do(f func(context.Context, int) (*domain.MenuVersion, error)) *MockProductRepositoryGetMenuVersionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetMenuVersionCall) DoAndReturn(f func(context.Context, int) (*domain.MenuVersion, error)) *MockProductRepositoryGetMenuVersionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMenuVersions mocks base method.
+func (m *MockProductRepository) GetMenuVersions(ctx context.Context) ([]domain.MenuVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMenuVersions", ctx)
+	ret0, _ := ret[0].([]domain.MenuVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMenuVersions indicates an expected call of GetMenuVersions.
+func (mr *MockProductRepositoryMockRecorder) GetMenuVersions(ctx any) *MockProductRepositoryGetMenuVersionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenuVersions", reflect.TypeOf((*MockProductRepository)(nil).GetMenuVersions), ctx)
+	return &MockProductRepositoryGetMenuVersionsCall{Call: call}
+}
+
+// MockProductRepositoryGetMenuVersionsCall wrap *gomock.Call
+type MockProductRepositoryGetMenuVersionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetMenuVersionsCall) Return(arg0 []domain.MenuVersion, arg1 error) *MockProductRepositoryGetMenuVersionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetMenuVersionsCall) Do(f func(context.Context) ([]domain.MenuVersion, error)) *MockProductRepositoryGetMenuVersionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetMenuVersionsCall) DoAndReturn(f func(context.Context) ([]domain.MenuVersion, error)) *MockProductRepositoryGetMenuVersionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPricingRules mocks base method.
+func (m *MockProductRepository) GetPricingRules(ctx context.Context) ([]domain.PricingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPricingRules", ctx)
+	ret0, _ := ret[0].([]domain.PricingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPricingRules indicates an expected call of GetPricingRules.
+func (mr *MockProductRepositoryMockRecorder) GetPricingRules(ctx any) *MockProductRepositoryGetPricingRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPricingRules", reflect.TypeOf((*MockProductRepository)(nil).GetPricingRules), ctx)
+	return &MockProductRepositoryGetPricingRulesCall{Call: call}
+}
+
+// MockProductRepositoryGetPricingRulesCall wrap *gomock.Call
+type MockProductRepositoryGetPricingRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetPricingRulesCall) Return(arg0 []domain.PricingRule, arg1 error) *MockProductRepositoryGetPricingRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetPricingRulesCall) Do(f func(context.Context) ([]domain.PricingRule, error)) *MockProductRepositoryGetPricingRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetPricingRulesCall) DoAndReturn(f func(context.Context) ([]domain.PricingRule, error)) *MockProductRepositoryGetPricingRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProductById mocks base method.
+func (m *MockProductRepository) GetProductById(ctx context.Context, id uuid.UUID) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductById", ctx, id)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductById indicates an expected call of GetProductById.
+func (mr *MockProductRepositoryMockRecorder) GetProductById(ctx, id any) *MockProductRepositoryGetProductByIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductById", reflect.TypeOf((*MockProductRepository)(nil).GetProductById), ctx, id)
+	return &MockProductRepositoryGetProductByIdCall{Call: call}
+}
+
+// MockProductRepositoryGetProductByIdCall wrap *gomock.Call
+type MockProductRepositoryGetProductByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetProductByIdCall) Return(arg0 *domain.Product, arg1 error) *MockProductRepositoryGetProductByIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetProductByIdCall) Do(f func(context.Context, uuid.UUID) (*domain.Product, error)) *MockProductRepositoryGetProductByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetProductByIdCall) DoAndReturn(f func(context.Context, uuid.UUID) (*domain.Product, error)) *MockProductRepositoryGetProductByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProductCategories mocks base method.
+func (m *MockProductRepository) GetProductCategories(ctx context.Context, locales []string) ([]domain.ProductCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductCategories", ctx, locales)
+	ret0, _ := ret[0].([]domain.ProductCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductCategories indicates an expected call of GetProductCategories.
+func (mr *MockProductRepositoryMockRecorder) GetProductCategories(ctx, locales any) *MockProductRepositoryGetProductCategoriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCategories", reflect.TypeOf((*MockProductRepository)(nil).GetProductCategories), ctx, locales)
+	return &MockProductRepositoryGetProductCategoriesCall{Call: call}
+}
+
+// MockProductRepositoryGetProductCategoriesCall wrap *gomock.Call
+type MockProductRepositoryGetProductCategoriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetProductCategoriesCall) Return(arg0 []domain.ProductCategory, arg1 error) *MockProductRepositoryGetProductCategoriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetProductCategoriesCall) Do(f func(context.Context, []string) ([]domain.ProductCategory, error)) *MockProductRepositoryGetProductCategoriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetProductCategoriesCall) DoAndReturn(f func(context.Context, []string) ([]domain.ProductCategory, error)) *MockProductRepositoryGetProductCategoriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProductSchedule mocks base method.
+func (m *MockProductRepository) GetProductSchedule(ctx context.Context, productId uuid.UUID) ([]domain.ScheduleWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductSchedule", ctx, productId)
+	ret0, _ := ret[0].([]domain.ScheduleWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductSchedule indicates an expected call of GetProductSchedule.
+func (mr *MockProductRepositoryMockRecorder) GetProductSchedule(ctx, productId any) *MockProductRepositoryGetProductScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductSchedule", reflect.TypeOf((*MockProductRepository)(nil).GetProductSchedule), ctx, productId)
+	return &MockProductRepositoryGetProductScheduleCall{Call: call}
+}
+
+// MockProductRepositoryGetProductScheduleCall wrap *gomock.Call
+type MockProductRepositoryGetProductScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetProductScheduleCall) Return(arg0 []domain.ScheduleWindow, arg1 error) *MockProductRepositoryGetProductScheduleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetProductScheduleCall) Do(f func(context.Context, uuid.UUID) ([]domain.ScheduleWindow, error)) *MockProductRepositoryGetProductScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetProductScheduleCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.ScheduleWindow, error)) *MockProductRepositoryGetProductScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProductTranslations mocks base method.
+func (m *MockProductRepository) GetProductTranslations(ctx context.Context, productId uuid.UUID) ([]domain.ProductTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductTranslations", ctx, productId)
+	ret0, _ := ret[0].([]domain.ProductTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductTranslations indicates an expected call of GetProductTranslations.
+func (mr *MockProductRepositoryMockRecorder) GetProductTranslations(ctx, productId any) *MockProductRepositoryGetProductTranslationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductTranslations", reflect.TypeOf((*MockProductRepository)(nil).GetProductTranslations), ctx, productId)
+	return &MockProductRepositoryGetProductTranslationsCall{Call: call}
+}
+
+// MockProductRepositoryGetProductTranslationsCall wrap *gomock.Call
+type MockProductRepositoryGetProductTranslationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetProductTranslationsCall) Return(arg0 []domain.ProductTranslation, arg1 error) *MockProductRepositoryGetProductTranslationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetProductTranslationsCall) Do(f func(context.Context, uuid.UUID) ([]domain.ProductTranslation, error)) *MockProductRepositoryGetProductTranslationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetProductTranslationsCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.ProductTranslation, error)) *MockProductRepositoryGetProductTranslationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProducts mocks base method.
+func (m *MockProductRepository) GetProducts(ctx context.Context, dto *domain.GetProductsDTO) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProducts", ctx, dto)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProducts indicates an expected call of GetProducts.
+func (mr *MockProductRepositoryMockRecorder) GetProducts(ctx, dto any) *MockProductRepositoryGetProductsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockProductRepository)(nil).GetProducts), ctx, dto)
+	return &MockProductRepositoryGetProductsCall{Call: call}
+}
+
+// MockProductRepositoryGetProductsCall wrap *gomock.Call
+type MockProductRepositoryGetProductsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetProductsCall) Return(arg0 []domain.Product, arg1 error) *MockProductRepositoryGetProductsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetProductsCall) Do(f func(context.Context, *domain.GetProductsDTO) ([]domain.Product, error)) *MockProductRepositoryGetProductsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetProductsCall) DoAndReturn(f func(context.Context, *domain.GetProductsDTO) ([]domain.Product, error)) *MockProductRepositoryGetProductsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTags mocks base method.
+func (m *MockProductRepository) GetTags(ctx context.Context) ([]domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx)
+	ret0, _ := ret[0].([]domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockProductRepositoryMockRecorder) GetTags(ctx any) *MockProductRepositoryGetTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockProductRepository)(nil).GetTags), ctx)
+	return &MockProductRepositoryGetTagsCall{Call: call}
+}
+
+// MockProductRepositoryGetTagsCall wrap *gomock.Call
+type MockProductRepositoryGetTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryGetTagsCall) Return(arg0 []domain.Tag, arg1 error) *MockProductRepositoryGetTagsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryGetTagsCall) Do(f func(context.Context) ([]domain.Tag, error)) *MockProductRepositoryGetTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryGetTagsCall) DoAndReturn(f func(context.Context) ([]domain.Tag, error)) *MockProductRepositoryGetTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// HasMenuDraft mocks base method.
+func (m *MockProductRepository) HasMenuDraft(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasMenuDraft", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasMenuDraft indicates an expected call of HasMenuDraft.
+func (mr *MockProductRepositoryMockRecorder) HasMenuDraft(ctx any) *MockProductRepositoryHasMenuDraftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMenuDraft", reflect.TypeOf((*MockProductRepository)(nil).HasMenuDraft), ctx)
+	return &MockProductRepositoryHasMenuDraftCall{Call: call}
+}
+
+// MockProductRepositoryHasMenuDraftCall wrap *gomock.Call
+type MockProductRepositoryHasMenuDraftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryHasMenuDraftCall) Return(arg0 bool, arg1 error) *MockProductRepositoryHasMenuDraftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryHasMenuDraftCall) Do(f func(context.Context) (bool, error)) *MockProductRepositoryHasMenuDraftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryHasMenuDraftCall) DoAndReturn(f func(context.Context) (bool, error)) *MockProductRepositoryHasMenuDraftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PublishMenu mocks base method.
+func (m *MockProductRepository) PublishMenu(ctx context.Context, draft *domain.MenuDraft, resolve port.MenuResolver) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishMenu", ctx, draft, resolve)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishMenu indicates an expected call of PublishMenu.
+func (mr *MockProductRepositoryMockRecorder) PublishMenu(ctx, draft, resolve any) *MockProductRepositoryPublishMenuCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMenu", reflect.TypeOf((*MockProductRepository)(nil).PublishMenu), ctx, draft, resolve)
+	return &MockProductRepositoryPublishMenuCall{Call: call}
+}
+
+// MockProductRepositoryPublishMenuCall wrap *gomock.Call
+type MockProductRepositoryPublishMenuCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryPublishMenuCall) Return(arg0 error) *MockProductRepositoryPublishMenuCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryPublishMenuCall) Do(f func(context.Context, *domain.MenuDraft, port.MenuResolver) error) *MockProductRepositoryPublishMenuCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryPublishMenuCall) DoAndReturn(f func(context.Context, *domain.MenuDraft, port.MenuResolver) error) *MockProductRepositoryPublishMenuCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReorderProductImages mocks base method.
+func (m *MockProductRepository) ReorderProductImages(ctx context.Context, productId uuid.UUID, imageIds []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderProductImages", ctx, productId, imageIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderProductImages indicates an expected call of ReorderProductImages.
+func (mr *MockProductRepositoryMockRecorder) ReorderProductImages(ctx, productId, imageIds any) *MockProductRepositoryReorderProductImagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderProductImages", reflect.TypeOf((*MockProductRepository)(nil).ReorderProductImages), ctx, productId, imageIds)
+	return &MockProductRepositoryReorderProductImagesCall{Call: call}
+}
+
+// MockProductRepositoryReorderProductImagesCall wrap *gomock.Call
+type MockProductRepositoryReorderProductImagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryReorderProductImagesCall) Return(arg0 error) *MockProductRepositoryReorderProductImagesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryReorderProductImagesCall) Do(f func(context.Context, uuid.UUID, []uuid.UUID) error) *MockProductRepositoryReorderProductImagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryReorderProductImagesCall) DoAndReturn(f func(context.Context, uuid.UUID, []uuid.UUID) error) *MockProductRepositoryReorderProductImagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveMenuDraft mocks base method.
+func (m *MockProductRepository) SaveMenuDraft(ctx context.Context, draft *domain.MenuDraft) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMenuDraft", ctx, draft)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMenuDraft indicates an expected call of SaveMenuDraft.
+func (mr *MockProductRepositoryMockRecorder) SaveMenuDraft(ctx, draft any) *MockProductRepositorySaveMenuDraftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMenuDraft", reflect.TypeOf((*MockProductRepository)(nil).SaveMenuDraft), ctx, draft)
+	return &MockProductRepositorySaveMenuDraftCall{Call: call}
+}
+
+// MockProductRepositorySaveMenuDraftCall wrap *gomock.Call
+type MockProductRepositorySaveMenuDraftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositorySaveMenuDraftCall) Return(arg0 error) *MockProductRepositorySaveMenuDraftCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositorySaveMenuDraftCall) Do(f func(context.Context, *domain.MenuDraft) error) *MockProductRepositorySaveMenuDraftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositorySaveMenuDraftCall) DoAndReturn(f func(context.Context, *domain.MenuDraft) error) *MockProductRepositorySaveMenuDraftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetCategoryArchived mocks base method.
+func (m *MockProductRepository) SetCategoryArchived(ctx context.Context, id uuid.UUID, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategoryArchived", ctx, id, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategoryArchived indicates an expected call of SetCategoryArchived.
+func (mr *MockProductRepositoryMockRecorder) SetCategoryArchived(ctx, id, archived any) *MockProductRepositorySetCategoryArchivedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryArchived", reflect.TypeOf((*MockProductRepository)(nil).SetCategoryArchived), ctx, id, archived)
+	return &MockProductRepositorySetCategoryArchivedCall{Call: call}
+}
+
+// MockProductRepositorySetCategoryArchivedCall wrap *gomock.Call
+type MockProductRepositorySetCategoryArchivedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositorySetCategoryArchivedCall) Return(arg0 error) *MockProductRepositorySetCategoryArchivedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositorySetCategoryArchivedCall) Do(f func(context.Context, uuid.UUID, bool) error) *MockProductRepositorySetCategoryArchivedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositorySetCategoryArchivedCall) DoAndReturn(f func(context.Context, uuid.UUID, bool) error) *MockProductRepositorySetCategoryArchivedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetCategorySchedule mocks base method.
+func (m *MockProductRepository) SetCategorySchedule(ctx context.Context, categoryId uuid.UUID, windows []domain.ScheduleWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategorySchedule", ctx, categoryId, windows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategorySchedule indicates an expected call of SetCategorySchedule.
+func (mr *MockProductRepositoryMockRecorder) SetCategorySchedule(ctx, categoryId, windows any) *MockProductRepositorySetCategoryScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategorySchedule", reflect.TypeOf((*MockProductRepository)(nil).SetCategorySchedule), ctx, categoryId, windows)
+	return &MockProductRepositorySetCategoryScheduleCall{Call: call}
+}
+
+// MockProductRepositorySetCategoryScheduleCall wrap *gomock.Call
+type MockProductRepositorySetCategoryScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositorySetCategoryScheduleCall) Return(arg0 error) *MockProductRepositorySetCategoryScheduleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositorySetCategoryScheduleCall) Do(f func(context.Context, uuid.UUID, []domain.ScheduleWindow) error) *MockProductRepositorySetCategoryScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositorySetCategoryScheduleCall) DoAndReturn(f func(context.Context, uuid.UUID, []domain.ScheduleWindow) error) *MockProductRepositorySetCategoryScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetCategoryTranslation mocks base method.
+func (m *MockProductRepository) SetCategoryTranslation(ctx context.Context, translation *domain.CategoryTranslation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategoryTranslation", ctx, translation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategoryTranslation indicates an expected call of SetCategoryTranslation.
+func (mr *MockProductRepositoryMockRecorder) SetCategoryTranslation(ctx, translation any) *MockProductRepositorySetCategoryTranslationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryTranslation", reflect.TypeOf((*MockProductRepository)(nil).SetCategoryTranslation), ctx, translation)
+	return &MockProductRepositorySetCategoryTranslationCall{Call: call}
+}
+
+// MockProductRepositorySetCategoryTranslationCall wrap *gomock.Call
+type MockProductRepositorySetCategoryTranslationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositorySetCategoryTranslationCall) Return(arg0 error) *MockProductRepositorySetCategoryTranslationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositorySetCategoryTranslationCall) Do(f func(context.Context, *domain.CategoryTranslation) error) *MockProductRepositorySetCategoryTranslationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositorySetCategoryTranslationCall) DoAndReturn(f func(context.Context, *domain.CategoryTranslation) error) *MockProductRepositorySetCategoryTranslationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetProductArchived mocks base method.
+func (m *MockProductRepository) SetProductArchived(ctx context.Context, id uuid.UUID, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductArchived", ctx, id, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProductArchived indicates an expected call of SetProductArchived.
+func (mr *MockProductRepositoryMockRecorder) SetProductArchived(ctx, id, archived any) *MockProductRepositorySetProductArchivedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductArchived", reflect.TypeOf((*MockProductRepository)(nil).SetProductArchived), ctx, id, archived)
+	return &MockProductRepositorySetProductArchivedCall{Call: call}
+}
+
+// MockProductRepositorySetProductArchivedCall wrap *gomock.Call
+type MockProductRepositorySetProductArchivedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositorySetProductArchivedCall) Return(arg0 error) *MockProductRepositorySetProductArchivedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositorySetProductArchivedCall) Do(f func(context.Context, uuid.UUID, bool) error) *MockProductRepositorySetProductArchivedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositorySetProductArchivedCall) DoAndReturn(f func(context.Context, uuid.UUID, bool) error) *MockProductRepositorySetProductArchivedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetProductAvailability mocks base method.
+func (m *MockProductRepository) SetProductAvailability(ctx context.Context, id uuid.UUID, available bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductAvailability", ctx, id, available)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProductAvailability indicates an expected call of SetProductAvailability.
+func (mr *MockProductRepositoryMockRecorder) SetProductAvailability(ctx, id, available any) *MockProductRepositorySetProductAvailabilityCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductAvailability", reflect.TypeOf((*MockProductRepository)(nil).SetProductAvailability), ctx, id, available)
+	return &MockProductRepositorySetProductAvailabilityCall{Call: call}
+}
+
+// MockProductRepositorySetProductAvailabilityCall wrap *gomock.Call
+type MockProductRepositorySetProductAvailabilityCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositorySetProductAvailabilityCall) Return(arg0 error) *MockProductRepositorySetProductAvailabilityCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositorySetProductAvailabilityCall) Do(f func(context.Context, uuid.UUID, bool) error) *MockProductRepositorySetProductAvailabilityCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositorySetProductAvailabilityCall) DoAndReturn(f func(context.Context, uuid.UUID, bool) error) *MockProductRepositorySetProductAvailabilityCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetProductSchedule mocks base method.
+func (m *MockProductRepository) SetProductSchedule(ctx context.Context, productId uuid.UUID, windows []domain.ScheduleWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductSchedule", ctx, productId, windows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProductSchedule indicates an expected call of SetProductSchedule.
+func (mr *MockProductRepositoryMockRecorder) SetProductSchedule(ctx, productId, windows any) *MockProductRepositorySetProductScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductSchedule", reflect.TypeOf((*MockProductRepository)(nil).SetProductSchedule), ctx, productId, windows)
+	return &MockProductRepositorySetProductScheduleCall{Call: call}
+}
+
+// MockProductRepositorySetProductScheduleCall wrap *gomock.Call
+type MockProductRepositorySetProductScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositorySetProductScheduleCall) Return(arg0 error) *MockProductRepositorySetProductScheduleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositorySetProductScheduleCall) Do(f func(context.Context, uuid.UUID, []domain.ScheduleWindow) error) *MockProductRepositorySetProductScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositorySetProductScheduleCall) DoAndReturn(f func(context.Context, uuid.UUID, []domain.ScheduleWindow) error) *MockProductRepositorySetProductScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetProductTags mocks base method.
+func (m *MockProductRepository) SetProductTags(ctx context.Context, productId uuid.UUID, tagIds []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductTags", ctx, productId, tagIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProductTags indicates an expected call of SetProductTags.
+func (mr *MockProductRepositoryMockRecorder) SetProductTags(ctx, productId, tagIds any) *MockProductRepositorySetProductTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductTags", reflect.TypeOf((*MockProductRepository)(nil).SetProductTags), ctx, productId, tagIds)
+	return &MockProductRepositorySetProductTagsCall{Call: call}
+}
+
+// MockProductRepositorySetProductTagsCall wrap *gomock.Call
+type MockProductRepositorySetProductTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositorySetProductTagsCall) Return(arg0 error) *MockProductRepositorySetProductTagsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositorySetProductTagsCall) Do(f func(context.Context, uuid.UUID, []uuid.UUID) error) *MockProductRepositorySetProductTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositorySetProductTagsCall) DoAndReturn(f func(context.Context, uuid.UUID, []uuid.UUID) error) *MockProductRepositorySetProductTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetProductTranslation mocks base method.
+func (m *MockProductRepository) SetProductTranslation(ctx context.Context, translation *domain.ProductTranslation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductTranslation", ctx, translation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProductTranslation indicates an expected call of SetProductTranslation.
+func (mr *MockProductRepositoryMockRecorder) SetProductTranslation(ctx, translation any) *MockProductRepositorySetProductTranslationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductTranslation", reflect.TypeOf((*MockProductRepository)(nil).SetProductTranslation), ctx, translation)
+	return &MockProductRepositorySetProductTranslationCall{Call: call}
+}
+
+// MockProductRepositorySetProductTranslationCall wrap *gomock.Call
+type MockProductRepositorySetProductTranslationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositorySetProductTranslationCall) Return(arg0 error) *MockProductRepositorySetProductTranslationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositorySetProductTranslationCall) Do(f func(context.Context, *domain.ProductTranslation) error) *MockProductRepositorySetProductTranslationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositorySetProductTranslationCall) DoAndReturn(f func(context.Context, *domain.ProductTranslation) error) *MockProductRepositorySetProductTranslationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateCategory mocks base method.
+func (m *MockProductRepository) UpdateCategory(ctx context.Context, dto *domain.UpdateCategoryProductDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, dto)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockProductRepositoryMockRecorder) UpdateCategory(ctx, dto any) *MockProductRepositoryUpdateCategoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockProductRepository)(nil).UpdateCategory), ctx, dto)
+	return &MockProductRepositoryUpdateCategoryCall{Call: call}
+}
+
+// MockProductRepositoryUpdateCategoryCall wrap *gomock.Call
+type MockProductRepositoryUpdateCategoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryUpdateCategoryCall) Return(arg0 error) *MockProductRepositoryUpdateCategoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryUpdateCategoryCall) Do(f func(context.Context, *domain.UpdateCategoryProductDTO) error) *MockProductRepositoryUpdateCategoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryUpdateCategoryCall) DoAndReturn(f func(context.Context, *domain.UpdateCategoryProductDTO) error) *MockProductRepositoryUpdateCategoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateProduct mocks base method.
+func (m *MockProductRepository) UpdateProduct(ctx context.Context, dto *domain.UpdateProductDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProduct", ctx, dto)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProduct indicates an expected call of UpdateProduct.
+func (mr *MockProductRepositoryMockRecorder) UpdateProduct(ctx, dto any) *MockProductRepositoryUpdateProductCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductRepository)(nil).UpdateProduct), ctx, dto)
+	return &MockProductRepositoryUpdateProductCall{Call: call}
+}
+
+// MockProductRepositoryUpdateProductCall wrap *gomock.Call
+type MockProductRepositoryUpdateProductCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryUpdateProductCall) Return(arg0 error) *MockProductRepositoryUpdateProductCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryUpdateProductCall) Do(f func(context.Context, *domain.UpdateProductDTO) error) *MockProductRepositoryUpdateProductCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryUpdateProductCall) DoAndReturn(f func(context.Context, *domain.UpdateProductDTO) error) *MockProductRepositoryUpdateProductCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateProductImage mocks base method.
+func (m *MockProductRepository) UpdateProductImage(ctx context.Context, productId uuid.UUID, images domain.ImageSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductImage", ctx, productId, images)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProductImage indicates an expected call of UpdateProductImage.
+func (mr *MockProductRepositoryMockRecorder) UpdateProductImage(ctx, productId, images any) *MockProductRepositoryUpdateProductImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductImage", reflect.TypeOf((*MockProductRepository)(nil).UpdateProductImage), ctx, productId, images)
+	return &MockProductRepositoryUpdateProductImageCall{Call: call}
+}
+
+// MockProductRepositoryUpdateProductImageCall wrap *gomock.Call
+type MockProductRepositoryUpdateProductImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryUpdateProductImageCall) Return(arg0 error) *MockProductRepositoryUpdateProductImageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryUpdateProductImageCall) Do(f func(context.Context, uuid.UUID, domain.ImageSet) error) *MockProductRepositoryUpdateProductImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryUpdateProductImageCall) DoAndReturn(f func(context.Context, uuid.UUID, domain.ImageSet) error) *MockProductRepositoryUpdateProductImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpsertMenu mocks base method.
+func (m *MockProductRepository) UpsertMenu(ctx context.Context, menu *domain.Menu) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertMenu", ctx, menu)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertMenu indicates an expected call of UpsertMenu.
+func (mr *MockProductRepositoryMockRecorder) UpsertMenu(ctx, menu any) *MockProductRepositoryUpsertMenuCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertMenu", reflect.TypeOf((*MockProductRepository)(nil).UpsertMenu), ctx, menu)
+	return &MockProductRepositoryUpsertMenuCall{Call: call}
+}
+
+// MockProductRepositoryUpsertMenuCall wrap *gomock.Call
+type MockProductRepositoryUpsertMenuCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductRepositoryUpsertMenuCall) Return(arg0 error) *MockProductRepositoryUpsertMenuCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductRepositoryUpsertMenuCall) Do(f func(context.Context, *domain.Menu) error) *MockProductRepositoryUpsertMenuCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductRepositoryUpsertMenuCall) DoAndReturn(f func(context.Context, *domain.Menu) error) *MockProductRepositoryUpsertMenuCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockProductService is a mock of ProductService interface.
+type MockProductService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProductServiceMockRecorder is the mock recorder for MockProductService.
+type MockProductServiceMockRecorder struct {
+	mock *MockProductService
+}
+
+// NewMockProductService creates a new mock instance.
+func NewMockProductService(ctrl *gomock.Controller) *MockProductService {
+	mock := &MockProductService{ctrl: ctrl}
+	mock.recorder = &MockProductServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductService) EXPECT() *MockProductServiceMockRecorder {
+	return m.recorder
+}
+
+// AddCategory mocks base method.
+func (m *MockProductService) AddCategory(ctx context.Context, name string) (*domain.ProductCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCategory", ctx, name)
+	ret0, _ := ret[0].(*domain.ProductCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCategory indicates an expected call of AddCategory.
+func (mr *MockProductServiceMockRecorder) AddCategory(ctx, name any) *MockProductServiceAddCategoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCategory", reflect.TypeOf((*MockProductService)(nil).AddCategory), ctx, name)
+	return &MockProductServiceAddCategoryCall{Call: call}
+}
+
+// MockProductServiceAddCategoryCall wrap *gomock.Call
+type MockProductServiceAddCategoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceAddCategoryCall) Return(arg0 *domain.ProductCategory, arg1 error) *MockProductServiceAddCategoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceAddCategoryCall) Do(f func(context.Context, string) (*domain.ProductCategory, error)) *MockProductServiceAddCategoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceAddCategoryCall) DoAndReturn(f func(context.Context, string) (*domain.ProductCategory, error)) *MockProductServiceAddCategoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddModifierGroup mocks base method.
+func (m *MockProductService) AddModifierGroup(ctx context.Context, dto *domain.AddModifierGroupDTO) (*domain.ModifierGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddModifierGroup", ctx, dto)
+	ret0, _ := ret[0].(*domain.ModifierGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddModifierGroup indicates an expected call of AddModifierGroup.
+func (mr *MockProductServiceMockRecorder) AddModifierGroup(ctx, dto any) *MockProductServiceAddModifierGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddModifierGroup", reflect.TypeOf((*MockProductService)(nil).AddModifierGroup), ctx, dto)
+	return &MockProductServiceAddModifierGroupCall{Call: call}
+}
+
+// MockProductServiceAddModifierGroupCall wrap *gomock.Call
+type MockProductServiceAddModifierGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceAddModifierGroupCall) Return(arg0 *domain.ModifierGroup, arg1 error) *MockProductServiceAddModifierGroupCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceAddModifierGroupCall) Do(f func(context.Context, *domain.AddModifierGroupDTO) (*domain.ModifierGroup, error)) *MockProductServiceAddModifierGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceAddModifierGroupCall) DoAndReturn(f func(context.Context, *domain.AddModifierGroupDTO) (*domain.ModifierGroup, error)) *MockProductServiceAddModifierGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddPricingRule mocks base method.
+func (m *MockProductService) AddPricingRule(ctx context.Context, dto *domain.AddPricingRuleDTO) (*domain.PricingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPricingRule", ctx, dto)
+	ret0, _ := ret[0].(*domain.PricingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPricingRule indicates an expected call of AddPricingRule.
+func (mr *MockProductServiceMockRecorder) AddPricingRule(ctx, dto any) *MockProductServiceAddPricingRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPricingRule", reflect.TypeOf((*MockProductService)(nil).AddPricingRule), ctx, dto)
+	return &MockProductServiceAddPricingRuleCall{Call: call}
+}
+
+// MockProductServiceAddPricingRuleCall wrap *gomock.Call
+type MockProductServiceAddPricingRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceAddPricingRuleCall) Return(arg0 *domain.PricingRule, arg1 error) *MockProductServiceAddPricingRuleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceAddPricingRuleCall) Do(f func(context.Context, *domain.AddPricingRuleDTO) (*domain.PricingRule, error)) *MockProductServiceAddPricingRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceAddPricingRuleCall) DoAndReturn(f func(context.Context, *domain.AddPricingRuleDTO) (*domain.PricingRule, error)) *MockProductServiceAddPricingRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddProduct mocks base method.
+func (m *MockProductService) AddProduct(ctx context.Context, dto *domain.AddProductDTO) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProduct", ctx, dto)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProduct indicates an expected call of AddProduct.
+func (mr *MockProductServiceMockRecorder) AddProduct(ctx, dto any) *MockProductServiceAddProductCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockProductService)(nil).AddProduct), ctx, dto)
+	return &MockProductServiceAddProductCall{Call: call}
+}
+
+// MockProductServiceAddProductCall wrap *gomock.Call
+type MockProductServiceAddProductCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceAddProductCall) Return(arg0 *domain.Product, arg1 error) *MockProductServiceAddProductCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceAddProductCall) Do(f func(context.Context, *domain.AddProductDTO) (*domain.Product, error)) *MockProductServiceAddProductCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceAddProductCall) DoAndReturn(f func(context.Context, *domain.AddProductDTO) (*domain.Product, error)) *MockProductServiceAddProductCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddProductImage mocks base method.
+func (m *MockProductService) AddProductImage(ctx context.Context, dto *domain.AddProductImageDTO) (*domain.ProductImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProductImage", ctx, dto)
+	ret0, _ := ret[0].(*domain.ProductImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProductImage indicates an expected call of AddProductImage.
+func (mr *MockProductServiceMockRecorder) AddProductImage(ctx, dto any) *MockProductServiceAddProductImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductImage", reflect.TypeOf((*MockProductService)(nil).AddProductImage), ctx, dto)
+	return &MockProductServiceAddProductImageCall{Call: call}
+}
+
+// MockProductServiceAddProductImageCall wrap *gomock.Call
+type MockProductServiceAddProductImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceAddProductImageCall) Return(arg0 *domain.ProductImage, arg1 error) *MockProductServiceAddProductImageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceAddProductImageCall) Do(f func(context.Context, *domain.AddProductImageDTO) (*domain.ProductImage, error)) *MockProductServiceAddProductImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceAddProductImageCall) DoAndReturn(f func(context.Context, *domain.AddProductImageDTO) (*domain.ProductImage, error)) *MockProductServiceAddProductImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddTag mocks base method.
+func (m *MockProductService) AddTag(ctx context.Context, name string) (*domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTag", ctx, name)
+	ret0, _ := ret[0].(*domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTag indicates an expected call of AddTag.
+func (mr *MockProductServiceMockRecorder) AddTag(ctx, name any) *MockProductServiceAddTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTag", reflect.TypeOf((*MockProductService)(nil).AddTag), ctx, name)
+	return &MockProductServiceAddTagCall{Call: call}
+}
+
+// MockProductServiceAddTagCall wrap *gomock.Call
+type MockProductServiceAddTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceAddTagCall) Return(arg0 *domain.Tag, arg1 error) *MockProductServiceAddTagCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceAddTagCall) Do(f func(context.Context, string) (*domain.Tag, error)) *MockProductServiceAddTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceAddTagCall) DoAndReturn(f func(context.Context, string) (*domain.Tag, error)) *MockProductServiceAddTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ArchiveCategory mocks base method.
+func (m *MockProductService) ArchiveCategory(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveCategory indicates an expected call of ArchiveCategory.
+func (mr *MockProductServiceMockRecorder) ArchiveCategory(ctx, id any) *MockProductServiceArchiveCategoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveCategory", reflect.TypeOf((*MockProductService)(nil).ArchiveCategory), ctx, id)
+	return &MockProductServiceArchiveCategoryCall{Call: call}
+}
+
+// MockProductServiceArchiveCategoryCall wrap *gomock.Call
+type MockProductServiceArchiveCategoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceArchiveCategoryCall) Return(arg0 error) *MockProductServiceArchiveCategoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceArchiveCategoryCall) Do(f func(context.Context, uuid.UUID) error) *MockProductServiceArchiveCategoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceArchiveCategoryCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductServiceArchiveCategoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ArchiveProduct mocks base method.
+func (m *MockProductService) ArchiveProduct(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveProduct", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveProduct indicates an expected call of ArchiveProduct.
+func (mr *MockProductServiceMockRecorder) ArchiveProduct(ctx, id any) *MockProductServiceArchiveProductCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveProduct", reflect.TypeOf((*MockProductService)(nil).ArchiveProduct), ctx, id)
+	return &MockProductServiceArchiveProductCall{Call: call}
+}
+
+// MockProductServiceArchiveProductCall wrap *gomock.Call
+type MockProductServiceArchiveProductCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceArchiveProductCall) Return(arg0 error) *MockProductServiceArchiveProductCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceArchiveProductCall) Do(f func(context.Context, uuid.UUID) error) *MockProductServiceArchiveProductCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceArchiveProductCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductServiceArchiveProductCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCategory mocks base method.
+func (m *MockProductService) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockProductServiceMockRecorder) DeleteCategory(ctx, id any) *MockProductServiceDeleteCategoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockProductService)(nil).DeleteCategory), ctx, id)
+	return &MockProductServiceDeleteCategoryCall{Call: call}
+}
+
+// MockProductServiceDeleteCategoryCall wrap *gomock.Call
+type MockProductServiceDeleteCategoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceDeleteCategoryCall) Return(arg0 error) *MockProductServiceDeleteCategoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceDeleteCategoryCall) Do(f func(context.Context, uuid.UUID) error) *MockProductServiceDeleteCategoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceDeleteCategoryCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductServiceDeleteCategoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteCategoryTranslation mocks base method.
+func (m *MockProductService) DeleteCategoryTranslation(ctx context.Context, categoryId uuid.UUID, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategoryTranslation", ctx, categoryId, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategoryTranslation indicates an expected call of DeleteCategoryTranslation.
+func (mr *MockProductServiceMockRecorder) DeleteCategoryTranslation(ctx, categoryId, locale any) *MockProductServiceDeleteCategoryTranslationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategoryTranslation", reflect.TypeOf((*MockProductService)(nil).DeleteCategoryTranslation), ctx, categoryId, locale)
+	return &MockProductServiceDeleteCategoryTranslationCall{Call: call}
+}
+
+// MockProductServiceDeleteCategoryTranslationCall wrap *gomock.Call
+type MockProductServiceDeleteCategoryTranslationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceDeleteCategoryTranslationCall) Return(arg0 error) *MockProductServiceDeleteCategoryTranslationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceDeleteCategoryTranslationCall) Do(f func(context.Context, uuid.UUID, string) error) *MockProductServiceDeleteCategoryTranslationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceDeleteCategoryTranslationCall) DoAndReturn(f func(context.Context, uuid.UUID, string) error) *MockProductServiceDeleteCategoryTranslationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteModifierGroup mocks base method.
+func (m *MockProductService) DeleteModifierGroup(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteModifierGroup", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteModifierGroup indicates an expected call of DeleteModifierGroup.
+func (mr *MockProductServiceMockRecorder) DeleteModifierGroup(ctx, id any) *MockProductServiceDeleteModifierGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModifierGroup", reflect.TypeOf((*MockProductService)(nil).DeleteModifierGroup), ctx, id)
+	return &MockProductServiceDeleteModifierGroupCall{Call: call}
+}
+
+// MockProductServiceDeleteModifierGroupCall wrap *gomock.Call
+type MockProductServiceDeleteModifierGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceDeleteModifierGroupCall) Return(arg0 error) *MockProductServiceDeleteModifierGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceDeleteModifierGroupCall) Do(f func(context.Context, uuid.UUID) error) *MockProductServiceDeleteModifierGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceDeleteModifierGroupCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductServiceDeleteModifierGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePricingRule mocks base method.
+func (m *MockProductService) DeletePricingRule(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePricingRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePricingRule indicates an expected call of DeletePricingRule.
+func (mr *MockProductServiceMockRecorder) DeletePricingRule(ctx, id any) *MockProductServiceDeletePricingRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePricingRule", reflect.TypeOf((*MockProductService)(nil).DeletePricingRule), ctx, id)
+	return &MockProductServiceDeletePricingRuleCall{Call: call}
+}
+
+// MockProductServiceDeletePricingRuleCall wrap *gomock.Call
+type MockProductServiceDeletePricingRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceDeletePricingRuleCall) Return(arg0 error) *MockProductServiceDeletePricingRuleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceDeletePricingRuleCall) Do(f func(context.Context, uuid.UUID) error) *MockProductServiceDeletePricingRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceDeletePricingRuleCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductServiceDeletePricingRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteProduct mocks base method.
+func (m *MockProductService) DeleteProduct(ctx context.Context, dto *domain.DeleteProductDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, dto)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockProductServiceMockRecorder) DeleteProduct(ctx, dto any) *MockProductServiceDeleteProductCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductService)(nil).DeleteProduct), ctx, dto)
+	return &MockProductServiceDeleteProductCall{Call: call}
+}
+
+// MockProductServiceDeleteProductCall wrap *gomock.Call
+type MockProductServiceDeleteProductCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceDeleteProductCall) Return(arg0 error) *MockProductServiceDeleteProductCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceDeleteProductCall) Do(f func(context.Context, *domain.DeleteProductDTO) error) *MockProductServiceDeleteProductCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceDeleteProductCall) DoAndReturn(f func(context.Context, *domain.DeleteProductDTO) error) *MockProductServiceDeleteProductCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteProductImage mocks base method.
+func (m *MockProductService) DeleteProductImage(ctx context.Context, productId uuid.UUID, imageId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductImage", ctx, productId, imageId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductImage indicates an expected call of DeleteProductImage.
+func (mr *MockProductServiceMockRecorder) DeleteProductImage(ctx, productId, imageId any) *MockProductServiceDeleteProductImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductImage", reflect.TypeOf((*MockProductService)(nil).DeleteProductImage), ctx, productId, imageId)
+	return &MockProductServiceDeleteProductImageCall{Call: call}
+}
+
+// MockProductServiceDeleteProductImageCall wrap *gomock.Call
+type MockProductServiceDeleteProductImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceDeleteProductImageCall) Return(arg0 error) *MockProductServiceDeleteProductImageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceDeleteProductImageCall) Do(f func(context.Context, uuid.UUID, uuid.UUID) error) *MockProductServiceDeleteProductImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceDeleteProductImageCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID) error) *MockProductServiceDeleteProductImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteProductTranslation mocks base method.
+func (m *MockProductService) DeleteProductTranslation(ctx context.Context, productId uuid.UUID, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductTranslation", ctx, productId, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductTranslation indicates an expected call of DeleteProductTranslation.
+func (mr *MockProductServiceMockRecorder) DeleteProductTranslation(ctx, productId, locale any) *MockProductServiceDeleteProductTranslationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductTranslation", reflect.TypeOf((*MockProductService)(nil).DeleteProductTranslation), ctx, productId, locale)
+	return &MockProductServiceDeleteProductTranslationCall{Call: call}
+}
+
+// MockProductServiceDeleteProductTranslationCall wrap *gomock.Call
+type MockProductServiceDeleteProductTranslationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceDeleteProductTranslationCall) Return(arg0 error) *MockProductServiceDeleteProductTranslationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceDeleteProductTranslationCall) Do(f func(context.Context, uuid.UUID, string) error) *MockProductServiceDeleteProductTranslationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceDeleteProductTranslationCall) DoAndReturn(f func(context.Context, uuid.UUID, string) error) *MockProductServiceDeleteProductTranslationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteTag mocks base method.
+func (m *MockProductService) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockProductServiceMockRecorder) DeleteTag(ctx, id any) *MockProductServiceDeleteTagCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockProductService)(nil).DeleteTag), ctx, id)
+	return &MockProductServiceDeleteTagCall{Call: call}
+}
+
+// MockProductServiceDeleteTagCall wrap *gomock.Call
+type MockProductServiceDeleteTagCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceDeleteTagCall) Return(arg0 error) *MockProductServiceDeleteTagCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceDeleteTagCall) Do(f func(context.Context, uuid.UUID) error) *MockProductServiceDeleteTagCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceDeleteTagCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductServiceDeleteTagCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DiscardMenuDraft mocks base method.
+func (m *MockProductService) DiscardMenuDraft(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscardMenuDraft", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DiscardMenuDraft indicates an expected call of DiscardMenuDraft.
+func (mr *MockProductServiceMockRecorder) DiscardMenuDraft(ctx any) *MockProductServiceDiscardMenuDraftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardMenuDraft", reflect.TypeOf((*MockProductService)(nil).DiscardMenuDraft), ctx)
+	return &MockProductServiceDiscardMenuDraftCall{Call: call}
+}
+
+// MockProductServiceDiscardMenuDraftCall wrap *gomock.Call
+type MockProductServiceDiscardMenuDraftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceDiscardMenuDraftCall) Return(arg0 error) *MockProductServiceDiscardMenuDraftCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceDiscardMenuDraftCall) Do(f func(context.Context) error) *MockProductServiceDiscardMenuDraftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceDiscardMenuDraftCall) DoAndReturn(f func(context.Context) error) *MockProductServiceDiscardMenuDraftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportMenu mocks base method.
+func (m *MockProductService) ExportMenu(ctx context.Context) ([]domain.MenuRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportMenu", ctx)
+	ret0, _ := ret[0].([]domain.MenuRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportMenu indicates an expected call of ExportMenu.
+func (mr *MockProductServiceMockRecorder) ExportMenu(ctx any) *MockProductServiceExportMenuCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportMenu", reflect.TypeOf((*MockProductService)(nil).ExportMenu), ctx)
+	return &MockProductServiceExportMenuCall{Call: call}
+}
+
+// MockProductServiceExportMenuCall wrap *gomock.Call
+type MockProductServiceExportMenuCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceExportMenuCall) Return(arg0 []domain.MenuRow, arg1 error) *MockProductServiceExportMenuCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceExportMenuCall) Do(f func(context.Context) ([]domain.MenuRow, error)) *MockProductServiceExportMenuCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceExportMenuCall) DoAndReturn(f func(context.Context) ([]domain.MenuRow, error)) *MockProductServiceExportMenuCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetArchivedCategories mocks base method.
+func (m *MockProductService) GetArchivedCategories(ctx context.Context) ([]domain.ProductCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedCategories", ctx)
+	ret0, _ := ret[0].([]domain.ProductCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedCategories indicates an expected call of GetArchivedCategories.
+func (mr *MockProductServiceMockRecorder) GetArchivedCategories(ctx any) *MockProductServiceGetArchivedCategoriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedCategories", reflect.TypeOf((*MockProductService)(nil).GetArchivedCategories), ctx)
+	return &MockProductServiceGetArchivedCategoriesCall{Call: call}
+}
+
+// MockProductServiceGetArchivedCategoriesCall wrap *gomock.Call
+type MockProductServiceGetArchivedCategoriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceGetArchivedCategoriesCall) Return(arg0 []domain.ProductCategory, arg1 error) *MockProductServiceGetArchivedCategoriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceGetArchivedCategoriesCall) Do(f func(context.Context) ([]domain.ProductCategory, error)) *MockProductServiceGetArchivedCategoriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceGetArchivedCategoriesCall) DoAndReturn(f func(context.Context) ([]domain.ProductCategory, error)) *MockProductServiceGetArchivedCategoriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetArchivedProducts mocks base method.
+func (m *MockProductService) GetArchivedProducts(ctx context.Context) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedProducts", ctx)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedProducts indicates an expected call of GetArchivedProducts.
+func (mr *MockProductServiceMockRecorder) GetArchivedProducts(ctx any) *MockProductServiceGetArchivedProductsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedProducts", reflect.TypeOf((*MockProductService)(nil).GetArchivedProducts), ctx)
+	return &MockProductServiceGetArchivedProductsCall{Call: call}
+}
+
+// MockProductServiceGetArchivedProductsCall wrap *gomock.Call
+type MockProductServiceGetArchivedProductsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceGetArchivedProductsCall) Return(arg0 []domain.Product, arg1 error) *MockProductServiceGetArchivedProductsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceGetArchivedProductsCall) Do(f func(context.Context) ([]domain.Product, error)) *MockProductServiceGetArchivedProductsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceGetArchivedProductsCall) DoAndReturn(f func(context.Context) ([]domain.Product, error)) *MockProductServiceGetArchivedProductsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCategorySchedule mocks base method.
+func (m *MockProductService) GetCategorySchedule(ctx context.Context, categoryId uuid.UUID) ([]domain.ScheduleWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategorySchedule", ctx, categoryId)
+	ret0, _ := ret[0].([]domain.ScheduleWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategorySchedule indicates an expected call of GetCategorySchedule.
+func (mr *MockProductServiceMockRecorder) GetCategorySchedule(ctx, categoryId any) *MockProductServiceGetCategoryScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategorySchedule", reflect.TypeOf((*MockProductService)(nil).GetCategorySchedule), ctx, categoryId)
+	return &MockProductServiceGetCategoryScheduleCall{Call: call}
+}
+
+// MockProductServiceGetCategoryScheduleCall wrap *gomock.Call
+type MockProductServiceGetCategoryScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceGetCategoryScheduleCall) Return(arg0 []domain.ScheduleWindow, arg1 error) *MockProductServiceGetCategoryScheduleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceGetCategoryScheduleCall) Do(f func(context.Context, uuid.UUID) ([]domain.ScheduleWindow, error)) *MockProductServiceGetCategoryScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceGetCategoryScheduleCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.ScheduleWindow, error)) *MockProductServiceGetCategoryScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCategoryTranslations mocks base method.
+func (m *MockProductService) GetCategoryTranslations(ctx context.Context, categoryId uuid.UUID) ([]domain.CategoryTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTranslations", ctx, categoryId)
+	ret0, _ := ret[0].([]domain.CategoryTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTranslations indicates an expected call of GetCategoryTranslations.
+func (mr *MockProductServiceMockRecorder) GetCategoryTranslations(ctx, categoryId any) *MockProductServiceGetCategoryTranslationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTranslations", reflect.TypeOf((*MockProductService)(nil).GetCategoryTranslations), ctx, categoryId)
+	return &MockProductServiceGetCategoryTranslationsCall{Call: call}
+}
+
+// MockProductServiceGetCategoryTranslationsCall wrap *gomock.Call
+type MockProductServiceGetCategoryTranslationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceGetCategoryTranslationsCall) Return(arg0 []domain.CategoryTranslation, arg1 error) *MockProductServiceGetCategoryTranslationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceGetCategoryTranslationsCall) Do(f func(context.Context, uuid.UUID) ([]domain.CategoryTranslation, error)) *MockProductServiceGetCategoryTranslationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceGetCategoryTranslationsCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.CategoryTranslation, error)) *MockProductServiceGetCategoryTranslationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMenuDraft mocks base method.
+func (m *MockProductService) GetMenuDraft(ctx context.Context) (*domain.MenuDraft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMenuDraft", ctx)
+	ret0, _ := ret[0].(*domain.MenuDraft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMenuDraft indicates an expected call of GetMenuDraft.
+func (mr *MockProductServiceMockRecorder) GetMenuDraft(ctx any) *MockProductServiceGetMenuDraftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenuDraft", reflect.TypeOf((*MockProductService)(nil).GetMenuDraft), ctx)
+	return &MockProductServiceGetMenuDraftCall{Call: call}
+}
+
+// MockProductServiceGetMenuDraftCall wrap *gomock.Call
+type MockProductServiceGetMenuDraftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceGetMenuDraftCall) Return(arg0 *domain.MenuDraft, arg1 error) *MockProductServiceGetMenuDraftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceGetMenuDraftCall) Do(f func(context.Context) (*domain.MenuDraft, error)) *MockProductServiceGetMenuDraftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceGetMenuDraftCall) DoAndReturn(f func(context.Context) (*domain.MenuDraft, error)) *MockProductServiceGetMenuDraftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMenuVersions mocks base method.
+func (m *MockProductService) GetMenuVersions(ctx context.Context) ([]domain.MenuVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMenuVersions", ctx)
+	ret0, _ := ret[0].([]domain.MenuVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMenuVersions indicates an expected call of GetMenuVersions.
+func (mr *MockProductServiceMockRecorder) GetMenuVersions(ctx any) *MockProductServiceGetMenuVersionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMenuVersions", reflect.TypeOf((*MockProductService)(nil).GetMenuVersions), ctx)
+	return &MockProductServiceGetMenuVersionsCall{Call: call}
+}
+
+// MockProductServiceGetMenuVersionsCall wrap *gomock.Call
+type MockProductServiceGetMenuVersionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceGetMenuVersionsCall) Return(arg0 []domain.MenuVersion, arg1 error) *MockProductServiceGetMenuVersionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceGetMenuVersionsCall) Do(f func(context.Context) ([]domain.MenuVersion, error)) *MockProductServiceGetMenuVersionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceGetMenuVersionsCall) DoAndReturn(f func(context.Context) ([]domain.MenuVersion, error)) *MockProductServiceGetMenuVersionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPricingRules mocks base method.
+func (m *MockProductService) GetPricingRules(ctx context.Context) ([]domain.PricingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPricingRules", ctx)
+	ret0, _ := ret[0].([]domain.PricingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPricingRules indicates an expected call of GetPricingRules.
+func (mr *MockProductServiceMockRecorder) GetPricingRules(ctx any) *MockProductServiceGetPricingRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPricingRules", reflect.TypeOf((*MockProductService)(nil).GetPricingRules), ctx)
+	return &MockProductServiceGetPricingRulesCall{Call: call}
+}
+
+// MockProductServiceGetPricingRulesCall wrap *gomock.Call
+type MockProductServiceGetPricingRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceGetPricingRulesCall) Return(arg0 []domain.PricingRule, arg1 error) *MockProductServiceGetPricingRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceGetPricingRulesCall) Do(f func(context.Context) ([]domain.PricingRule, error)) *MockProductServiceGetPricingRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceGetPricingRulesCall) DoAndReturn(f func(context.Context) ([]domain.PricingRule, error)) *MockProductServiceGetPricingRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProductCategories mocks base method.
+func (m *MockProductService) GetProductCategories(ctx context.Context, locales []string) ([]domain.ProductCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductCategories", ctx, locales)
+	ret0, _ := ret[0].([]domain.ProductCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductCategories indicates an expected call of GetProductCategories.
+func (mr *MockProductServiceMockRecorder) GetProductCategories(ctx, locales any) *MockProductServiceGetProductCategoriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCategories", reflect.TypeOf((*MockProductService)(nil).GetProductCategories), ctx, locales)
+	return &MockProductServiceGetProductCategoriesCall{Call: call}
+}
+
+// MockProductServiceGetProductCategoriesCall wrap *gomock.Call
+type MockProductServiceGetProductCategoriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceGetProductCategoriesCall) Return(arg0 []domain.ProductCategory, arg1 error) *MockProductServiceGetProductCategoriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceGetProductCategoriesCall) Do(f func(context.Context, []string) ([]domain.ProductCategory, error)) *MockProductServiceGetProductCategoriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceGetProductCategoriesCall) DoAndReturn(f func(context.Context, []string) ([]domain.ProductCategory, error)) *MockProductServiceGetProductCategoriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProductSchedule mocks base method.
+func (m *MockProductService) GetProductSchedule(ctx context.Context, productId uuid.UUID) ([]domain.ScheduleWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductSchedule", ctx, productId)
+	ret0, _ := ret[0].([]domain.ScheduleWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductSchedule indicates an expected call of GetProductSchedule.
+func (mr *MockProductServiceMockRecorder) GetProductSchedule(ctx, productId any) *MockProductServiceGetProductScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductSchedule", reflect.TypeOf((*MockProductService)(nil).GetProductSchedule), ctx, productId)
+	return &MockProductServiceGetProductScheduleCall{Call: call}
+}
+
+// MockProductServiceGetProductScheduleCall wrap *gomock.Call
+type MockProductServiceGetProductScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceGetProductScheduleCall) Return(arg0 []domain.ScheduleWindow, arg1 error) *MockProductServiceGetProductScheduleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceGetProductScheduleCall) Do(f func(context.Context, uuid.UUID) ([]domain.ScheduleWindow, error)) *MockProductServiceGetProductScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProductServiceGetProductScheduleCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.ScheduleWindow, error)) *MockProductServiceGetProductScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProductTranslations mocks base method.
+func (m *MockProductService) GetProductTranslations(ctx context.Context, productId uuid.UUID) ([]domain.ProductTranslation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductTranslations", ctx, productId)
+	ret0, _ := ret[0].([]domain.ProductTranslation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductTranslations indicates an expected call of GetProductTranslations.
+func (mr *MockProductServiceMockRecorder) GetProductTranslations(ctx, productId any) *MockProductServiceGetProductTranslationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductTranslations", reflect.TypeOf((*MockProductService)(nil).GetProductTranslations), ctx, productId)
+	return &MockProductServiceGetProductTranslationsCall{Call: call}
+}
+
+// MockProductServiceGetProductTranslationsCall wrap *gomock.Call
+type MockProductServiceGetProductTranslationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProductServiceGetProductTranslationsCall) Return(arg0 []domain.ProductTranslation, arg1 error) *MockProductServiceGetProductTranslationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProductServiceGetProductTranslationsCall) Do(f func(context.Context, uuid.UUID) ([]domain.ProductTranslation, error)) *MockProductServiceGetProductTranslationsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryAddProductCall) DoAndReturn(f func(context.Context, *domain.Product) error) *MockProductRepositoryAddProductCall {
+func (c *MockProductServiceGetProductTranslationsCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.ProductTranslation, error)) *MockProductServiceGetProductTranslationsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteCategory mocks base method.
-func (m *MockProductRepository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+// GetProducts mocks base method.
+func (m *MockProductService) GetProducts(ctx context.Context, dto *domain.GetProductsDTO) (*domain.ProductPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetProducts", ctx, dto)
+	ret0, _ := ret[0].(*domain.ProductPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteCategory indicates an expected call of DeleteCategory.
-func (mr *MockProductRepositoryMockRecorder) DeleteCategory(ctx, id any) *MockProductRepositoryDeleteCategoryCall {
+// GetProducts indicates an expected call of GetProducts.
+func (mr *MockProductServiceMockRecorder) GetProducts(ctx, dto any) *MockProductServiceGetProductsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockProductRepository)(nil).DeleteCategory), ctx, id)
-	return &MockProductRepositoryDeleteCategoryCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockProductService)(nil).GetProducts), ctx, dto)
+	return &MockProductServiceGetProductsCall{Call: call}
 }
 
-// MockProductRepositoryDeleteCategoryCall wrap *gomock.Call
-type MockProductRepositoryDeleteCategoryCall struct {
+// MockProductServiceGetProductsCall wrap *gomock.Call
+type MockProductServiceGetProductsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductRepositoryDeleteCategoryCall) Return(arg0 error) *MockProductRepositoryDeleteCategoryCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockProductServiceGetProductsCall) Return(arg0 *domain.ProductPage, arg1 error) *MockProductServiceGetProductsCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryDeleteCategoryCall) Do(f func(context.Context, uuid.UUID) error) *MockProductRepositoryDeleteCategoryCall {
+func (c *MockProductServiceGetProductsCall) Do(f func(context.Context, *domain.GetProductsDTO) (*domain.ProductPage, error)) *MockProductServiceGetProductsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryDeleteCategoryCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductRepositoryDeleteCategoryCall {
+func (c *MockProductServiceGetProductsCall) DoAndReturn(f func(context.Context, *domain.GetProductsDTO) (*domain.ProductPage, error)) *MockProductServiceGetProductsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteProductById mocks base method.
-func (m *MockProductRepository) DeleteProductById(ctx context.Context, id uuid.UUID) (*domain.Product, error) {
+// GetTags mocks base method.
+func (m *MockProductService) GetTags(ctx context.Context) ([]domain.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProductById", ctx, id)
-	ret0, _ := ret[0].(*domain.Product)
+	ret := m.ctrl.Call(m, "GetTags", ctx)
+	ret0, _ := ret[0].([]domain.Tag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteProductById indicates an expected call of DeleteProductById.
-func (mr *MockProductRepositoryMockRecorder) DeleteProductById(ctx, id any) *MockProductRepositoryDeleteProductByIdCall {
+// GetTags indicates an expected call of GetTags.
+func (mr *MockProductServiceMockRecorder) GetTags(ctx any) *MockProductServiceGetTagsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductById", reflect.TypeOf((*MockProductRepository)(nil).DeleteProductById), ctx, id)
-	return &MockProductRepositoryDeleteProductByIdCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockProductService)(nil).GetTags), ctx)
+	return &MockProductServiceGetTagsCall{Call: call}
 }
 
-// MockProductRepositoryDeleteProductByIdCall wrap *gomock.Call
-type MockProductRepositoryDeleteProductByIdCall struct {
+// MockProductServiceGetTagsCall wrap *gomock.Call
+type MockProductServiceGetTagsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductRepositoryDeleteProductByIdCall) Return(arg0 *domain.Product, arg1 error) *MockProductRepositoryDeleteProductByIdCall {
+func (c *MockProductServiceGetTagsCall) Return(arg0 []domain.Tag, arg1 error) *MockProductServiceGetTagsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryDeleteProductByIdCall) Do(f func(context.Context, uuid.UUID) (*domain.Product, error)) *MockProductRepositoryDeleteProductByIdCall {
+func (c *MockProductServiceGetTagsCall) Do(f func(context.Context) ([]domain.Tag, error)) *MockProductServiceGetTagsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryDeleteProductByIdCall) DoAndReturn(f func(context.Context, uuid.UUID) (*domain.Product, error)) *MockProductRepositoryDeleteProductByIdCall {
+func (c *MockProductServiceGetTagsCall) DoAndReturn(f func(context.Context) ([]domain.Tag, error)) *MockProductServiceGetTagsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteProductsByCategory mocks base method.
-func (m *MockProductRepository) DeleteProductsByCategory(ctx context.Context, categoryId uuid.UUID) ([]domain.Product, error) {
+// ImportMenu mocks base method.
+func (m *MockProductService) ImportMenu(ctx context.Context, dto *domain.ImportMenuDTO) (*domain.MenuImportReport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProductsByCategory", ctx, categoryId)
-	ret0, _ := ret[0].([]domain.Product)
+	ret := m.ctrl.Call(m, "ImportMenu", ctx, dto)
+	ret0, _ := ret[0].(*domain.MenuImportReport)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteProductsByCategory indicates an expected call of DeleteProductsByCategory.
-func (mr *MockProductRepositoryMockRecorder) DeleteProductsByCategory(ctx, categoryId any) *MockProductRepositoryDeleteProductsByCategoryCall {
+// ImportMenu indicates an expected call of ImportMenu.
+func (mr *MockProductServiceMockRecorder) ImportMenu(ctx, dto any) *MockProductServiceImportMenuCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductsByCategory", reflect.TypeOf((*MockProductRepository)(nil).DeleteProductsByCategory), ctx, categoryId)
-	return &MockProductRepositoryDeleteProductsByCategoryCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMenu", reflect.TypeOf((*MockProductService)(nil).ImportMenu), ctx, dto)
+	return &MockProductServiceImportMenuCall{Call: call}
 }
 
-// MockProductRepositoryDeleteProductsByCategoryCall wrap *gomock.Call
-type MockProductRepositoryDeleteProductsByCategoryCall struct {
+// MockProductServiceImportMenuCall wrap *gomock.Call
+type MockProductServiceImportMenuCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductRepositoryDeleteProductsByCategoryCall) Return(arg0 []domain.Product, arg1 error) *MockProductRepositoryDeleteProductsByCategoryCall {
+func (c *MockProductServiceImportMenuCall) Return(arg0 *domain.MenuImportReport, arg1 error) *MockProductServiceImportMenuCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryDeleteProductsByCategoryCall) Do(f func(context.Context, uuid.UUID) ([]domain.Product, error)) *MockProductRepositoryDeleteProductsByCategoryCall {
+func (c *MockProductServiceImportMenuCall) Do(f func(context.Context, *domain.ImportMenuDTO) (*domain.MenuImportReport, error)) *MockProductServiceImportMenuCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryDeleteProductsByCategoryCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.Product, error)) *MockProductRepositoryDeleteProductsByCategoryCall {
+func (c *MockProductServiceImportMenuCall) DoAndReturn(f func(context.Context, *domain.ImportMenuDTO) (*domain.MenuImportReport, error)) *MockProductServiceImportMenuCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetProductById mocks base method.
-func (m *MockProductRepository) GetProductById(ctx context.Context, id uuid.UUID) (*domain.Product, error) {
+// PreviewMenuDraft mocks base method.
+func (m *MockProductService) PreviewMenuDraft(ctx context.Context) (*domain.MenuPreview, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductById", ctx, id)
-	ret0, _ := ret[0].(*domain.Product)
+	ret := m.ctrl.Call(m, "PreviewMenuDraft", ctx)
+	ret0, _ := ret[0].(*domain.MenuPreview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProductById indicates an expected call of GetProductById.
-func (mr *MockProductRepositoryMockRecorder) GetProductById(ctx, id any) *MockProductRepositoryGetProductByIdCall {
+// PreviewMenuDraft indicates an expected call of PreviewMenuDraft.
+func (mr *MockProductServiceMockRecorder) PreviewMenuDraft(ctx any) *MockProductServicePreviewMenuDraftCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductById", reflect.TypeOf((*MockProductRepository)(nil).GetProductById), ctx, id)
-	return &MockProductRepositoryGetProductByIdCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewMenuDraft", reflect.TypeOf((*MockProductService)(nil).PreviewMenuDraft), ctx)
+	return &MockProductServicePreviewMenuDraftCall{Call: call}
 }
 
-// MockProductRepositoryGetProductByIdCall wrap *gomock.Call
-type MockProductRepositoryGetProductByIdCall struct {
+// MockProductServicePreviewMenuDraftCall wrap *gomock.Call
+type MockProductServicePreviewMenuDraftCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductRepositoryGetProductByIdCall) Return(arg0 *domain.Product, arg1 error) *MockProductRepositoryGetProductByIdCall {
+func (c *MockProductServicePreviewMenuDraftCall) Return(arg0 *domain.MenuPreview, arg1 error) *MockProductServicePreviewMenuDraftCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryGetProductByIdCall) Do(f func(context.Context, uuid.UUID) (*domain.Product, error)) *MockProductRepositoryGetProductByIdCall {
+func (c *MockProductServicePreviewMenuDraftCall) Do(f func(context.Context) (*domain.MenuPreview, error)) *MockProductServicePreviewMenuDraftCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryGetProductByIdCall) DoAndReturn(f func(context.Context, uuid.UUID) (*domain.Product, error)) *MockProductRepositoryGetProductByIdCall {
+func (c *MockProductServicePreviewMenuDraftCall) DoAndReturn(f func(context.Context) (*domain.MenuPreview, error)) *MockProductServicePreviewMenuDraftCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetProductCategories mocks base method.
-func (m *MockProductRepository) GetProductCategories(ctx context.Context) ([]domain.ProductCategory, error) {
+// PublishMenuDraft mocks base method.
+func (m *MockProductService) PublishMenuDraft(ctx context.Context) (*domain.MenuVersion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductCategories", ctx)
-	ret0, _ := ret[0].([]domain.ProductCategory)
+	ret := m.ctrl.Call(m, "PublishMenuDraft", ctx)
+	ret0, _ := ret[0].(*domain.MenuVersion)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProductCategories indicates an expected call of GetProductCategories.
-func (mr *MockProductRepositoryMockRecorder) GetProductCategories(ctx any) *MockProductRepositoryGetProductCategoriesCall {
+// PublishMenuDraft indicates an expected call of PublishMenuDraft.
+func (mr *MockProductServiceMockRecorder) PublishMenuDraft(ctx any) *MockProductServicePublishMenuDraftCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCategories", reflect.TypeOf((*MockProductRepository)(nil).GetProductCategories), ctx)
-	return &MockProductRepositoryGetProductCategoriesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishMenuDraft", reflect.TypeOf((*MockProductService)(nil).PublishMenuDraft), ctx)
+	return &MockProductServicePublishMenuDraftCall{Call: call}
 }
 
-// MockProductRepositoryGetProductCategoriesCall wrap *gomock.Call
-type MockProductRepositoryGetProductCategoriesCall struct {
+// MockProductServicePublishMenuDraftCall wrap *gomock.Call
+type MockProductServicePublishMenuDraftCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductRepositoryGetProductCategoriesCall) Return(arg0 []domain.ProductCategory, arg1 error) *MockProductRepositoryGetProductCategoriesCall {
+func (c *MockProductServicePublishMenuDraftCall) Return(arg0 *domain.MenuVersion, arg1 error) *MockProductServicePublishMenuDraftCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryGetProductCategoriesCall) Do(f func(context.Context) ([]domain.ProductCategory, error)) *MockProductRepositoryGetProductCategoriesCall {
+func (c *MockProductServicePublishMenuDraftCall) Do(f func(context.Context) (*domain.MenuVersion, error)) *MockProductServicePublishMenuDraftCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryGetProductCategoriesCall) DoAndReturn(f func(context.Context) ([]domain.ProductCategory, error)) *MockProductRepositoryGetProductCategoriesCall {
+func (c *MockProductServicePublishMenuDraftCall) DoAndReturn(f func(context.Context) (*domain.MenuVersion, error)) *MockProductServicePublishMenuDraftCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetProducts mocks base method.
-func (m *MockProductRepository) GetProducts(ctx context.Context) ([]domain.Product, error) {
+// ReorderProductImages mocks base method.
+func (m *MockProductService) ReorderProductImages(ctx context.Context, productId uuid.UUID, imageIds []uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProducts", ctx)
-	ret0, _ := ret[0].([]domain.Product)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ReorderProductImages", ctx, productId, imageIds)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetProducts indicates an expected call of GetProducts.
-func (mr *MockProductRepositoryMockRecorder) GetProducts(ctx any) *MockProductRepositoryGetProductsCall {
+// ReorderProductImages indicates an expected call of ReorderProductImages.
+func (mr *MockProductServiceMockRecorder) ReorderProductImages(ctx, productId, imageIds any) *MockProductServiceReorderProductImagesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockProductRepository)(nil).GetProducts), ctx)
-	return &MockProductRepositoryGetProductsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderProductImages", reflect.TypeOf((*MockProductService)(nil).ReorderProductImages), ctx, productId, imageIds)
+	return &MockProductServiceReorderProductImagesCall{Call: call}
 }
 
-// MockProductRepositoryGetProductsCall wrap *gomock.Call
-type MockProductRepositoryGetProductsCall struct {
+// MockProductServiceReorderProductImagesCall wrap *gomock.Call
+type MockProductServiceReorderProductImagesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductRepositoryGetProductsCall) Return(arg0 []domain.Product, arg1 error) *MockProductRepositoryGetProductsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockProductServiceReorderProductImagesCall) Return(arg0 error) *MockProductServiceReorderProductImagesCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryGetProductsCall) Do(f func(context.Context) ([]domain.Product, error)) *MockProductRepositoryGetProductsCall {
+func (c *MockProductServiceReorderProductImagesCall) Do(f func(context.Context, uuid.UUID, []uuid.UUID) error) *MockProductServiceReorderProductImagesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryGetProductsCall) DoAndReturn(f func(context.Context) ([]domain.Product, error)) *MockProductRepositoryGetProductsCall {
+func (c *MockProductServiceReorderProductImagesCall) DoAndReturn(f func(context.Context, uuid.UUID, []uuid.UUID) error) *MockProductServiceReorderProductImagesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetProductsByCategory mocks base method.
-func (m *MockProductRepository) GetProductsByCategory(ctx context.Context, categoryId uuid.UUID) ([]domain.Product, error) {
+// ReplaceProductImage mocks base method.
+func (m *MockProductService) ReplaceProductImage(ctx context.Context, productId uuid.UUID, data io.Reader) (domain.ImageSet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductsByCategory", ctx, categoryId)
-	ret0, _ := ret[0].([]domain.Product)
+	ret := m.ctrl.Call(m, "ReplaceProductImage", ctx, productId, data)
+	ret0, _ := ret[0].(domain.ImageSet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetProductsByCategory indicates an expected call of GetProductsByCategory.
-func (mr *MockProductRepositoryMockRecorder) GetProductsByCategory(ctx, categoryId any) *MockProductRepositoryGetProductsByCategoryCall {
+// ReplaceProductImage indicates an expected call of ReplaceProductImage.
+func (mr *MockProductServiceMockRecorder) ReplaceProductImage(ctx, productId, data any) *MockProductServiceReplaceProductImageCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByCategory", reflect.TypeOf((*MockProductRepository)(nil).GetProductsByCategory), ctx, categoryId)
-	return &MockProductRepositoryGetProductsByCategoryCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceProductImage", reflect.TypeOf((*MockProductService)(nil).ReplaceProductImage), ctx, productId, data)
+	return &MockProductServiceReplaceProductImageCall{Call: call}
 }
 
-// MockProductRepositoryGetProductsByCategoryCall wrap *gomock.Call
-type MockProductRepositoryGetProductsByCategoryCall struct {
+// MockProductServiceReplaceProductImageCall wrap *gomock.Call
+type MockProductServiceReplaceProductImageCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductRepositoryGetProductsByCategoryCall) Return(arg0 []domain.Product, arg1 error) *MockProductRepositoryGetProductsByCategoryCall {
+func (c *MockProductServiceReplaceProductImageCall) Return(arg0 domain.ImageSet, arg1 error) *MockProductServiceReplaceProductImageCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryGetProductsByCategoryCall) Do(f func(context.Context, uuid.UUID) ([]domain.Product, error)) *MockProductRepositoryGetProductsByCategoryCall {
+func (c *MockProductServiceReplaceProductImageCall) Do(f func(context.Context, uuid.UUID, io.Reader) (domain.ImageSet, error)) *MockProductServiceReplaceProductImageCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryGetProductsByCategoryCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]domain.Product, error)) *MockProductRepositoryGetProductsByCategoryCall {
+func (c *MockProductServiceReplaceProductImageCall) DoAndReturn(f func(context.Context, uuid.UUID, io.Reader) (domain.ImageSet, error)) *MockProductServiceReplaceProductImageCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateCategory mocks base method.
-func (m *MockProductRepository) UpdateCategory(ctx context.Context, dto *domain.UpdateCategoryProductDTO) error {
+// RestoreCategory mocks base method.
+func (m *MockProductService) RestoreCategory(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCategory", ctx, dto)
+	ret := m.ctrl.Call(m, "RestoreCategory", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateCategory indicates an expected call of UpdateCategory.
-func (mr *MockProductRepositoryMockRecorder) UpdateCategory(ctx, dto any) *MockProductRepositoryUpdateCategoryCall {
+// RestoreCategory indicates an expected call of RestoreCategory.
+func (mr *MockProductServiceMockRecorder) RestoreCategory(ctx, id any) *MockProductServiceRestoreCategoryCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockProductRepository)(nil).UpdateCategory), ctx, dto)
-	return &MockProductRepositoryUpdateCategoryCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCategory", reflect.TypeOf((*MockProductService)(nil).RestoreCategory), ctx, id)
+	return &MockProductServiceRestoreCategoryCall{Call: call}
 }
 
-// MockProductRepositoryUpdateCategoryCall wrap *gomock.Call
-type MockProductRepositoryUpdateCategoryCall struct {
+// MockProductServiceRestoreCategoryCall wrap *gomock.Call
+type MockProductServiceRestoreCategoryCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductRepositoryUpdateCategoryCall) Return(arg0 error) *MockProductRepositoryUpdateCategoryCall {
+func (c *MockProductServiceRestoreCategoryCall) Return(arg0 error) *MockProductServiceRestoreCategoryCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryUpdateCategoryCall) Do(f func(context.Context, *domain.UpdateCategoryProductDTO) error) *MockProductRepositoryUpdateCategoryCall {
+func (c *MockProductServiceRestoreCategoryCall) Do(f func(context.Context, uuid.UUID) error) *MockProductServiceRestoreCategoryCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryUpdateCategoryCall) DoAndReturn(f func(context.Context, *domain.UpdateCategoryProductDTO) error) *MockProductRepositoryUpdateCategoryCall {
+func (c *MockProductServiceRestoreCategoryCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductServiceRestoreCategoryCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateProduct mocks base method.
-func (m *MockProductRepository) UpdateProduct(ctx context.Context, dto *domain.UpdateProductDTO) error {
+// RestoreProduct mocks base method.
+func (m *MockProductService) RestoreProduct(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProduct", ctx, dto)
+	ret := m.ctrl.Call(m, "RestoreProduct", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateProduct indicates an expected call of UpdateProduct.
-func (mr *MockProductRepositoryMockRecorder) UpdateProduct(ctx, dto any) *MockProductRepositoryUpdateProductCall {
+// RestoreProduct indicates an expected call of RestoreProduct.
+func (mr *MockProductServiceMockRecorder) RestoreProduct(ctx, id any) *MockProductServiceRestoreProductCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductRepository)(nil).UpdateProduct), ctx, dto)
-	return &MockProductRepositoryUpdateProductCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProduct", reflect.TypeOf((*MockProductService)(nil).RestoreProduct), ctx, id)
+	return &MockProductServiceRestoreProductCall{Call: call}
 }
 
-// MockProductRepositoryUpdateProductCall wrap *gomock.Call
-type MockProductRepositoryUpdateProductCall struct {
+// MockProductServiceRestoreProductCall wrap *gomock.Call
+type MockProductServiceRestoreProductCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductRepositoryUpdateProductCall) Return(arg0 error) *MockProductRepositoryUpdateProductCall {
+func (c *MockProductServiceRestoreProductCall) Return(arg0 error) *MockProductServiceRestoreProductCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryUpdateProductCall) Do(f func(context.Context, *domain.UpdateProductDTO) error) *MockProductRepositoryUpdateProductCall {
+func (c *MockProductServiceRestoreProductCall) Do(f func(context.Context, uuid.UUID) error) *MockProductServiceRestoreProductCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryUpdateProductCall) DoAndReturn(f func(context.Context, *domain.UpdateProductDTO) error) *MockProductRepositoryUpdateProductCall {
+func (c *MockProductServiceRestoreProductCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductServiceRestoreProductCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateProductImage mocks base method.
-func (m *MockProductRepository) UpdateProductImage(ctx context.Context, productId uuid.UUID, image *domain.Image) error {
+// RollbackMenu mocks base method.
+func (m *MockProductService) RollbackMenu(ctx context.Context, number int) (*domain.MenuVersion, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProductImage", ctx, productId, image)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RollbackMenu", ctx, number)
+	ret0, _ := ret[0].(*domain.MenuVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateProductImage indicates an expected call of UpdateProductImage.
-func (mr *MockProductRepositoryMockRecorder) UpdateProductImage(ctx, productId, image any) *MockProductRepositoryUpdateProductImageCall {
+// RollbackMenu indicates an expected call of RollbackMenu.
+func (mr *MockProductServiceMockRecorder) RollbackMenu(ctx, number any) *MockProductServiceRollbackMenuCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductImage", reflect.TypeOf((*MockProductRepository)(nil).UpdateProductImage), ctx, productId, image)
-	return &MockProductRepositoryUpdateProductImageCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMenu", reflect.TypeOf((*MockProductService)(nil).RollbackMenu), ctx, number)
+	return &MockProductServiceRollbackMenuCall{Call: call}
 }
 
-// MockProductRepositoryUpdateProductImageCall wrap *gomock.Call
-type MockProductRepositoryUpdateProductImageCall struct {
+// MockProductServiceRollbackMenuCall wrap *gomock.Call
+type MockProductServiceRollbackMenuCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductRepositoryUpdateProductImageCall) Return(arg0 error) *MockProductRepositoryUpdateProductImageCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockProductServiceRollbackMenuCall) Return(arg0 *domain.MenuVersion, arg1 error) *MockProductServiceRollbackMenuCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductRepositoryUpdateProductImageCall) Do(f func(context.Context, uuid.UUID, *domain.Image) error) *MockProductRepositoryUpdateProductImageCall {
+func (c *MockProductServiceRollbackMenuCall) Do(f func(context.Context, int) (*domain.MenuVersion, error)) *MockProductServiceRollbackMenuCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductRepositoryUpdateProductImageCall) DoAndReturn(f func(context.Context, uuid.UUID, *domain.Image) error) *MockProductRepositoryUpdateProductImageCall {
+func (c *MockProductServiceRollbackMenuCall) DoAndReturn(f func(context.Context, int) (*domain.MenuVersion, error)) *MockProductServiceRollbackMenuCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// MockProductService is a mock of ProductService interface.
-type MockProductService struct {
-	ctrl     *gomock.Controller
-	recorder *MockProductServiceMockRecorder
-	isgomock struct{}
-}
-
-// MockProductServiceMockRecorder is the mock recorder for MockProductService.
-type MockProductServiceMockRecorder struct {
-	mock *MockProductService
-}
-
-// NewMockProductService creates a new mock instance.
-func NewMockProductService(ctrl *gomock.Controller) *MockProductService {
-	mock := &MockProductService{ctrl: ctrl}
-	mock.recorder = &MockProductServiceMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockProductService) EXPECT() *MockProductServiceMockRecorder {
-	return m.recorder
-}
-
-// AddCategory mocks base method.
-func (m *MockProductService) AddCategory(ctx context.Context, name string) (*domain.ProductCategory, error) {
+// SaveMenuDraft mocks base method.
+func (m *MockProductService) SaveMenuDraft(ctx context.Context, rows []domain.MenuRow) (*domain.MenuDraft, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddCategory", ctx, name)
-	ret0, _ := ret[0].(*domain.ProductCategory)
+	ret := m.ctrl.Call(m, "SaveMenuDraft", ctx, rows)
+	ret0, _ := ret[0].(*domain.MenuDraft)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddCategory indicates an expected call of AddCategory.
-func (mr *MockProductServiceMockRecorder) AddCategory(ctx, name any) *MockProductServiceAddCategoryCall {
+// SaveMenuDraft indicates an expected call of SaveMenuDraft.
+func (mr *MockProductServiceMockRecorder) SaveMenuDraft(ctx, rows any) *MockProductServiceSaveMenuDraftCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCategory", reflect.TypeOf((*MockProductService)(nil).AddCategory), ctx, name)
-	return &MockProductServiceAddCategoryCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMenuDraft", reflect.TypeOf((*MockProductService)(nil).SaveMenuDraft), ctx, rows)
+	return &MockProductServiceSaveMenuDraftCall{Call: call}
 }
 
-// MockProductServiceAddCategoryCall wrap *gomock.Call
-type MockProductServiceAddCategoryCall struct {
+// MockProductServiceSaveMenuDraftCall wrap *gomock.Call
+type MockProductServiceSaveMenuDraftCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductServiceAddCategoryCall) Return(arg0 *domain.ProductCategory, arg1 error) *MockProductServiceAddCategoryCall {
+func (c *MockProductServiceSaveMenuDraftCall) Return(arg0 *domain.MenuDraft, arg1 error) *MockProductServiceSaveMenuDraftCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductServiceAddCategoryCall) Do(f func(context.Context, string) (*domain.ProductCategory, error)) *MockProductServiceAddCategoryCall {
+func (c *MockProductServiceSaveMenuDraftCall) Do(f func(context.Context, []domain.MenuRow) (*domain.MenuDraft, error)) *MockProductServiceSaveMenuDraftCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductServiceAddCategoryCall) DoAndReturn(f func(context.Context, string) (*domain.ProductCategory, error)) *MockProductServiceAddCategoryCall {
+func (c *MockProductServiceSaveMenuDraftCall) DoAndReturn(f func(context.Context, []domain.MenuRow) (*domain.MenuDraft, error)) *MockProductServiceSaveMenuDraftCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// AddProduct mocks base method.
-func (m *MockProductService) AddProduct(ctx context.Context, dto *domain.AddProductDTO) (*domain.Product, error) {
+// SetCategorySchedule mocks base method.
+func (m *MockProductService) SetCategorySchedule(ctx context.Context, categoryId uuid.UUID, windows []domain.ScheduleWindow) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddProduct", ctx, dto)
-	ret0, _ := ret[0].(*domain.Product)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetCategorySchedule", ctx, categoryId, windows)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// AddProduct indicates an expected call of AddProduct.
-func (mr *MockProductServiceMockRecorder) AddProduct(ctx, dto any) *MockProductServiceAddProductCall {
+// SetCategorySchedule indicates an expected call of SetCategorySchedule.
+func (mr *MockProductServiceMockRecorder) SetCategorySchedule(ctx, categoryId, windows any) *MockProductServiceSetCategoryScheduleCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockProductService)(nil).AddProduct), ctx, dto)
-	return &MockProductServiceAddProductCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategorySchedule", reflect.TypeOf((*MockProductService)(nil).SetCategorySchedule), ctx, categoryId, windows)
+	return &MockProductServiceSetCategoryScheduleCall{Call: call}
 }
 
-// MockProductServiceAddProductCall wrap *gomock.Call
-type MockProductServiceAddProductCall struct {
+// MockProductServiceSetCategoryScheduleCall wrap *gomock.Call
+type MockProductServiceSetCategoryScheduleCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductServiceAddProductCall) Return(arg0 *domain.Product, arg1 error) *MockProductServiceAddProductCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockProductServiceSetCategoryScheduleCall) Return(arg0 error) *MockProductServiceSetCategoryScheduleCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductServiceAddProductCall) Do(f func(context.Context, *domain.AddProductDTO) (*domain.Product, error)) *MockProductServiceAddProductCall {
+func (c *MockProductServiceSetCategoryScheduleCall) Do(f func(context.Context, uuid.UUID, []domain.ScheduleWindow) error) *MockProductServiceSetCategoryScheduleCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductServiceAddProductCall) DoAndReturn(f func(context.Context, *domain.AddProductDTO) (*domain.Product, error)) *MockProductServiceAddProductCall {
+func (c *MockProductServiceSetCategoryScheduleCall) DoAndReturn(f func(context.Context, uuid.UUID, []domain.ScheduleWindow) error) *MockProductServiceSetCategoryScheduleCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteCategory mocks base method.
-func (m *MockProductService) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+// SetCategoryTranslation mocks base method.
+func (m *MockProductService) SetCategoryTranslation(ctx context.Context, translation *domain.CategoryTranslation) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret := m.ctrl.Call(m, "SetCategoryTranslation", ctx, translation)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteCategory indicates an expected call of DeleteCategory.
-func (mr *MockProductServiceMockRecorder) DeleteCategory(ctx, id any) *MockProductServiceDeleteCategoryCall {
+// SetCategoryTranslation indicates an expected call of SetCategoryTranslation.
+func (mr *MockProductServiceMockRecorder) SetCategoryTranslation(ctx, translation any) *MockProductServiceSetCategoryTranslationCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockProductService)(nil).DeleteCategory), ctx, id)
-	return &MockProductServiceDeleteCategoryCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryTranslation", reflect.TypeOf((*MockProductService)(nil).SetCategoryTranslation), ctx, translation)
+	return &MockProductServiceSetCategoryTranslationCall{Call: call}
 }
 
-// MockProductServiceDeleteCategoryCall wrap *gomock.Call
-type MockProductServiceDeleteCategoryCall struct {
+// MockProductServiceSetCategoryTranslationCall wrap *gomock.Call
+type MockProductServiceSetCategoryTranslationCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductServiceDeleteCategoryCall) Return(arg0 error) *MockProductServiceDeleteCategoryCall {
+func (c *MockProductServiceSetCategoryTranslationCall) Return(arg0 error) *MockProductServiceSetCategoryTranslationCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductServiceDeleteCategoryCall) Do(f func(context.Context, uuid.UUID) error) *MockProductServiceDeleteCategoryCall {
+func (c *MockProductServiceSetCategoryTranslationCall) Do(f func(context.Context, *domain.CategoryTranslation) error) *MockProductServiceSetCategoryTranslationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductServiceDeleteCategoryCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockProductServiceDeleteCategoryCall {
+func (c *MockProductServiceSetCategoryTranslationCall) DoAndReturn(f func(context.Context, *domain.CategoryTranslation) error) *MockProductServiceSetCategoryTranslationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// DeleteProduct mocks base method.
-func (m *MockProductService) DeleteProduct(ctx context.Context, dto *domain.DeleteProductDTO) error {
+// SetProductAvailability mocks base method.
+func (m *MockProductService) SetProductAvailability(ctx context.Context, id uuid.UUID, available bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteProduct", ctx, dto)
+	ret := m.ctrl.Call(m, "SetProductAvailability", ctx, id, available)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteProduct indicates an expected call of DeleteProduct.
-func (mr *MockProductServiceMockRecorder) DeleteProduct(ctx, dto any) *MockProductServiceDeleteProductCall {
+// SetProductAvailability indicates an expected call of SetProductAvailability.
+func (mr *MockProductServiceMockRecorder) SetProductAvailability(ctx, id, available any) *MockProductServiceSetProductAvailabilityCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductService)(nil).DeleteProduct), ctx, dto)
-	return &MockProductServiceDeleteProductCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductAvailability", reflect.TypeOf((*MockProductService)(nil).SetProductAvailability), ctx, id, available)
+	return &MockProductServiceSetProductAvailabilityCall{Call: call}
 }
 
-// MockProductServiceDeleteProductCall wrap *gomock.Call
-type MockProductServiceDeleteProductCall struct {
+// MockProductServiceSetProductAvailabilityCall wrap *gomock.Call
+type MockProductServiceSetProductAvailabilityCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductServiceDeleteProductCall) Return(arg0 error) *MockProductServiceDeleteProductCall {
+func (c *MockProductServiceSetProductAvailabilityCall) Return(arg0 error) *MockProductServiceSetProductAvailabilityCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductServiceDeleteProductCall) Do(f func(context.Context, *domain.DeleteProductDTO) error) *MockProductServiceDeleteProductCall {
+func (c *MockProductServiceSetProductAvailabilityCall) Do(f func(context.Context, uuid.UUID, bool) error) *MockProductServiceSetProductAvailabilityCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductServiceDeleteProductCall) DoAndReturn(f func(context.Context, *domain.DeleteProductDTO) error) *MockProductServiceDeleteProductCall {
+func (c *MockProductServiceSetProductAvailabilityCall) DoAndReturn(f func(context.Context, uuid.UUID, bool) error) *MockProductServiceSetProductAvailabilityCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetProductCategories mocks base method.
-func (m *MockProductService) GetProductCategories(ctx context.Context) ([]domain.ProductCategory, error) {
+// SetProductSchedule mocks base method.
+func (m *MockProductService) SetProductSchedule(ctx context.Context, productId uuid.UUID, windows []domain.ScheduleWindow) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductCategories", ctx)
-	ret0, _ := ret[0].([]domain.ProductCategory)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetProductSchedule", ctx, productId, windows)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetProductCategories indicates an expected call of GetProductCategories.
-func (mr *MockProductServiceMockRecorder) GetProductCategories(ctx any) *MockProductServiceGetProductCategoriesCall {
+// SetProductSchedule indicates an expected call of SetProductSchedule.
+func (mr *MockProductServiceMockRecorder) SetProductSchedule(ctx, productId, windows any) *MockProductServiceSetProductScheduleCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCategories", reflect.TypeOf((*MockProductService)(nil).GetProductCategories), ctx)
-	return &MockProductServiceGetProductCategoriesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductSchedule", reflect.TypeOf((*MockProductService)(nil).SetProductSchedule), ctx, productId, windows)
+	return &MockProductServiceSetProductScheduleCall{Call: call}
 }
 
-// MockProductServiceGetProductCategoriesCall wrap *gomock.Call
-type MockProductServiceGetProductCategoriesCall struct {
+// MockProductServiceSetProductScheduleCall wrap *gomock.Call
+type MockProductServiceSetProductScheduleCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductServiceGetProductCategoriesCall) Return(arg0 []domain.ProductCategory, arg1 error) *MockProductServiceGetProductCategoriesCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockProductServiceSetProductScheduleCall) Return(arg0 error) *MockProductServiceSetProductScheduleCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductServiceGetProductCategoriesCall) Do(f func(context.Context) ([]domain.ProductCategory, error)) *MockProductServiceGetProductCategoriesCall {
+func (c *MockProductServiceSetProductScheduleCall) Do(f func(context.Context, uuid.UUID, []domain.ScheduleWindow) error) *MockProductServiceSetProductScheduleCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductServiceGetProductCategoriesCall) DoAndReturn(f func(context.Context) ([]domain.ProductCategory, error)) *MockProductServiceGetProductCategoriesCall {
+func (c *MockProductServiceSetProductScheduleCall) DoAndReturn(f func(context.Context, uuid.UUID, []domain.ScheduleWindow) error) *MockProductServiceSetProductScheduleCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetProducts mocks base method.
-func (m *MockProductService) GetProducts(ctx context.Context, dto *domain.GetProductsDTO) ([]domain.Product, error) {
+// SetProductTags mocks base method.
+func (m *MockProductService) SetProductTags(ctx context.Context, productId uuid.UUID, tagIds []uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProducts", ctx, dto)
-	ret0, _ := ret[0].([]domain.Product)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetProductTags", ctx, productId, tagIds)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetProducts indicates an expected call of GetProducts.
-func (mr *MockProductServiceMockRecorder) GetProducts(ctx, dto any) *MockProductServiceGetProductsCall {
+// SetProductTags indicates an expected call of SetProductTags.
+func (mr *MockProductServiceMockRecorder) SetProductTags(ctx, productId, tagIds any) *MockProductServiceSetProductTagsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockProductService)(nil).GetProducts), ctx, dto)
-	return &MockProductServiceGetProductsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductTags", reflect.TypeOf((*MockProductService)(nil).SetProductTags), ctx, productId, tagIds)
+	return &MockProductServiceSetProductTagsCall{Call: call}
 }
 
-// MockProductServiceGetProductsCall wrap *gomock.Call
-type MockProductServiceGetProductsCall struct {
+// MockProductServiceSetProductTagsCall wrap *gomock.Call
+type MockProductServiceSetProductTagsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductServiceGetProductsCall) Return(arg0 []domain.Product, arg1 error) *MockProductServiceGetProductsCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockProductServiceSetProductTagsCall) Return(arg0 error) *MockProductServiceSetProductTagsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductServiceGetProductsCall) Do(f func(context.Context, *domain.GetProductsDTO) ([]domain.Product, error)) *MockProductServiceGetProductsCall {
+func (c *MockProductServiceSetProductTagsCall) Do(f func(context.Context, uuid.UUID, []uuid.UUID) error) *MockProductServiceSetProductTagsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductServiceGetProductsCall) DoAndReturn(f func(context.Context, *domain.GetProductsDTO) ([]domain.Product, error)) *MockProductServiceGetProductsCall {
+func (c *MockProductServiceSetProductTagsCall) DoAndReturn(f func(context.Context, uuid.UUID, []uuid.UUID) error) *MockProductServiceSetProductTagsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReplaceProductImage mocks base method.
-func (m *MockProductService) ReplaceProductImage(ctx context.Context, productId uuid.UUID, data io.Reader) (string, error) {
+// SetProductTranslation mocks base method.
+func (m *MockProductService) SetProductTranslation(ctx context.Context, translation *domain.ProductTranslation) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReplaceProductImage", ctx, productId, data)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetProductTranslation", ctx, translation)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ReplaceProductImage indicates an expected call of ReplaceProductImage.
-func (mr *MockProductServiceMockRecorder) ReplaceProductImage(ctx, productId, data any) *MockProductServiceReplaceProductImageCall {
+// SetProductTranslation indicates an expected call of SetProductTranslation.
+func (mr *MockProductServiceMockRecorder) SetProductTranslation(ctx, translation any) *MockProductServiceSetProductTranslationCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceProductImage", reflect.TypeOf((*MockProductService)(nil).ReplaceProductImage), ctx, productId, data)
-	return &MockProductServiceReplaceProductImageCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductTranslation", reflect.TypeOf((*MockProductService)(nil).SetProductTranslation), ctx, translation)
+	return &MockProductServiceSetProductTranslationCall{Call: call}
 }
 
-// MockProductServiceReplaceProductImageCall wrap *gomock.Call
-type MockProductServiceReplaceProductImageCall struct {
+// MockProductServiceSetProductTranslationCall wrap *gomock.Call
+type MockProductServiceSetProductTranslationCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockProductServiceReplaceProductImageCall) Return(arg0 string, arg1 error) *MockProductServiceReplaceProductImageCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockProductServiceSetProductTranslationCall) Return(arg0 error) *MockProductServiceSetProductTranslationCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProductServiceReplaceProductImageCall) Do(f func(context.Context, uuid.UUID, io.Reader) (string, error)) *MockProductServiceReplaceProductImageCall {
+func (c *MockProductServiceSetProductTranslationCall) Do(f func(context.Context, *domain.ProductTranslation) error) *MockProductServiceSetProductTranslationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProductServiceReplaceProductImageCall) DoAndReturn(f func(context.Context, uuid.UUID, io.Reader) (string, error)) *MockProductServiceReplaceProductImageCall {
+func (c *MockProductServiceSetProductTranslationCall) DoAndReturn(f func(context.Context, *domain.ProductTranslation) error) *MockProductServiceSetProductTranslationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
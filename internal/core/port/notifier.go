@@ -0,0 +1,9 @@
+package port
+
+import "github.com/google/uuid"
+
+// MenuNotifier is an interface for telling connected clients about changes to the menu.
+type MenuNotifier interface {
+	// NotifyAvailabilityChanged tells every client that a product became available or unavailable.
+	NotifyAvailabilityChanged(productId uuid.UUID, available bool)
+}
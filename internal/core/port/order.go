@@ -9,8 +9,9 @@ import (
 
 // OrderRepository is an interface for interacting with orders data.
 type OrderRepository interface {
-	// GetSessions fetches all sessions.
-	GetSessions(ctx context.Context) ([]domain.OrderSession, error)
+	// GetSessions fetches at most dto.Limit sessions matching the filters that were created before the cursor,
+	// from the newest to the oldest.
+	GetSessions(ctx context.Context, dto *domain.GetSessionsDTO) ([]domain.OrderSession, error)
 
 	// GetSessionByID fetches a session by id.
 	GetSessionByID(ctx context.Context, id uuid.UUID) (*domain.OrderSession, error)
@@ -24,35 +25,47 @@ type OrderRepository interface {
 	// DeleteSession deletes a session by specific id.
 	DeleteSession(ctx context.Context, id uuid.UUID) error
 
-	// GetOrderedProducts fetches all ordered products.
-	GetOrderedProducts(ctx context.Context) ([]domain.OrderedProduct, error)
+	// GetOrderedProducts fetches at most dto.Limit ordered products matching the filters that were created
+	// before the cursor, from the newest to the oldest.
+	GetOrderedProducts(ctx context.Context, dto *domain.GetOrderedProductsDTO) ([]domain.OrderedProduct, error)
 
-	// AddOrderedProduct inserts an ordered product.
+	// AddOrderedProduct inserts an ordered product with its selected options.
 	AddOrderedProduct(ctx context.Context, product *domain.OrderedProduct) error
 
-	// DeletePendingOrderedProduct deletes an ordered product only if the status is pending.
+	// AddOrderedBundle inserts an ordered bundle together with its components in a single transaction.
+	AddOrderedBundle(ctx context.Context, bundle *domain.OrderedBundle) error
+
+	// AddOrderedProducts inserts multiple ordered products in a single transaction.
+	AddOrderedProducts(ctx context.Context, products []domain.OrderedProduct) error
+
+	// DeletePendingOrderedProduct deletes an ordered product only if the status is pending and it isn't
+	// a component of an ordered bundle.
 	DeletePendingOrderedProduct(ctx context.Context, orderedProductId uuid.UUID) (*domain.OrderedProduct, error)
 
-	// DeleteOrderedProduct deletes an ordered product.
+	// DeleteOrderedProduct deletes an ordered product unless it is a component of an ordered bundle.
 	DeleteOrderedProduct(ctx context.Context, orderedProductId uuid.UUID) (*domain.OrderedProduct, error)
 
 	// UpdateOrderedProductStatus updates and returns the ordered product with updates status.
-	UpdateOrderedProductStatus(ctx context.Context, id uuid.UUID, status domain.OrderedProductStatus) (*domain.OrderedProduct, error)
+	// Moving a product to preparing deducts its recipe from stock in the same transaction and
+	// returns the products whose availability changed because of it. It fails with
+	// domain.ErrInsufficientStock if an ingredient would go negative.
+	UpdateOrderedProductStatus(ctx context.Context, id uuid.UUID, status domain.OrderedProductStatus) (*domain.OrderedProduct, []domain.AvailabilityChange, error)
 
-	// GetBillFromSession calculates the bill for order session.
+	// GetBillFromSession calculates the bill for order session. Bundle components are billed with their bundle.
 	GetBillFromSession(ctx context.Context, id uuid.UUID) (*domain.Bill, error)
 
 	// HasIncompletedOrderedProducts checks if there are any incompleted products for a session
 	HasIncompletedOrderedProducts(ctx context.Context, id uuid.UUID) (bool, error)
 
-	// DeleteOrderedProductsBySessionId deletes all ordered products with specified session.
-	DeleteOrderedProductsBySessionId(ctx context.Context, sessionId uuid.UUID) error
+	// MoveOrderedProductsToHistory deletes all ordered products and bundles with specified session and keeps
+	// which products were ordered in the session as order history for the recommendations.
+	MoveOrderedProductsToHistory(ctx context.Context, sessionId uuid.UUID) error
 }
 
 // OrderService is an interface for interacting with orders business login
 type OrderService interface {
-	// GetSessions fetches all sessions.
-	GetSessions(ctx context.Context) ([]domain.OrderSession, error)
+	// GetSessions fetches a page of sessions matching the filters.
+	GetSessions(ctx context.Context, dto *domain.GetSessionsDTO) (*domain.OrderSessionPage, error)
 
 	// CreateSession creates a new order session.
 	CreateSession(ctx context.Context) (*domain.OrderSession, error)
@@ -63,19 +76,28 @@ type OrderService interface {
 	// DeleteSession deletes a session by specific id.
 	DeleteSession(ctx context.Context, id uuid.UUID) error
 
-	// GetOrderedProducts fetches all ordered products.
-	GetOrderedProducts(ctx context.Context) ([]domain.OrderedProduct, error)
+	// GetOrderedProducts fetches a page of ordered products matching the filters.
+	GetOrderedProducts(ctx context.Context, dto *domain.GetOrderedProductsDTO) (*domain.OrderedProductPage, error)
 
 	// ValidateSession validates the session exists and its open.
 	ValidateSession(ctx context.Context, sessionId uuid.UUID) error
 
-	// OrderProduct validates the session and adds the product.
-	OrderProduct(ctx context.Context, productId uuid.UUID, sessionId uuid.UUID) (*domain.OrderedProduct, error)
+	// OrderProduct validates the session and the selected modifier options and adds the product.
+	OrderProduct(ctx context.Context, dto *domain.OrderProductDTO) (*domain.OrderedProduct, error)
+
+	// OrderBundle validates the session, the bundle and the choice for each of its slots
+	// and adds the bundle together with the chosen products as its components.
+	OrderBundle(ctx context.Context, dto *domain.OrderBundleDTO) (*domain.OrderedBundle, error)
+
+	// SubmitCart validates the session once and adds all cart items atomically.
+	SubmitCart(ctx context.Context, sessionId uuid.UUID, items []domain.OrderProductDTO) ([]domain.OrderedProduct, error)
 
 	// DeleteOrderedProduct deletes the ordered product status.
 	DeleteOrderedProduct(ctx context.Context, productId uuid.UUID, isPrivilegedCall bool) (*domain.OrderedProduct, error)
 
 	// UpdateOrderedProductStatus updates and returns the ordered product with updates status.
+	// Moving a product to preparing deducts its recipe from stock. Products whose availability changed
+	// because of it are dropped from the menu cache and announced to all clients.
 	UpdateOrderedProductStatus(ctx context.Context, id uuid.UUID, status domain.OrderedProductStatus) (*domain.OrderedProduct, error)
 
 	// GetBill fetches the bill for a specific
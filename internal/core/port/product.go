@@ -8,6 +8,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// MenuResolver resolves the menu to publish and its version against the live menu.
+type MenuResolver func(current *domain.Menu) (*domain.Menu, *domain.MenuVersion, error)
+
 // ProductRepository is an interface for interacting with product data.
 type ProductRepository interface {
 	// AddCategory saves a new product category.
@@ -19,8 +22,15 @@ type ProductRepository interface {
 	// DeleteCategory deletes a category by specified id.
 	DeleteCategory(ctx context.Context, id uuid.UUID) error
 
-	// GetProductCategories fetches all product categories.
-	GetProductCategories(ctx context.Context) ([]domain.ProductCategory, error)
+	// GetProductCategories fetches all product categories that are not archived,
+	// translated to the first of the preferred locales that has a translation.
+	GetProductCategories(ctx context.Context, locales []string) ([]domain.ProductCategory, error)
+
+	// GetArchivedCategories fetches all archived product categories.
+	GetArchivedCategories(ctx context.Context) ([]domain.ProductCategory, error)
+
+	// SetCategoryArchived archives or restores a category by specified id.
+	SetCategoryArchived(ctx context.Context, id uuid.UUID, archived bool) error
 
 	// AddProduct saves a new product.
 	AddProduct(ctx context.Context, product *domain.Product) error
@@ -28,23 +38,143 @@ type ProductRepository interface {
 	// UpdateProduct updates an existing product.
 	UpdateProduct(ctx context.Context, dto *domain.UpdateProductDTO) error
 
-	// UpdateProductImage replaces the image data of a product.
-	UpdateProductImage(ctx context.Context, productId uuid.UUID, image *domain.Image) error
+	// UpdateProductImage replaces the primary image of a product with the saved image variants,
+	// keeping its alt text, or adds it when the product has no images.
+	UpdateProductImage(ctx context.Context, productId uuid.UUID, images domain.ImageSet) error
+
+	// AddProductImage adds an image to the end of the product gallery and sets its position.
+	AddProductImage(ctx context.Context, image *domain.ProductImage) error
+
+	// ReorderProductImages sets the order of the product gallery to the order of the image ids,
+	// which must list every image of the product.
+	ReorderProductImages(ctx context.Context, productId uuid.UUID, imageIds []uuid.UUID) error
 
-	// DeleteProductById deletes a product by specified id and return its data.
+	// DeleteProductImage removes an image from the product gallery and returns its data.
+	DeleteProductImage(ctx context.Context, productId, imageId uuid.UUID) (*domain.ProductImage, error)
+
+	// DeleteProductById deletes a product by specified id and return its data including the gallery.
 	DeleteProductById(ctx context.Context, id uuid.UUID) (*domain.Product, error)
 
-	// DeleteProductsByCategory deletes all products by specified category id and return their data.
+	// DeleteProductsByCategory deletes all products by specified category id and return their data including the galleries.
 	DeleteProductsByCategory(ctx context.Context, categoryId uuid.UUID) ([]domain.Product, error)
 
-	// GetProductById fetches a single product by id.
+	// GetProductById fetches a single product by id, including archived products and products of archived categories,
+	// together with its own and its category's availability schedule and pricing rules.
 	GetProductById(ctx context.Context, id uuid.UUID) (*domain.Product, error)
 
-	// GetProducts fetches all products.
-	GetProducts(ctx context.Context) ([]domain.Product, error)
+	// GetProducts fetches a page of products that are not archived, match the filters and search
+	// and are scheduled at dto.At, in the requested order.
+	// The products include the pricing rules of their own and of their category.
+	GetProducts(ctx context.Context, dto *domain.GetProductsDTO) ([]domain.Product, error)
+
+	// CountProducts counts all products GetProducts would return without pagination.
+	CountProducts(ctx context.Context, dto *domain.GetProductsDTO) (int, error)
+
+	// SetProductAvailability marks a product as available or unavailable for ordering. The stock of
+	// its ingredients no longer makes it available again after that.
+	SetProductAvailability(ctx context.Context, id uuid.UUID, available bool) error
+
+	// GetArchivedProducts fetches all archived products and the products of archived categories.
+	GetArchivedProducts(ctx context.Context) ([]domain.Product, error)
+
+	// SetProductArchived archives or restores a product by specified id.
+	SetProductArchived(ctx context.Context, id uuid.UUID, archived bool) error
+
+	// AddModifierGroup saves a new modifier group with its options.
+	AddModifierGroup(ctx context.Context, group *domain.ModifierGroup) error
+
+	// DeleteModifierGroup deletes a modifier group and its options by specified id.
+	DeleteModifierGroup(ctx context.Context, id uuid.UUID) error
+
+	// GetTags fetches all tags.
+	GetTags(ctx context.Context) ([]domain.Tag, error)
+
+	// AddTag saves a new tag.
+	AddTag(ctx context.Context, tag *domain.Tag) error
+
+	// DeleteTag deletes a dietary tag by specified id.
+	DeleteTag(ctx context.Context, id uuid.UUID) error
+
+	// SetProductTags replaces the tags of a product.
+	SetProductTags(ctx context.Context, productId uuid.UUID, tagIds []uuid.UUID) error
+
+	// GetProductTranslations fetches all translations of a product.
+	GetProductTranslations(ctx context.Context, productId uuid.UUID) ([]domain.ProductTranslation, error)
+
+	// SetProductTranslation creates or replaces the translation of a product in a locale.
+	SetProductTranslation(ctx context.Context, translation *domain.ProductTranslation) error
+
+	// DeleteProductTranslation deletes the translation of a product in a locale.
+	DeleteProductTranslation(ctx context.Context, productId uuid.UUID, locale string) error
 
-	// GetProductsByCategory fetches products by category id.
-	GetProductsByCategory(ctx context.Context, categoryId uuid.UUID) ([]domain.Product, error)
+	// GetCategoryTranslations fetches all translations of a product category.
+	GetCategoryTranslations(ctx context.Context, categoryId uuid.UUID) ([]domain.CategoryTranslation, error)
+
+	// SetCategoryTranslation creates or replaces the translation of a product category in a locale.
+	SetCategoryTranslation(ctx context.Context, translation *domain.CategoryTranslation) error
+
+	// DeleteCategoryTranslation deletes the translation of a product category in a locale.
+	DeleteCategoryTranslation(ctx context.Context, categoryId uuid.UUID, locale string) error
+
+	// GetProductSchedule fetches the availability schedule of a product.
+	GetProductSchedule(ctx context.Context, productId uuid.UUID) ([]domain.ScheduleWindow, error)
+
+	// SetProductSchedule replaces the availability schedule of a product.
+	SetProductSchedule(ctx context.Context, productId uuid.UUID, windows []domain.ScheduleWindow) error
+
+	// GetCategorySchedule fetches the availability schedule of a product category.
+	GetCategorySchedule(ctx context.Context, categoryId uuid.UUID) ([]domain.ScheduleWindow, error)
+
+	// SetCategorySchedule replaces the availability schedule of a product category.
+	SetCategorySchedule(ctx context.Context, categoryId uuid.UUID, windows []domain.ScheduleWindow) error
+
+	// GetPricingRules fetches all pricing rules.
+	GetPricingRules(ctx context.Context) ([]domain.PricingRule, error)
+
+	// AddPricingRule saves a new pricing rule.
+	AddPricingRule(ctx context.Context, rule *domain.PricingRule) error
+
+	// DeletePricingRule deletes a pricing rule by specified id.
+	DeletePricingRule(ctx context.Context, id uuid.UUID) error
+
+	// GetMenu fetches all product categories and products, including archived ones, ordered by name.
+	GetMenu(ctx context.Context) (*domain.Menu, error)
+
+	// UpsertMenu creates or updates all categories and products of the menu by id in one transaction.
+	// A product without an image url keeps its current image.
+	UpsertMenu(ctx context.Context, menu *domain.Menu) error
+
+	// GetMenuDraft fetches the staged menu changes.
+	GetMenuDraft(ctx context.Context) (*domain.MenuDraft, error)
+
+	// HasMenuDraft checks if menu changes are staged.
+	HasMenuDraft(ctx context.Context) (bool, error)
+
+	// SaveMenuDraft creates or replaces the staged menu changes. A replaced draft keeps its creation time.
+	SaveMenuDraft(ctx context.Context, draft *domain.MenuDraft) error
+
+	// DeleteMenuDraft discards the staged menu changes.
+	DeleteMenuDraft(ctx context.Context) error
+
+	// GetMenuVersions fetches all published menu versions without their rows, newest first.
+	GetMenuVersions(ctx context.Context) ([]domain.MenuVersion, error)
+
+	// GetMenuVersion fetches a published menu version by its number.
+	GetMenuVersion(ctx context.Context, number int) (*domain.MenuVersion, error)
+
+	// PublishMenu makes a menu live as a new version in one transaction. It locks the menu against other
+	// changes, fetches the live menu and passes it to resolve, which returns the menu to publish and its version.
+	// It upserts the categories and products of the menu, restores the archived ones among them and archives
+	// the other live ones, then saves the version with the next number. When no version was published yet,
+	// the live menu is saved as the first version beforehand, so it can be rolled back to.
+	//
+	// The details of the published entries and the pricing rules are captured in the version. A version
+	// that restores another one with details restores them first. Tags that were deleted since are left
+	// out, and modifier groups and options that were ordered since are kept, because orders refer to them.
+	//
+	// When a draft is published, it is deleted in the same transaction, only the entries created before
+	// the draft are archived, and ErrMenuDraftChanged is returned if it was saved again since it was fetched.
+	PublishMenu(ctx context.Context, draft *domain.MenuDraft, resolve MenuResolver) error
 }
 
 // ProductService is an interface for interacting with product business logic.
@@ -58,8 +188,18 @@ type ProductService interface {
 	// DeleteCategory deletes a category by specified id.
 	DeleteCategory(ctx context.Context, id uuid.UUID) error
 
-	// GetProductCategories fetches all product categories.
-	GetProductCategories(ctx context.Context) ([]domain.ProductCategory, error)
+	// GetProductCategories fetches all product categories that are not archived,
+	// translated to the first of the preferred locales that has a translation.
+	GetProductCategories(ctx context.Context, locales []string) ([]domain.ProductCategory, error)
+
+	// GetArchivedCategories fetches all archived product categories.
+	GetArchivedCategories(ctx context.Context) ([]domain.ProductCategory, error)
+
+	// ArchiveCategory hides a category and its products from the public menu.
+	ArchiveCategory(ctx context.Context, id uuid.UUID) error
+
+	// RestoreCategory makes an archived category visible again.
+	RestoreCategory(ctx context.Context, id uuid.UUID) error
 
 	// AddProduct saves a new product with linked image.
 	AddProduct(ctx context.Context, dto *domain.AddProductDTO) (*domain.Product, error)
@@ -67,12 +207,118 @@ type ProductService interface {
 	// UpdateProduct updates an existing product.
 	UpdateProduct(ctx context.Context, dto *domain.UpdateProductDTO) error
 
-	// ReplaceProductImage sets a new image to a product.
-	ReplaceProductImage(ctx context.Context, productId uuid.UUID, data io.Reader) (string, error)
+	// ReplaceProductImage sets a new primary image to a product and returns its saved variants.
+	ReplaceProductImage(ctx context.Context, productId uuid.UUID, data io.Reader) (domain.ImageSet, error)
+
+	// AddProductImage adds a new image to the end of the product gallery.
+	AddProductImage(ctx context.Context, dto *domain.AddProductImageDTO) (*domain.ProductImage, error)
+
+	// ReorderProductImages sets the order of the product gallery. The first image becomes the primary image.
+	ReorderProductImages(ctx context.Context, productId uuid.UUID, imageIds []uuid.UUID) error
+
+	// DeleteProductImage removes an image from the product gallery.
+	DeleteProductImage(ctx context.Context, productId, imageId uuid.UUID) error
 
 	// DeleteProduct deletes a product with filters.
 	DeleteProduct(ctx context.Context, dto *domain.DeleteProductDTO) error
 
-	// GetProducts fetches products.
-	GetProducts(ctx context.Context, dto *domain.GetProductsDTO) ([]domain.Product, error)
+	// GetProducts fetches a page of products.
+	GetProducts(ctx context.Context, dto *domain.GetProductsDTO) (*domain.ProductPage, error)
+
+	// SetProductAvailability marks a product as available or unavailable for ordering.
+	SetProductAvailability(ctx context.Context, id uuid.UUID, available bool) error
+
+	// GetArchivedProducts fetches all archived products and the products of archived categories.
+	GetArchivedProducts(ctx context.Context) ([]domain.Product, error)
+
+	// ArchiveProduct hides a product from the public menu while keeping its order history.
+	ArchiveProduct(ctx context.Context, id uuid.UUID) error
+
+	// RestoreProduct makes an archived product visible again.
+	RestoreProduct(ctx context.Context, id uuid.UUID) error
+
+	// AddModifierGroup saves a new modifier group to a product.
+	AddModifierGroup(ctx context.Context, dto *domain.AddModifierGroupDTO) (*domain.ModifierGroup, error)
+
+	// DeleteModifierGroup deletes a modifier group by specified id.
+	DeleteModifierGroup(ctx context.Context, id uuid.UUID) error
+
+	// GetTags fetches all tags.
+	GetTags(ctx context.Context) ([]domain.Tag, error)
+
+	// AddTag saves a new custom dietary tag.
+	AddTag(ctx context.Context, name string) (*domain.Tag, error)
+
+	// DeleteTag deletes a custom dietary tag by specified id.
+	DeleteTag(ctx context.Context, id uuid.UUID) error
+
+	// SetProductTags replaces the tags of a product.
+	SetProductTags(ctx context.Context, productId uuid.UUID, tagIds []uuid.UUID) error
+
+	// GetProductTranslations fetches all translations of a product.
+	GetProductTranslations(ctx context.Context, productId uuid.UUID) ([]domain.ProductTranslation, error)
+
+	// SetProductTranslation creates or replaces the translation of a product in a locale.
+	SetProductTranslation(ctx context.Context, translation *domain.ProductTranslation) error
+
+	// DeleteProductTranslation deletes the translation of a product in a locale.
+	DeleteProductTranslation(ctx context.Context, productId uuid.UUID, locale string) error
+
+	// GetCategoryTranslations fetches all translations of a product category.
+	GetCategoryTranslations(ctx context.Context, categoryId uuid.UUID) ([]domain.CategoryTranslation, error)
+
+	// SetCategoryTranslation creates or replaces the translation of a product category in a locale.
+	SetCategoryTranslation(ctx context.Context, translation *domain.CategoryTranslation) error
+
+	// DeleteCategoryTranslation deletes the translation of a product category in a locale.
+	DeleteCategoryTranslation(ctx context.Context, categoryId uuid.UUID, locale string) error
+
+	// GetProductSchedule fetches the availability schedule of a product.
+	GetProductSchedule(ctx context.Context, productId uuid.UUID) ([]domain.ScheduleWindow, error)
+
+	// SetProductSchedule replaces the availability schedule of a product.
+	SetProductSchedule(ctx context.Context, productId uuid.UUID, windows []domain.ScheduleWindow) error
+
+	// GetCategorySchedule fetches the availability schedule of a product category.
+	GetCategorySchedule(ctx context.Context, categoryId uuid.UUID) ([]domain.ScheduleWindow, error)
+
+	// SetCategorySchedule replaces the availability schedule of a product category.
+	SetCategorySchedule(ctx context.Context, categoryId uuid.UUID, windows []domain.ScheduleWindow) error
+
+	// GetPricingRules fetches all pricing rules.
+	GetPricingRules(ctx context.Context) ([]domain.PricingRule, error)
+
+	// AddPricingRule saves a new pricing rule.
+	AddPricingRule(ctx context.Context, dto *domain.AddPricingRuleDTO) (*domain.PricingRule, error)
+
+	// DeletePricingRule deletes a pricing rule by specified id.
+	DeletePricingRule(ctx context.Context, id uuid.UUID) error
+
+	// ExportMenu fetches the menu that is not archived as rows, one per product or empty category.
+	ExportMenu(ctx context.Context) ([]domain.MenuRow, error)
+
+	// ImportMenu validates the rows and, unless it is a dry run or a row is invalid, upserts them.
+	ImportMenu(ctx context.Context, dto *domain.ImportMenuDTO) (*domain.MenuImportReport, error)
+
+	// GetMenuDraft fetches the staged menu changes, or the live menu if nothing is staged.
+	GetMenuDraft(ctx context.Context) (*domain.MenuDraft, error)
+
+	// SaveMenuDraft replaces the staged menu changes with the rows.
+	SaveMenuDraft(ctx context.Context, rows []domain.MenuRow) (*domain.MenuDraft, error)
+
+	// DiscardMenuDraft discards the staged menu changes.
+	DiscardMenuDraft(ctx context.Context) error
+
+	// PreviewMenuDraft shows the menu the draft would publish and how it differs from the live menu.
+	PreviewMenuDraft(ctx context.Context) (*domain.MenuPreview, error)
+
+	// PublishMenuDraft replaces the live menu with the draft as a new version and discards the draft.
+	PublishMenuDraft(ctx context.Context) (*domain.MenuVersion, error)
+
+	// GetMenuVersions fetches all published menu versions without their rows, newest first.
+	GetMenuVersions(ctx context.Context) ([]domain.MenuVersion, error)
+
+	// RollbackMenu publishes the rows of an earlier version as a new version.
+	// It fails with domain.ErrMenuDraftPending while a menu draft is staged.
+	RollbackMenu(ctx context.Context, number int) (*domain.MenuVersion, error)
 }
@@ -0,0 +1,38 @@
+package port
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecommendationRepository interface {
+	// GetRecommendations computes which products were ordered together in the sessions since the given time,
+	// including the sessions that have been paid since.
+	// It returns up to limit companions for every product, which must have been ordered together in at least
+	// minSessions sessions, ordered by product and then by the number of sessions. Archived and unavailable
+	// products aren't recommended. Only the ids are returned, the product data may change before they are used.
+	GetRecommendations(ctx context.Context, since time.Time, minSessions, limit int) ([]domain.Recommendation, error)
+}
+
+// RecommendationCacheRepository is an interface for keeping the recommendations between recomputations.
+type RecommendationCacheRepository interface {
+	// Get fetches the recommendations for a product.
+	Get(productId uuid.UUID) []domain.Recommendation
+
+	// Replace replaces all cached recommendations with the recomputed ones.
+	Replace(recommendations map[uuid.UUID][]domain.Recommendation)
+}
+
+type RecommendationService interface {
+	// RefreshRecommendations recomputes the recommendations from the order history and returns
+	// for how many products there are recommendations.
+	RefreshRecommendations(ctx context.Context) (int, error)
+
+	// GetRecommendations fetches the products that are most often ordered together with a product. The companions
+	// are listed as the public menu lists them in the preferred locales, so only those that are currently available
+	// and scheduled are recommended, with their current prices.
+	GetRecommendations(ctx context.Context, productId uuid.UUID, locales []string) ([]domain.RecommendedProduct, error)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port"
+
+	"github.com/google/uuid"
+)
+
+// BundleService implements port.BundleService and provides access to bundle-related business logic.
+type BundleService struct {
+	bundleRepository port.BundleRepository
+}
+
+// NewBundleService creates a new BundleService instance.
+func NewBundleService(bundleRepository port.BundleRepository) *BundleService {
+	return &BundleService{
+		bundleRepository: bundleRepository,
+	}
+}
+
+func (s *BundleService) GetBundles(ctx context.Context) ([]domain.Bundle, error) {
+	return s.bundleRepository.GetBundles(ctx)
+}
+
+func (s *BundleService) AddBundle(ctx context.Context, dto *domain.AddBundleDTO) (*domain.Bundle, error) {
+	if len(dto.Slots) == 0 {
+		return nil, domain.ErrInvalidBundle
+	}
+
+	slots := make([]domain.BundleSlot, 0, len(dto.Slots))
+	for _, slot := range dto.Slots {
+		if (slot.CategoryId == nil) == (len(slot.ProductIds) == 0) {
+			return nil, domain.ErrInvalidBundle
+		}
+		slots = append(slots, *domain.NewBundleSlot(uuid.New(), slot.Name, slot.CategoryId, slot.ProductIds))
+	}
+
+	bundle := domain.NewBundle(uuid.New(), dto.Name, dto.Description, dto.Price, true, slots)
+	if err := s.bundleRepository.AddBundle(ctx, bundle); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+func (s *BundleService) DeleteBundle(ctx context.Context, id uuid.UUID) error {
+	return s.bundleRepository.DeleteBundle(ctx, id)
+}
+
+func (s *BundleService) SetBundleAvailability(ctx context.Context, id uuid.UUID, available bool) error {
+	return s.bundleRepository.SetBundleAvailability(ctx, id, available)
+}
@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port/mock"
+	"restaurant/internal/core/service"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBundleService_AddBundle(t *testing.T) {
+	categoryId := uuid.New()
+	productIds := []uuid.UUID{uuid.New(), uuid.New()}
+	price := decimal.NewFromInt(15)
+
+	tests := []struct {
+		name          string
+		slots         []domain.AddBundleSlotDTO
+		expectedError error
+		mockSetup     func(bundleRepository *mock.MockBundleRepository)
+	}{
+		{
+			name: "success",
+			slots: []domain.AddBundleSlotDTO{
+				*domain.NewAddBundleSlotDTO("Main", &categoryId, nil),
+				*domain.NewAddBundleSlotDTO("Drink", nil, productIds),
+			},
+			mockSetup: func(bundleRepository *mock.MockBundleRepository) {
+				bundleRepository.EXPECT().
+					AddBundle(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.Bundle{})).
+					DoAndReturn(func(_ context.Context, bundle *domain.Bundle) error {
+						require.NotEqual(t, uuid.Nil, bundle.Id)
+						require.Equal(t, "Lunch", bundle.Name)
+						require.True(t, price.Equal(bundle.Price))
+						require.True(t, bundle.Available)
+						require.Len(t, bundle.Slots, 2)
+						require.Equal(t, "Main", bundle.Slots[0].Name)
+						require.Equal(t, &categoryId, bundle.Slots[0].CategoryId)
+						require.Equal(t, productIds, bundle.Slots[1].ProductIds)
+						require.NotEqual(t, bundle.Slots[0].Id, bundle.Slots[1].Id)
+						return nil
+					})
+			},
+		}, {
+			name:          "without slots",
+			expectedError: domain.ErrInvalidBundle,
+		}, {
+			name:          "slot without category or products",
+			slots:         []domain.AddBundleSlotDTO{*domain.NewAddBundleSlotDTO("Main", nil, nil)},
+			expectedError: domain.ErrInvalidBundle,
+		}, {
+			name:          "slot with category and products",
+			slots:         []domain.AddBundleSlotDTO{*domain.NewAddBundleSlotDTO("Main", &categoryId, productIds)},
+			expectedError: domain.ErrInvalidBundle,
+		}, {
+			name:          "name already in use",
+			slots:         []domain.AddBundleSlotDTO{*domain.NewAddBundleSlotDTO("Main", &categoryId, nil)},
+			expectedError: domain.ErrBundleNameAlreadyInUse,
+			mockSetup: func(bundleRepository *mock.MockBundleRepository) {
+				bundleRepository.EXPECT().
+					AddBundle(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.Bundle{})).
+					Return(domain.ErrBundleNameAlreadyInUse)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			bundleRepository := mock.NewMockBundleRepository(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(bundleRepository)
+			}
+
+			bundle, err := service.NewBundleService(bundleRepository).
+				AddBundle(context.Background(), domain.NewAddBundleDTO("Lunch", "Main course with a drink", price, tt.slots))
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				require.NotNil(t, bundle)
+			} else {
+				require.Nil(t, bundle)
+			}
+		})
+	}
+}
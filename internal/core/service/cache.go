@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// CachedProductService implements port.ProductService in front of another product service.
+// It serves the public product and category listings from the menu cache and invalidates
+// the cache whenever a change to the menu succeeds.
+type CachedProductService struct {
+	port.ProductService
+	menuCache port.MenuCacheRepository
+	clock     port.Clock
+}
+
+// NewCachedProductService creates a new CachedProductService instance.
+func NewCachedProductService(productService *ProductService, menuCache port.MenuCacheRepository) *CachedProductService {
+	return &CachedProductService{
+		ProductService: productService,
+		menuCache:      menuCache,
+		clock:          productService.clock,
+	}
+}
+
+// invalidate drops the cached listings if the change succeeded and passes its error through.
+func (s *CachedProductService) invalidate(err error) error {
+	if err == nil {
+		s.menuCache.Invalidate()
+	}
+	return err
+}
+
+func (s *CachedProductService) GetProductCategories(ctx context.Context, locales []string) ([]domain.ProductCategory, error) {
+	key := "categories|" + strings.Join(locales, ",")
+	if cached, ok := s.menuCache.Get(key); ok {
+		return cached.([]domain.ProductCategory), nil
+	}
+
+	loadedAt := s.clock.Now()
+	categories, err := s.ProductService.GetProductCategories(ctx, locales)
+	if err != nil {
+		return nil, err
+	}
+	s.menuCache.Set(key, categories, loadedAt)
+	return categories, nil
+}
+
+func (s *CachedProductService) GetProducts(ctx context.Context, dto *domain.GetProductsDTO) (*domain.ProductPage, error) {
+	var categoryId string
+	if dto.CategoryId != nil {
+		categoryId = dto.CategoryId.String()
+	}
+	var ids string
+	if dto.Ids != nil {
+		ids = fmt.Sprint(dto.Ids)
+	}
+	var maxCalories string
+	if dto.MaxCalories != nil {
+		maxCalories = strconv.Itoa(*dto.MaxCalories)
+	}
+	key := fmt.Sprintf(
+		"products|%s|%s|%q|%q|%q|%q|%s|%s|%t|%d|%d",
+		ids,
+		categoryId,
+		dto.IncludeTags,
+		dto.ExcludeTags,
+		dto.Locales,
+		dto.Search,
+		maxCalories,
+		dto.Sort,
+		dto.Descending,
+		dto.Limit,
+		dto.Offset,
+	)
+	if cached, ok := s.menuCache.Get(key); ok {
+		return cached.(*domain.ProductPage), nil
+	}
+
+	loadedAt := s.clock.Now()
+	page, err := s.ProductService.GetProducts(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+	s.menuCache.Set(key, page, loadedAt)
+	return page, nil
+}
+
+func (s *CachedProductService) AddCategory(ctx context.Context, name string) (*domain.ProductCategory, error) {
+	category, err := s.ProductService.AddCategory(ctx, name)
+	return category, s.invalidate(err)
+}
+
+func (s *CachedProductService) UpdateCategory(ctx context.Context, dto *domain.UpdateCategoryProductDTO) error {
+	return s.invalidate(s.ProductService.UpdateCategory(ctx, dto))
+}
+
+func (s *CachedProductService) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	return s.invalidate(s.ProductService.DeleteCategory(ctx, id))
+}
+
+func (s *CachedProductService) ArchiveCategory(ctx context.Context, id uuid.UUID) error {
+	return s.invalidate(s.ProductService.ArchiveCategory(ctx, id))
+}
+
+func (s *CachedProductService) RestoreCategory(ctx context.Context, id uuid.UUID) error {
+	return s.invalidate(s.ProductService.RestoreCategory(ctx, id))
+}
+
+func (s *CachedProductService) AddProduct(ctx context.Context, dto *domain.AddProductDTO) (*domain.Product, error) {
+	product, err := s.ProductService.AddProduct(ctx, dto)
+	return product, s.invalidate(err)
+}
+
+func (s *CachedProductService) UpdateProduct(ctx context.Context, dto *domain.UpdateProductDTO) error {
+	return s.invalidate(s.ProductService.UpdateProduct(ctx, dto))
+}
+
+func (s *CachedProductService) ReplaceProductImage(ctx context.Context, productId uuid.UUID, data io.Reader) (domain.ImageSet, error) {
+	images, err := s.ProductService.ReplaceProductImage(ctx, productId, data)
+	return images, s.invalidate(err)
+}
+
+func (s *CachedProductService) AddProductImage(ctx context.Context, dto *domain.AddProductImageDTO) (*domain.ProductImage, error) {
+	image, err := s.ProductService.AddProductImage(ctx, dto)
+	return image, s.invalidate(err)
+}
+
+func (s *CachedProductService) ReorderProductImages(ctx context.Context, productId uuid.UUID, imageIds []uuid.UUID) error {
+	return s.invalidate(s.ProductService.ReorderProductImages(ctx, productId, imageIds))
+}
+
+func (s *CachedProductService) DeleteProductImage(ctx context.Context, productId, imageId uuid.UUID) error {
+	return s.invalidate(s.ProductService.DeleteProductImage(ctx, productId, imageId))
+}
+
+func (s *CachedProductService) DeleteProduct(ctx context.Context, dto *domain.DeleteProductDTO) error {
+	return s.invalidate(s.ProductService.DeleteProduct(ctx, dto))
+}
+
+func (s *CachedProductService) SetProductAvailability(ctx context.Context, id uuid.UUID, available bool) error {
+	return s.invalidate(s.ProductService.SetProductAvailability(ctx, id, available))
+}
+
+func (s *CachedProductService) ArchiveProduct(ctx context.Context, id uuid.UUID) error {
+	return s.invalidate(s.ProductService.ArchiveProduct(ctx, id))
+}
+
+func (s *CachedProductService) RestoreProduct(ctx context.Context, id uuid.UUID) error {
+	return s.invalidate(s.ProductService.RestoreProduct(ctx, id))
+}
+
+func (s *CachedProductService) AddModifierGroup(ctx context.Context, dto *domain.AddModifierGroupDTO) (*domain.ModifierGroup, error) {
+	group, err := s.ProductService.AddModifierGroup(ctx, dto)
+	return group, s.invalidate(err)
+}
+
+func (s *CachedProductService) DeleteModifierGroup(ctx context.Context, id uuid.UUID) error {
+	return s.invalidate(s.ProductService.DeleteModifierGroup(ctx, id))
+}
+
+func (s *CachedProductService) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	return s.invalidate(s.ProductService.DeleteTag(ctx, id))
+}
+
+func (s *CachedProductService) SetProductTags(ctx context.Context, productId uuid.UUID, tagIds []uuid.UUID) error {
+	return s.invalidate(s.ProductService.SetProductTags(ctx, productId, tagIds))
+}
+
+func (s *CachedProductService) SetProductTranslation(ctx context.Context, translation *domain.ProductTranslation) error {
+	return s.invalidate(s.ProductService.SetProductTranslation(ctx, translation))
+}
+
+func (s *CachedProductService) DeleteProductTranslation(ctx context.Context, productId uuid.UUID, locale string) error {
+	return s.invalidate(s.ProductService.DeleteProductTranslation(ctx, productId, locale))
+}
+
+func (s *CachedProductService) SetCategoryTranslation(ctx context.Context, translation *domain.CategoryTranslation) error {
+	return s.invalidate(s.ProductService.SetCategoryTranslation(ctx, translation))
+}
+
+func (s *CachedProductService) DeleteCategoryTranslation(ctx context.Context, categoryId uuid.UUID, locale string) error {
+	return s.invalidate(s.ProductService.DeleteCategoryTranslation(ctx, categoryId, locale))
+}
+
+func (s *CachedProductService) SetProductSchedule(ctx context.Context, productId uuid.UUID, windows []domain.ScheduleWindow) error {
+	return s.invalidate(s.ProductService.SetProductSchedule(ctx, productId, windows))
+}
+
+func (s *CachedProductService) SetCategorySchedule(ctx context.Context, categoryId uuid.UUID, windows []domain.ScheduleWindow) error {
+	return s.invalidate(s.ProductService.SetCategorySchedule(ctx, categoryId, windows))
+}
+
+func (s *CachedProductService) AddPricingRule(ctx context.Context, dto *domain.AddPricingRuleDTO) (*domain.PricingRule, error) {
+	rule, err := s.ProductService.AddPricingRule(ctx, dto)
+	return rule, s.invalidate(err)
+}
+
+func (s *CachedProductService) DeletePricingRule(ctx context.Context, id uuid.UUID) error {
+	return s.invalidate(s.ProductService.DeletePricingRule(ctx, id))
+}
+
+func (s *CachedProductService) ImportMenu(ctx context.Context, dto *domain.ImportMenuDTO) (*domain.MenuImportReport, error) {
+	report, err := s.ProductService.ImportMenu(ctx, dto)
+	if err == nil && report.Applied {
+		s.menuCache.Invalidate()
+	}
+	return report, err
+}
+
+func (s *CachedProductService) PublishMenuDraft(ctx context.Context) (*domain.MenuVersion, error) {
+	version, err := s.ProductService.PublishMenuDraft(ctx)
+	return version, s.invalidate(err)
+}
+
+func (s *CachedProductService) RollbackMenu(ctx context.Context, number int) (*domain.MenuVersion, error) {
+	version, err := s.ProductService.RollbackMenu(ctx, number)
+	return version, s.invalidate(err)
+}
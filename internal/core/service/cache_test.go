@@ -0,0 +1,107 @@
+package service_test
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port/mock"
+	"restaurant/internal/core/service"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCachedProductService_GetProducts(t *testing.T) {
+	cachedPage := domain.NewProductPage([]domain.Product{{Id: uuid.New(), Name: "Cola"}}, 1)
+
+	tests := []struct {
+		name      string
+		mockSetup func(productRepository *mock.MockProductRepository, menuCache *mock.MockMenuCacheRepository)
+	}{
+		{
+			name: "cache hit",
+			mockSetup: func(productRepository *mock.MockProductRepository, menuCache *mock.MockMenuCacheRepository) {
+				menuCache.EXPECT().
+					Get(gomock.Any()).
+					Return(cachedPage, true)
+			},
+		},
+		{
+			name: "cache miss",
+			mockSetup: func(productRepository *mock.MockProductRepository, menuCache *mock.MockMenuCacheRepository) {
+				menuCache.EXPECT().
+					Get(gomock.Any()).
+					Return(nil, false)
+				productRepository.EXPECT().
+					GetProducts(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.GetProductsDTO{})).
+					Return(cachedPage.Products, nil)
+				productRepository.EXPECT().
+					CountProducts(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.GetProductsDTO{})).
+					Return(1, nil)
+				menuCache.EXPECT().
+					Set(gomock.Any(), gomock.AssignableToTypeOf(&domain.ProductPage{}), gomock.AssignableToTypeOf(time.Time{}))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			menuCache := mock.NewMockMenuCacheRepository(ctrl)
+			tt.mockSetup(productRepository, menuCache)
+
+			productService := newProductService(ctrl, productServiceDeps{productRepository: productRepository})
+			page, err := service.NewCachedProductService(productService, menuCache).
+				GetProducts(context.Background(), domain.NewGetProductsDTO(nil, nil, nil, nil, "", nil, "", false, 0, 0))
+			require.NoError(t, err)
+			require.Equal(t, cachedPage.Products, page.Products)
+		})
+	}
+}
+
+func TestCachedProductService_UpdateProduct(t *testing.T) {
+	name := "New Product"
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mockSetup     func(productRepository *mock.MockProductRepository, menuCache *mock.MockMenuCacheRepository)
+	}{
+		{
+			name: "success invalidates",
+			mockSetup: func(productRepository *mock.MockProductRepository, menuCache *mock.MockMenuCacheRepository) {
+				productRepository.EXPECT().
+					UpdateProduct(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.UpdateProductDTO{})).
+					Return(nil)
+				menuCache.EXPECT().Invalidate()
+			},
+		},
+		{
+			name:          "error keeps cache",
+			expectedError: domain.ErrProductNotFound,
+			mockSetup: func(productRepository *mock.MockProductRepository, menuCache *mock.MockMenuCacheRepository) {
+				productRepository.EXPECT().
+					UpdateProduct(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.UpdateProductDTO{})).
+					Return(domain.ErrProductNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			productRepository.EXPECT().HasMenuDraft(gomock.Any()).Return(false, nil).AnyTimes()
+			menuCache := mock.NewMockMenuCacheRepository(ctrl)
+			tt.mockSetup(productRepository, menuCache)
+
+			productService := newProductService(ctrl, productServiceDeps{productRepository: productRepository})
+			err := service.NewCachedProductService(productService, menuCache).
+				UpdateProduct(context.Background(), domain.NewUpdateProductDTO(uuid.New(), &name, nil, nil, nil, nil))
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package service_test
+
+import "time"
+
+// testNow is the moment services see in tests. 2024-01-01 is a Monday.
+var testNow = time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+// fixedClock implements port.Clock and always returns the same moment.
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
@@ -9,8 +9,9 @@ import (
 var Module = fx.Module(
 	"service",
 	fx.Provide(
+		NewProductService,
 		fx.Annotate(
-			NewProductService,
+			NewCachedProductService,
 			fx.As(new(port.ProductService)),
 		),
 	),
@@ -20,4 +21,28 @@ var Module = fx.Module(
 			fx.As(new(port.OrderService)),
 		),
 	),
+	fx.Provide(
+		fx.Annotate(
+			NewInventoryService,
+			fx.As(new(port.InventoryService)),
+		),
+	),
+	fx.Provide(
+		fx.Annotate(
+			NewBundleService,
+			fx.As(new(port.BundleService)),
+		),
+	),
+	fx.Provide(
+		fx.Annotate(
+			NewImageCleanupService,
+			fx.As(new(port.ImageCleanupService)),
+		),
+	),
+	fx.Provide(
+		fx.Annotate(
+			NewRecommendationService,
+			fx.As(new(port.RecommendationService)),
+		),
+	),
 )
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// imageOperationsBatchSize is the number of image operations claimed at once.
+	imageOperationsBatchSize = 50
+	// imageOperationLease is how long claimed image operations are hidden from other workers.
+	imageOperationLease = 5 * time.Minute
+)
+
+// ImageCleanupService implements port.ImageCleanupService and removes images that are no longer used from the image storage.
+type ImageCleanupService struct {
+	imageRepository          port.ImageRepository
+	imageOperationRepository port.ImageOperationRepository
+}
+
+// NewImageCleanupService creates a new ImageCleanupService instance.
+func NewImageCleanupService(imageRepository port.ImageRepository, imageOperationRepository port.ImageOperationRepository) *ImageCleanupService {
+	return &ImageCleanupService{
+		imageRepository:          imageRepository,
+		imageOperationRepository: imageOperationRepository,
+	}
+}
+
+func (s *ImageCleanupService) ProcessImageOperations(ctx context.Context) (int, error) {
+	deleted := 0
+	for {
+		operations, err := s.imageOperationRepository.ClaimImageOperations(ctx, imageOperationsBatchSize, imageOperationLease)
+		if err != nil {
+			return deleted, err
+		}
+
+		var used map[string]struct{}
+		if len(operations) > 0 {
+			// Images are fetched as used after claiming, so a product or menu version referring to
+			// an image again since its deletion was scheduled keeps it.
+			if used, err = s.imageOperationRepository.GetUsedImageUrls(ctx); err != nil {
+				return deleted, err
+			}
+		}
+
+		for _, operation := range operations {
+			if operation.IsUsed(used) {
+				zap.L().Info("keeping image that is still used", zap.String("url", operation.Url))
+				if err = s.imageOperationRepository.CompleteImageOperation(ctx, operation.Id); err != nil {
+					return deleted, err
+				}
+				continue
+			}
+
+			if deleteErr := s.imageRepository.DeleteImage(ctx, operation.DeleteUrl); deleteErr != nil {
+				nextAttemptAt := time.Now().Add(operation.RetryDelay())
+				zap.L().Warn(
+					"error deleting image, retrying later",
+					zap.String("url", operation.DeleteUrl),
+					zap.Int("attempts", operation.Attempts),
+					zap.Time("next_attempt_at", nextAttemptAt),
+					zap.Error(deleteErr),
+				)
+
+				if err = s.imageOperationRepository.FailImageOperation(ctx, operation.Id, deleteErr.Error(), nextAttemptAt); err != nil {
+					return deleted, err
+				}
+				continue
+			}
+
+			if err = s.imageOperationRepository.CompleteImageOperation(ctx, operation.Id); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+
+		// Failed operations are postponed by the claim, so a full batch is never claimed twice.
+		if len(operations) < imageOperationsBatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+func (s *ImageCleanupService) ReconcileImages(ctx context.Context) (int, error) {
+	stored, err := s.imageRepository.ListImages(ctx)
+	if errors.Is(err, domain.ErrImageListingUnsupported) {
+		zap.L().Info("skipping image reconciliation, the image storage can't list its images")
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	// The references are fetched after listing the images, so an image linked in between is still seen as used.
+	referenced, err := s.imageOperationRepository.GetReferencedImageUrls(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Recent images may belong to an upload that hasn't been recorded yet.
+	uploadedBefore := time.Now().Add(-domain.PendingUploadTimeout)
+
+	var orphans []domain.Image
+	for _, image := range stored {
+		if image.UploadedAt.After(uploadedBefore) {
+			continue
+		}
+		if _, ok := referenced[image.Url]; ok {
+			continue
+		}
+		if _, ok := referenced[image.DeleteUrl]; ok {
+			continue
+		}
+		orphans = append(orphans, image.Image)
+	}
+
+	if len(orphans) == 0 {
+		return 0, nil
+	}
+
+	operations := domain.NewImageOperations(domain.DeleteImageOperation, orphans, time.Now())
+	if err = s.imageOperationRepository.AddImageOperations(ctx, operations); err != nil {
+		return 0, err
+	}
+	return len(orphans), nil
+}
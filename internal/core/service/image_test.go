@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port/mock"
+	"restaurant/internal/core/service"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImageCleanupService_ProcessImageOperations(t *testing.T) {
+	succeeding := domain.ImageOperation{Id: uuid.New(), Kind: domain.DeleteImageOperation, Url: "old", DeleteUrl: "delete-old", Attempts: 1}
+	failing := domain.ImageOperation{Id: uuid.New(), Kind: domain.UploadImageOperation, Url: "abandoned", DeleteUrl: "delete-abandoned", Attempts: 3}
+	versioned := domain.ImageOperation{Id: uuid.New(), Kind: domain.DeleteImageOperation, Url: "versioned", DeleteUrl: "delete-versioned", Attempts: 1}
+
+	tests := []struct {
+		name            string
+		expectedDeleted int
+		expectedError   error
+		mockSetup       func(imageRepository *mock.MockImageRepository, imageOperationRepository *mock.MockImageOperationRepository)
+	}{
+		{
+			name:            "success reschedules failed deletions",
+			expectedDeleted: 1,
+			mockSetup: func(imageRepository *mock.MockImageRepository, imageOperationRepository *mock.MockImageOperationRepository) {
+				imageOperationRepository.EXPECT().
+					ClaimImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Any(), gomock.Any()).
+					Return([]domain.ImageOperation{succeeding, failing}, nil)
+				imageOperationRepository.EXPECT().
+					GetUsedImageUrls(gomock.AssignableToTypeOf(context.Background())).
+					Return(map[string]struct{}{}, nil)
+				imageRepository.EXPECT().
+					DeleteImage(gomock.AssignableToTypeOf(context.Background()), "delete-old").
+					Return(nil)
+				imageOperationRepository.EXPECT().
+					CompleteImageOperation(gomock.AssignableToTypeOf(context.Background()), succeeding.Id).
+					Return(nil)
+				imageRepository.EXPECT().
+					DeleteImage(gomock.AssignableToTypeOf(context.Background()), "delete-abandoned").
+					Return(domain.ErrInternal)
+				imageOperationRepository.EXPECT().
+					FailImageOperation(gomock.AssignableToTypeOf(context.Background()), failing.Id, domain.ErrInternal.Error(), gomock.AssignableToTypeOf(time.Time{})).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, nextAttemptAt time.Time) error {
+						// The third attempt waits four minutes.
+						require.WithinDuration(t, time.Now().Add(4*time.Minute), nextAttemptAt, time.Minute)
+						return nil
+					})
+			},
+		}, {
+			name: "success keeps images referenced by a version",
+			mockSetup: func(imageRepository *mock.MockImageRepository, imageOperationRepository *mock.MockImageOperationRepository) {
+				imageOperationRepository.EXPECT().
+					ClaimImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Any(), gomock.Any()).
+					Return([]domain.ImageOperation{versioned}, nil)
+				imageOperationRepository.EXPECT().
+					GetUsedImageUrls(gomock.AssignableToTypeOf(context.Background())).
+					Return(map[string]struct{}{"versioned": {}}, nil)
+				imageOperationRepository.EXPECT().
+					CompleteImageOperation(gomock.AssignableToTypeOf(context.Background()), versioned.Id).
+					Return(nil)
+			},
+		}, {
+			name: "success nothing due",
+			mockSetup: func(imageRepository *mock.MockImageRepository, imageOperationRepository *mock.MockImageOperationRepository) {
+				imageOperationRepository.EXPECT().
+					ClaimImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+			},
+		}, {
+			name:          "error claiming",
+			expectedError: domain.ErrInternal,
+			mockSetup: func(imageRepository *mock.MockImageRepository, imageOperationRepository *mock.MockImageOperationRepository) {
+				imageOperationRepository.EXPECT().
+					ClaimImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInternal)
+			},
+		}, {
+			name:          "error getting used images",
+			expectedError: domain.ErrInternal,
+			mockSetup: func(imageRepository *mock.MockImageRepository, imageOperationRepository *mock.MockImageOperationRepository) {
+				imageOperationRepository.EXPECT().
+					ClaimImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Any(), gomock.Any()).
+					Return([]domain.ImageOperation{succeeding}, nil)
+				imageOperationRepository.EXPECT().
+					GetUsedImageUrls(gomock.AssignableToTypeOf(context.Background())).
+					Return(nil, domain.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			imageRepository := mock.NewMockImageRepository(ctrl)
+			imageOperationRepository := mock.NewMockImageOperationRepository(ctrl)
+			tt.mockSetup(imageRepository, imageOperationRepository)
+
+			deleted, err := service.NewImageCleanupService(imageRepository, imageOperationRepository).
+				ProcessImageOperations(context.Background())
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedDeleted, deleted)
+		})
+	}
+}
+
+func TestImageCleanupService_ReconcileImages(t *testing.T) {
+	old := time.Now().Add(-time.Hour)
+	stored := []domain.StoredImage{
+		{Image: domain.Image{Url: "used", DeleteUrl: "used"}, UploadedAt: old},
+		{Image: domain.Image{Url: "orphan", DeleteUrl: "delete-orphan"}, UploadedAt: old},
+		{Image: domain.Image{Url: "recent", DeleteUrl: "delete-recent"}, UploadedAt: time.Now()},
+	}
+
+	tests := []struct {
+		name            string
+		expectedOrphans int
+		expectedError   error
+		mockSetup       func(imageRepository *mock.MockImageRepository, imageOperationRepository *mock.MockImageOperationRepository)
+	}{
+		{
+			name:            "success schedules unreferenced old images",
+			expectedOrphans: 1,
+			mockSetup: func(imageRepository *mock.MockImageRepository, imageOperationRepository *mock.MockImageOperationRepository) {
+				imageRepository.EXPECT().
+					ListImages(gomock.AssignableToTypeOf(context.Background())).
+					Return(stored, nil)
+				imageOperationRepository.EXPECT().
+					GetReferencedImageUrls(gomock.AssignableToTypeOf(context.Background())).
+					Return(map[string]struct{}{"used": {}}, nil)
+				imageOperationRepository.EXPECT().
+					AddImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Len(1)).
+					DoAndReturn(func(_ context.Context, operations []domain.ImageOperation) error {
+						require.Equal(t, domain.DeleteImageOperation, operations[0].Kind)
+						require.Equal(t, "orphan", operations[0].Url)
+						require.Equal(t, "delete-orphan", operations[0].DeleteUrl)
+						return nil
+					})
+			},
+		}, {
+			name: "success nothing to schedule",
+			mockSetup: func(imageRepository *mock.MockImageRepository, imageOperationRepository *mock.MockImageOperationRepository) {
+				imageRepository.EXPECT().
+					ListImages(gomock.AssignableToTypeOf(context.Background())).
+					Return(stored, nil)
+				imageOperationRepository.EXPECT().
+					GetReferencedImageUrls(gomock.AssignableToTypeOf(context.Background())).
+					Return(map[string]struct{}{"used": {}, "orphan": {}}, nil)
+			},
+		}, {
+			name: "success listing unsupported",
+			mockSetup: func(imageRepository *mock.MockImageRepository, imageOperationRepository *mock.MockImageOperationRepository) {
+				imageRepository.EXPECT().
+					ListImages(gomock.AssignableToTypeOf(context.Background())).
+					Return(nil, domain.ErrImageListingUnsupported)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			imageRepository := mock.NewMockImageRepository(ctrl)
+			imageOperationRepository := mock.NewMockImageOperationRepository(ctrl)
+			tt.mockSetup(imageRepository, imageOperationRepository)
+
+			orphans, err := service.NewImageCleanupService(imageRepository, imageOperationRepository).
+				ReconcileImages(context.Background())
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedOrphans, orphans)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port"
+
+	"github.com/google/uuid"
+)
+
+// InventoryService implements port.InventoryService and provides access to inventory-related business logic.
+type InventoryService struct {
+	inventoryRepository port.InventoryRepository
+	menuCache           port.MenuCacheRepository
+	menuNotifier        port.MenuNotifier
+}
+
+// NewInventoryService creates a new InventoryService instance.
+func NewInventoryService(
+	inventoryRepository port.InventoryRepository,
+	menuCache port.MenuCacheRepository,
+	menuNotifier port.MenuNotifier,
+) *InventoryService {
+	return &InventoryService{
+		inventoryRepository: inventoryRepository,
+		menuCache:           menuCache,
+		menuNotifier:        menuNotifier,
+	}
+}
+
+// notifyAvailabilityChanges drops the cached listings, which show the availability of the products,
+// and announces the changes to all clients.
+func notifyAvailabilityChanges(menuCache port.MenuCacheRepository, menuNotifier port.MenuNotifier, changes []domain.AvailabilityChange) {
+	if len(changes) > 0 {
+		menuCache.Invalidate()
+	}
+	for _, change := range changes {
+		menuNotifier.NotifyAvailabilityChanged(change.ProductId, change.Available)
+	}
+}
+
+func (s *InventoryService) AddIngredient(ctx context.Context, dto *domain.AddIngredientDTO) (*domain.Ingredient, error) {
+	ingredient := domain.NewIngredient(uuid.New(), dto.Name, dto.Unit, dto.OnHand)
+	if err := s.inventoryRepository.AddIngredient(ctx, ingredient); err != nil {
+		return nil, err
+	}
+	return ingredient, nil
+}
+
+func (s *InventoryService) GetIngredients(ctx context.Context) ([]domain.Ingredient, error) {
+	return s.inventoryRepository.GetIngredients(ctx)
+}
+
+func (s *InventoryService) DeleteIngredient(ctx context.Context, id uuid.UUID) error {
+	return s.inventoryRepository.DeleteIngredient(ctx, id)
+}
+
+func (s *InventoryService) AdjustStock(ctx context.Context, dto *domain.StockAdjustmentDTO) (*domain.Ingredient, error) {
+	if dto.Delta.IsZero() {
+		return nil, domain.ErrNothingToUpdate
+	}
+
+	ingredient, changes, err := s.inventoryRepository.AdjustStock(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyAvailabilityChanges(s.menuCache, s.menuNotifier, changes)
+	return ingredient, nil
+}
+
+func (s *InventoryService) CountStock(ctx context.Context, dto *domain.StockCountDTO) (*domain.Ingredient, error) {
+	if dto.Counted.IsNegative() {
+		return nil, domain.ErrInvalidStockCount
+	}
+
+	ingredient, changes, err := s.inventoryRepository.CountStock(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyAvailabilityChanges(s.menuCache, s.menuNotifier, changes)
+	return ingredient, nil
+}
+
+func (s *InventoryService) SetRecipe(ctx context.Context, productId uuid.UUID, items []domain.RecipeItem) error {
+	for i := range items {
+		items[i].ProductId = productId
+	}
+	return s.inventoryRepository.SetRecipe(ctx, productId, items)
+}
+
+func (s *InventoryService) GetRecipe(ctx context.Context, productId uuid.UUID) ([]domain.RecipeItem, error) {
+	return s.inventoryRepository.GetRecipe(ctx, productId)
+}
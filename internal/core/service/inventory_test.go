@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port/mock"
+	"restaurant/internal/core/service"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInventoryService_AdjustStock(t *testing.T) {
+	soldOutProductId := uuid.New()
+
+	tests := []struct {
+		name          string
+		delta         decimal.Decimal
+		expectedError error
+		mockSetup     func(
+			inventoryRepository *mock.MockInventoryRepository,
+			menuCache *mock.MockMenuCacheRepository,
+			menuNotifier *mock.MockMenuNotifier,
+		)
+	}{
+		{
+			name:  "success",
+			delta: decimal.NewFromInt(5),
+			mockSetup: func(
+				inventoryRepository *mock.MockInventoryRepository,
+				_ *mock.MockMenuCacheRepository,
+				_ *mock.MockMenuNotifier,
+			) {
+				inventoryRepository.EXPECT().
+					AdjustStock(gomock.Any(), gomock.AssignableToTypeOf(&domain.StockAdjustmentDTO{})).
+					Return(&domain.Ingredient{}, nil, nil)
+			},
+		},
+		{
+			name:  "announces products that ran out of stock",
+			delta: decimal.NewFromInt(-5),
+			mockSetup: func(
+				inventoryRepository *mock.MockInventoryRepository,
+				menuCache *mock.MockMenuCacheRepository,
+				menuNotifier *mock.MockMenuNotifier,
+			) {
+				inventoryRepository.EXPECT().
+					AdjustStock(gomock.Any(), gomock.AssignableToTypeOf(&domain.StockAdjustmentDTO{})).
+					Return(&domain.Ingredient{}, []domain.AvailabilityChange{{ProductId: soldOutProductId}}, nil)
+				menuCache.EXPECT().Invalidate()
+				menuNotifier.EXPECT().NotifyAvailabilityChanged(soldOutProductId, false)
+			},
+		},
+		{
+			name:          "stock would go negative",
+			delta:         decimal.NewFromInt(-50),
+			expectedError: domain.ErrInsufficientStock,
+			mockSetup: func(
+				inventoryRepository *mock.MockInventoryRepository,
+				_ *mock.MockMenuCacheRepository,
+				_ *mock.MockMenuNotifier,
+			) {
+				inventoryRepository.EXPECT().
+					AdjustStock(gomock.Any(), gomock.AssignableToTypeOf(&domain.StockAdjustmentDTO{})).
+					Return(nil, nil, domain.ErrInsufficientStock)
+			},
+		},
+		{
+			name:          "nothing to update",
+			delta:         decimal.Zero,
+			expectedError: domain.ErrNothingToUpdate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			inventoryRepository := mock.NewMockInventoryRepository(ctrl)
+			menuCache := mock.NewMockMenuCacheRepository(ctrl)
+			menuNotifier := mock.NewMockMenuNotifier(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(inventoryRepository, menuCache, menuNotifier)
+			}
+
+			_, err := service.NewInventoryService(inventoryRepository, menuCache, menuNotifier).
+				AdjustStock(context.Background(), domain.NewStockAdjustmentDTO(uuid.New(), tt.delta, nil))
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestInventoryService_CountStock(t *testing.T) {
+	restockedProductId := uuid.New()
+
+	tests := []struct {
+		name          string
+		counted       decimal.Decimal
+		expectedError error
+		mockSetup     func(
+			inventoryRepository *mock.MockInventoryRepository,
+			menuCache *mock.MockMenuCacheRepository,
+			menuNotifier *mock.MockMenuNotifier,
+		)
+	}{
+		{
+			name:    "announces products that are back in stock",
+			counted: decimal.NewFromInt(10),
+			mockSetup: func(
+				inventoryRepository *mock.MockInventoryRepository,
+				menuCache *mock.MockMenuCacheRepository,
+				menuNotifier *mock.MockMenuNotifier,
+			) {
+				inventoryRepository.EXPECT().
+					CountStock(gomock.Any(), gomock.AssignableToTypeOf(&domain.StockCountDTO{})).
+					Return(&domain.Ingredient{}, []domain.AvailabilityChange{{ProductId: restockedProductId, Available: true}}, nil)
+				menuCache.EXPECT().Invalidate()
+				menuNotifier.EXPECT().NotifyAvailabilityChanged(restockedProductId, true)
+			},
+		},
+		{
+			name:          "ingredient not found",
+			counted:       decimal.Zero,
+			expectedError: domain.ErrIngredientNotFound,
+			mockSetup: func(
+				inventoryRepository *mock.MockInventoryRepository,
+				_ *mock.MockMenuCacheRepository,
+				_ *mock.MockMenuNotifier,
+			) {
+				inventoryRepository.EXPECT().
+					CountStock(gomock.Any(), gomock.AssignableToTypeOf(&domain.StockCountDTO{})).
+					Return(nil, nil, domain.ErrIngredientNotFound)
+			},
+		},
+		{
+			name:          "negative count",
+			counted:       decimal.NewFromInt(-1),
+			expectedError: domain.ErrInvalidStockCount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			inventoryRepository := mock.NewMockInventoryRepository(ctrl)
+			menuCache := mock.NewMockMenuCacheRepository(ctrl)
+			menuNotifier := mock.NewMockMenuNotifier(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(inventoryRepository, menuCache, menuNotifier)
+			}
+
+			_, err := service.NewInventoryService(inventoryRepository, menuCache, menuNotifier).
+				CountStock(context.Background(), domain.NewStockCountDTO(uuid.New(), tt.counted, nil))
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
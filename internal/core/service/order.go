@@ -4,24 +4,73 @@ import (
 	"context"
 	"restaurant/internal/core/domain"
 	"restaurant/internal/core/port"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // OrderService implements port.OrderService and provided access to orders-related business logic
 type OrderService struct {
-	orderRepository port.OrderRepository
+	orderRepository   port.OrderRepository
+	productRepository port.ProductRepository
+	bundleRepository  port.BundleRepository
+	menuCache         port.MenuCacheRepository
+	menuNotifier      port.MenuNotifier
+	clock             port.Clock
 }
 
 // NewOrderService creates new OrderService interface.
-func NewOrderService(orderRepository port.OrderRepository) *OrderService {
+func NewOrderService(
+	orderRepository port.OrderRepository,
+	productRepository port.ProductRepository,
+	bundleRepository port.BundleRepository,
+	menuCache port.MenuCacheRepository,
+	menuNotifier port.MenuNotifier,
+	clock port.Clock,
+) *OrderService {
 	return &OrderService{
-		orderRepository: orderRepository,
+		orderRepository:   orderRepository,
+		productRepository: productRepository,
+		bundleRepository:  bundleRepository,
+		menuCache:         menuCache,
+		menuNotifier:      menuNotifier,
+		clock:             clock,
 	}
 }
 
-func (s *OrderService) GetSessions(ctx context.Context) ([]domain.OrderSession, error) {
-	return s.orderRepository.GetSessions(ctx)
+// validateOrdersLimit checks the requested page size and returns it, or the default one when none is requested.
+func validateOrdersLimit(limit int) (int, error) {
+	if limit == 0 {
+		return domain.DefaultOrdersLimit, nil
+	}
+	if limit < 0 || limit > domain.MaxOrdersLimit {
+		return 0, domain.ErrInvalidPagination
+	}
+	return limit, nil
+}
+
+func (s *OrderService) GetSessions(ctx context.Context, dto *domain.GetSessionsDTO) (*domain.OrderSessionPage, error) {
+	limit, err := validateOrdersLimit(dto.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// One more session than requested is fetched to know whether there is a next page.
+	query := *dto
+	query.Limit = limit + 1
+	sessions, err := s.orderRepository.GetSessions(ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.OrderSessionPage{Sessions: sessions}
+	if len(sessions) > limit {
+		page.Sessions = sessions[:limit]
+		last := page.Sessions[limit-1]
+		page.NextCursor = domain.NewCursor(last.CreatedAt, last.Id)
+	}
+	return page, nil
 }
 
 func (s *OrderService) CreateSession(ctx context.Context) (*domain.OrderSession, error) {
@@ -52,8 +101,27 @@ func (s *OrderService) UpdateSession(ctx context.Context, session *domain.Update
 func (s *OrderService) DeleteSession(ctx context.Context, id uuid.UUID) error {
 	return s.orderRepository.DeleteSession(ctx, id)
 }
-func (s *OrderService) GetOrderedProducts(ctx context.Context) ([]domain.OrderedProduct, error) {
-	return s.orderRepository.GetOrderedProducts(ctx)
+func (s *OrderService) GetOrderedProducts(ctx context.Context, dto *domain.GetOrderedProductsDTO) (*domain.OrderedProductPage, error) {
+	limit, err := validateOrdersLimit(dto.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// One more ordered product than requested is fetched to know whether there is a next page.
+	query := *dto
+	query.Limit = limit + 1
+	products, err := s.orderRepository.GetOrderedProducts(ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.OrderedProductPage{OrderedProducts: products}
+	if len(products) > limit {
+		page.OrderedProducts = products[:limit]
+		last := page.OrderedProducts[limit-1]
+		page.NextCursor = domain.NewCursor(last.CreatedAt, last.Id)
+	}
+	return page, nil
 }
 
 func (s *OrderService) ValidateSession(ctx context.Context, sessionId uuid.UUID) error {
@@ -68,16 +136,214 @@ func (s *OrderService) ValidateSession(ctx context.Context, sessionId uuid.UUID)
 	return nil
 }
 
-func (s *OrderService) OrderProduct(ctx context.Context, productId uuid.UUID, sessionId uuid.UUID) (*domain.OrderedProduct, error) {
-	if err := s.ValidateSession(ctx, sessionId); err != nil {
+// selectModifierOptions resolves the selected option ids against the modifier groups of the product
+// and checks that the selection limits of every group are respected.
+func selectModifierOptions(product *domain.Product, optionIds []uuid.UUID) ([]domain.ModifierOption, error) {
+	selected := make(map[uuid.UUID]struct{}, len(optionIds))
+	for _, id := range optionIds {
+		selected[id] = struct{}{}
+	}
+	if len(selected) != len(optionIds) {
+		return nil, domain.ErrInvalidModifierSelection
+	}
+
+	options := make([]domain.ModifierOption, 0, len(optionIds))
+	for _, group := range product.ModifierGroups {
+		count := 0
+		for _, option := range group.Options {
+			if _, ok := selected[option.Id]; ok {
+				options = append(options, option)
+				count++
+			}
+		}
+
+		if count < group.MinSelected || count > group.MaxSelected {
+			return nil, domain.ErrInvalidModifierSelection
+		}
+	}
+
+	if len(options) != len(selected) {
+		return nil, domain.ErrInvalidModifierSelection
+	}
+	return options, nil
+}
+
+// orderableProduct fetches a product and checks that it can be ordered at the moment.
+func (s *OrderService) orderableProduct(ctx context.Context, productId uuid.UUID, now time.Time) (*domain.Product, error) {
+	product, err := s.productRepository.GetProductById(ctx, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.ArchivedAt != nil || product.CategoryArchivedAt != nil {
+		return nil, domain.ErrProductArchived
+	}
+
+	if !product.Available {
+		return nil, domain.ErrProductUnavailable
+	}
+
+	for _, schedule := range [][]domain.ScheduleWindow{product.Schedule, product.CategorySchedule} {
+		withinSchedule, err := domain.IsWithinSchedule(schedule, now)
+		if err != nil {
+			return nil, err
+		}
+		if !withinSchedule {
+			return nil, domain.ErrProductOutOfSchedule
+		}
+	}
+	return product, nil
+}
+
+// newOrderedProduct validates the quantity, the product and the selected options
+// and creates a pending ordered product for the session.
+func (s *OrderService) newOrderedProduct(ctx context.Context, dto *domain.OrderProductDTO) (*domain.OrderedProduct, error) {
+	if dto.Quantity < 1 {
+		return nil, domain.ErrInvalidQuantity
+	}
+
+	now := s.clock.Now()
+	product, err := s.orderableProduct(ctx, dto.ProductId, now)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := selectModifierOptions(product, dto.OptionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.ActivePricingRule, err = domain.BestPricingRule(product.PricingRules, product.Price, now); err != nil {
+		return nil, err
+	}
+	var pricingRuleName *string
+	if product.ActivePricingRule != nil {
+		pricingRuleName = &product.ActivePricingRule.Name
+	}
+
+	return domain.NewOrderedProduct(
+		uuid.New(),
+		dto.ProductId,
+		dto.SessionId,
+		domain.Pending,
+		options,
+		dto.Quantity,
+		dto.Note,
+		product.Name,
+		product.CurrentPrice(),
+		pricingRuleName,
+	), nil
+}
+
+func (s *OrderService) OrderProduct(ctx context.Context, dto *domain.OrderProductDTO) (*domain.OrderedProduct, error) {
+	if err := s.ValidateSession(ctx, dto.SessionId); err != nil {
+		return nil, err
+	}
+
+	orderedProduct, err := s.newOrderedProduct(ctx, dto)
+	if err != nil {
 		return nil, err
 	}
 
-	id := uuid.New()
-	orderedProduct := domain.NewOrderedProduct(id, productId, sessionId, domain.Pending)
 	return orderedProduct, s.orderRepository.AddOrderedProduct(ctx, orderedProduct)
 }
 
+func (s *OrderService) OrderBundle(ctx context.Context, dto *domain.OrderBundleDTO) (*domain.OrderedBundle, error) {
+	if err := s.ValidateSession(ctx, dto.SessionId); err != nil {
+		return nil, err
+	}
+
+	if dto.Quantity < 1 {
+		return nil, domain.ErrInvalidQuantity
+	}
+
+	bundle, err := s.bundleRepository.GetBundleById(ctx, dto.BundleId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bundle.Available {
+		return nil, domain.ErrBundleUnavailable
+	}
+
+	choices := make(map[uuid.UUID]domain.BundleChoiceDTO, len(dto.Choices))
+	for _, choice := range dto.Choices {
+		choices[choice.SlotId] = choice
+	}
+	if len(choices) != len(dto.Choices) || len(choices) != len(bundle.Slots) {
+		return nil, domain.ErrInvalidBundleSelection
+	}
+
+	orderedBundle := domain.NewOrderedBundle(uuid.New(), bundle.Id, dto.SessionId, bundle.Name, bundle.Price, dto.Quantity, nil)
+	now := s.clock.Now()
+	for _, slot := range bundle.Slots {
+		choice, ok := choices[slot.Id]
+		if !ok {
+			return nil, domain.ErrInvalidBundleSelection
+		}
+
+		product, err := s.orderableProduct(ctx, choice.ProductId, now)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slot.Allows(product) {
+			return nil, domain.ErrInvalidBundleSelection
+		}
+
+		options, err := selectModifierOptions(product, choice.OptionIds)
+		if err != nil {
+			return nil, err
+		}
+
+		component := domain.NewOrderedProduct(
+			uuid.New(),
+			product.Id,
+			dto.SessionId,
+			domain.Pending,
+			options,
+			dto.Quantity,
+			choice.Note,
+			product.Name,
+			decimal.Zero,
+			nil,
+		)
+		component.OrderedBundleId = &orderedBundle.Id
+		orderedBundle.Components = append(orderedBundle.Components, *component)
+	}
+
+	if err = s.orderRepository.AddOrderedBundle(ctx, orderedBundle); err != nil {
+		return nil, err
+	}
+	return orderedBundle, nil
+}
+
+func (s *OrderService) SubmitCart(ctx context.Context, sessionId uuid.UUID, items []domain.OrderProductDTO) ([]domain.OrderedProduct, error) {
+	if len(items) == 0 {
+		return nil, domain.ErrEmptyCart
+	}
+
+	if err := s.ValidateSession(ctx, sessionId); err != nil {
+		return nil, err
+	}
+
+	orderedProducts := make([]domain.OrderedProduct, 0, len(items))
+	for _, item := range items {
+		// Items are ordered into the session that was validated, whatever session they name.
+		item.SessionId = sessionId
+		orderedProduct, err := s.newOrderedProduct(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		orderedProducts = append(orderedProducts, *orderedProduct)
+	}
+
+	if err := s.orderRepository.AddOrderedProducts(ctx, orderedProducts); err != nil {
+		return nil, err
+	}
+	return orderedProducts, nil
+}
+
 func (s *OrderService) DeleteOrderedProduct(ctx context.Context, productId uuid.UUID, isPrivilegedCall bool) (orderedProduct *domain.OrderedProduct, err error) {
 	if isPrivilegedCall {
 		orderedProduct, err = s.orderRepository.DeleteOrderedProduct(ctx, productId)
@@ -88,7 +354,13 @@ func (s *OrderService) DeleteOrderedProduct(ctx context.Context, productId uuid.
 }
 
 func (s *OrderService) UpdateOrderedProductStatus(ctx context.Context, id uuid.UUID, status domain.OrderedProductStatus) (*domain.OrderedProduct, error) {
-	return s.orderRepository.UpdateOrderedProductStatus(ctx, id, status)
+	orderedProduct, changes, err := s.orderRepository.UpdateOrderedProductStatus(ctx, id, status)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyAvailabilityChanges(s.menuCache, s.menuNotifier, changes)
+	return orderedProduct, nil
 }
 
 func (s *OrderService) GetBill(ctx context.Context, sessionId uuid.UUID) (*domain.Bill, error) {
@@ -120,7 +392,7 @@ func (s *OrderService) PayBill(ctx context.Context, sessionId uuid.UUID) error {
 		return domain.ErrProductsAreIncomplete
 	}
 
-	if err = s.orderRepository.DeleteOrderedProductsBySessionId(ctx, sessionId); err != nil {
+	if err = s.orderRepository.MoveOrderedProductsToHistory(ctx, sessionId); err != nil {
 		return err
 	}
 
@@ -6,8 +6,10 @@ import (
 	"restaurant/internal/core/port/mock"
 	"restaurant/internal/core/service"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -17,17 +19,17 @@ func TestOrderService_UpdateSession(t *testing.T) {
 		name          string
 		expectedError error
 		update        *domain.UpdateOrderSessionDTO
-		mockSetup     func(orderRepository *mock.MockOrderRepository)
+		mockSetup     func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository)
 	}{
 		{
 			name:   "success",
 			update: domain.NewUpdateOrderSessionDTO(uuid.Nil, new(int), new(domain.OrderSessionStatus)),
-			mockSetup: func(orderRepository *mock.MockOrderRepository) {
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
 				orderRepository.EXPECT().
 					UpdateSession(
 						gomock.AssignableToTypeOf(context.Background()),
 						gomock.AssignableToTypeOf(&domain.UpdateOrderSessionDTO{}),
-					).Return(nil)
+					).Return(nil, nil)
 			},
 		},
 		{
@@ -41,12 +43,895 @@ func TestOrderService_UpdateSession(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			orderRepository := mock.NewMockOrderRepository(ctrl)
+			productRepository := mock.NewMockProductRepository(ctrl)
 			if tt.mockSetup != nil {
-				tt.mockSetup(orderRepository)
+				tt.mockSetup(orderRepository, productRepository)
+			}
+
+			_, err := service.NewOrderService(orderRepository, productRepository, mock.NewMockBundleRepository(ctrl), mock.NewMockMenuCacheRepository(ctrl), mock.NewMockMenuNotifier(ctrl), fixedClock(testNow)).UpdateSession(context.Background(), tt.update)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestOrderService_GetSessions(t *testing.T) {
+	sessions := []domain.OrderSession{
+		{Id: uuid.New(), CreatedAt: time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC)},
+		{Id: uuid.New(), CreatedAt: time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{Id: uuid.New(), CreatedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name               string
+		limit              int
+		sessions           []domain.OrderSession
+		expectedLimit      int
+		expectedSessions   []domain.OrderSession
+		expectedNextCursor *domain.Cursor
+		expectedError      error
+	}{
+		{
+			name:             "default limit",
+			sessions:         sessions,
+			expectedLimit:    domain.DefaultOrdersLimit + 1,
+			expectedSessions: sessions,
+		},
+		{
+			name:               "more sessions have next cursor",
+			limit:              2,
+			sessions:           sessions,
+			expectedLimit:      3,
+			expectedSessions:   sessions[:2],
+			expectedNextCursor: domain.NewCursor(sessions[1].CreatedAt, sessions[1].Id),
+		},
+		{
+			name:             "exactly full last page has no next cursor",
+			limit:            3,
+			sessions:         sessions,
+			expectedLimit:    4,
+			expectedSessions: sessions,
+		},
+		{
+			name:          "negative limit",
+			limit:         -1,
+			expectedError: domain.ErrInvalidPagination,
+		},
+		{
+			name:          "limit too large",
+			limit:         domain.MaxOrdersLimit + 1,
+			expectedError: domain.ErrInvalidPagination,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderRepository := mock.NewMockOrderRepository(ctrl)
+			if tt.expectedError == nil {
+				orderRepository.EXPECT().
+					GetSessions(gomock.Any(), gomock.AssignableToTypeOf(&domain.GetSessionsDTO{})).
+					DoAndReturn(func(_ context.Context, dto *domain.GetSessionsDTO) ([]domain.OrderSession, error) {
+						require.Equal(t, tt.expectedLimit, dto.Limit)
+						return tt.sessions, nil
+					})
 			}
 
-			err := service.NewOrderService(orderRepository).UpdateSession(context.Background(), tt.update)
+			dto := domain.NewGetSessionsDTO(nil, nil, nil, nil, nil, tt.limit)
+			page, err := service.NewOrderService(orderRepository, mock.NewMockProductRepository(ctrl), mock.NewMockBundleRepository(ctrl), mock.NewMockMenuCacheRepository(ctrl), mock.NewMockMenuNotifier(ctrl), fixedClock(testNow)).
+				GetSessions(context.Background(), dto)
 			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.limit, dto.Limit)
+			if tt.expectedError == nil {
+				require.Equal(t, tt.expectedSessions, page.Sessions)
+				require.Equal(t, tt.expectedNextCursor, page.NextCursor)
+			}
 		})
 	}
 }
+
+func TestOrderService_GetOrderedProducts(t *testing.T) {
+	sessionId := uuid.New()
+	status := domain.Pending
+	products := []domain.OrderedProduct{
+		{Id: uuid.New(), CreatedAt: time.Date(2024, time.January, 1, 12, 2, 0, 0, time.UTC)},
+		{Id: uuid.New(), CreatedAt: time.Date(2024, time.January, 1, 12, 1, 0, 0, time.UTC)},
+		{Id: uuid.New(), CreatedAt: time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name               string
+		limit              int
+		products           []domain.OrderedProduct
+		expectedLimit      int
+		expectedProducts   []domain.OrderedProduct
+		expectedNextCursor *domain.Cursor
+		expectedError      error
+	}{
+		{
+			name:             "default limit",
+			products:         products,
+			expectedLimit:    domain.DefaultOrdersLimit + 1,
+			expectedProducts: products,
+		},
+		{
+			name:               "more ordered products have next cursor",
+			limit:              1,
+			products:           products[:2],
+			expectedLimit:      2,
+			expectedProducts:   products[:1],
+			expectedNextCursor: domain.NewCursor(products[0].CreatedAt, products[0].Id),
+		},
+		{
+			name:             "exactly full last page has no next cursor",
+			limit:            3,
+			products:         products,
+			expectedLimit:    4,
+			expectedProducts: products,
+		},
+		{
+			name:             "empty page",
+			limit:            2,
+			expectedLimit:    3,
+			expectedProducts: nil,
+		},
+		{
+			name:          "limit too large",
+			limit:         domain.MaxOrdersLimit + 1,
+			expectedError: domain.ErrInvalidPagination,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderRepository := mock.NewMockOrderRepository(ctrl)
+			if tt.expectedError == nil {
+				orderRepository.EXPECT().
+					GetOrderedProducts(gomock.Any(), gomock.AssignableToTypeOf(&domain.GetOrderedProductsDTO{})).
+					DoAndReturn(func(_ context.Context, dto *domain.GetOrderedProductsDTO) ([]domain.OrderedProduct, error) {
+						require.Equal(t, tt.expectedLimit, dto.Limit)
+						require.Equal(t, &status, dto.Status)
+						require.Equal(t, &sessionId, dto.SessionId)
+						return tt.products, nil
+					})
+			}
+
+			dto := domain.NewGetOrderedProductsDTO(&status, &sessionId, nil, nil, nil, nil, tt.limit)
+			page, err := service.NewOrderService(orderRepository, mock.NewMockProductRepository(ctrl), mock.NewMockBundleRepository(ctrl), mock.NewMockMenuCacheRepository(ctrl), mock.NewMockMenuNotifier(ctrl), fixedClock(testNow)).
+				GetOrderedProducts(context.Background(), dto)
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.limit, dto.Limit)
+			if tt.expectedError == nil {
+				require.Equal(t, tt.expectedProducts, page.OrderedProducts)
+				require.Equal(t, tt.expectedNextCursor, page.NextCursor)
+			}
+		})
+	}
+}
+
+func TestOrderService_OrderProduct(t *testing.T) {
+	doneness := domain.ModifierGroup{
+		Id:          uuid.New(),
+		Name:        "Doneness",
+		MinSelected: 1,
+		MaxSelected: 1,
+		Options: []domain.ModifierOption{
+			{Id: uuid.New(), Name: "Rare"},
+			{Id: uuid.New(), Name: "Well done"},
+		},
+	}
+	product := &domain.Product{
+		Id:             uuid.New(),
+		Name:           "Beef Steak",
+		Price:          decimal.NewFromFloat(22.5),
+		Available:      true,
+		ModifierGroups: []domain.ModifierGroup{doneness},
+	}
+
+	openWindow := *domain.NewScheduleWindow(testNow.Weekday(), "11:00", "13:00")
+	closedWindow := *domain.NewScheduleWindow(testNow.Weekday(), "13:00", "11:00")
+
+	tests := []struct {
+		name          string
+		optionIds     []uuid.UUID
+		quantity      int
+		expectedError error
+		mockSetup     func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository)
+	}{
+		{
+			name:      "success",
+			optionIds: []uuid.UUID{doneness.Options[0].Id},
+			quantity:  2,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				gomock.InOrder(
+					orderRepository.EXPECT().
+						GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+						Return(&domain.OrderSession{Status: domain.Open}, nil),
+					productRepository.EXPECT().
+						GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+						Return(product, nil),
+					orderRepository.EXPECT().
+						AddOrderedProduct(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.OrderedProduct{})).
+						DoAndReturn(func(_ context.Context, orderedProduct *domain.OrderedProduct) error {
+							require.Equal(t, product.Name, orderedProduct.ProductName)
+							require.True(t, product.Price.Equal(orderedProduct.UnitPrice))
+							return nil
+						}),
+				)
+			},
+		},
+		{
+			name:      "success with active pricing rule",
+			optionIds: []uuid.UUID{doneness.Options[0].Id},
+			quantity:  1,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				discounted := *product
+				discounted.PricingRules = []domain.PricingRule{
+					*domain.NewPricingRule(uuid.New(), "Happy Hour", &product.Id, nil, domain.PercentageDiscount, decimal.NewFromInt(20), []domain.ScheduleWindow{openWindow}),
+					*domain.NewPricingRule(uuid.New(), "Lunch", &product.Id, nil, domain.FixedPrice, decimal.NewFromInt(10), []domain.ScheduleWindow{closedWindow}),
+				}
+				gomock.InOrder(
+					orderRepository.EXPECT().
+						GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+						Return(&domain.OrderSession{Status: domain.Open}, nil),
+					productRepository.EXPECT().
+						GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+						Return(&discounted, nil),
+					orderRepository.EXPECT().
+						AddOrderedProduct(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.OrderedProduct{})).
+						DoAndReturn(func(_ context.Context, orderedProduct *domain.OrderedProduct) error {
+							require.True(t, decimal.NewFromInt(18).Equal(orderedProduct.UnitPrice))
+							require.NotNil(t, orderedProduct.PricingRuleName)
+							require.Equal(t, "Happy Hour", *orderedProduct.PricingRuleName)
+							return nil
+						}),
+				)
+			},
+		},
+		{
+			name:          "required group not selected",
+			quantity:      1,
+			expectedError: domain.ErrInvalidModifierSelection,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+					Return(product, nil)
+			},
+		},
+		{
+			name:          "too many options selected",
+			quantity:      1,
+			optionIds:     []uuid.UUID{doneness.Options[0].Id, doneness.Options[1].Id},
+			expectedError: domain.ErrInvalidModifierSelection,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+					Return(product, nil)
+			},
+		},
+		{
+			name:          "unknown option",
+			quantity:      1,
+			optionIds:     []uuid.UUID{doneness.Options[0].Id, uuid.New()},
+			expectedError: domain.ErrInvalidModifierSelection,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+					Return(product, nil)
+			},
+		},
+		{
+			name:          "archived product",
+			quantity:      1,
+			expectedError: domain.ErrProductArchived,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				archivedAt := time.Now()
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+					Return(&domain.Product{Id: product.Id, ArchivedAt: &archivedAt}, nil)
+			},
+		},
+		{
+			name:          "product in archived category",
+			quantity:      1,
+			expectedError: domain.ErrProductArchived,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				archivedAt := time.Now()
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+					Return(&domain.Product{Id: product.Id, Available: true, CategoryArchivedAt: &archivedAt}, nil)
+			},
+		},
+		{
+			name:          "unavailable product",
+			quantity:      1,
+			expectedError: domain.ErrProductUnavailable,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+					Return(&domain.Product{Id: product.Id, Available: false}, nil)
+			},
+		},
+		{
+			name:          "ordered outside product schedule",
+			quantity:      1,
+			expectedError: domain.ErrProductOutOfSchedule,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+					Return(&domain.Product{Id: product.Id, Available: true, Schedule: []domain.ScheduleWindow{closedWindow}}, nil)
+			},
+		},
+		{
+			name:          "ordered outside category schedule",
+			quantity:      1,
+			expectedError: domain.ErrProductOutOfSchedule,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+					Return(&domain.Product{
+						Id:               product.Id,
+						Available:        true,
+						Schedule:         []domain.ScheduleWindow{openWindow},
+						CategorySchedule: []domain.ScheduleWindow{closedWindow},
+					}, nil)
+			},
+		},
+		{
+			name:          "malformed schedule",
+			quantity:      1,
+			expectedError: domain.ErrInvalidScheduleWindow,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+					Return(&domain.Product{
+						Id:        product.Id,
+						Available: true,
+						Schedule:  []domain.ScheduleWindow{*domain.NewScheduleWindow(time.Monday, "25:00", "11:00")},
+					}, nil)
+			},
+		},
+		{
+			name:          "invalid quantity",
+			optionIds:     []uuid.UUID{doneness.Options[0].Id},
+			expectedError: domain.ErrInvalidQuantity,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+			},
+		},
+		{
+			name:          "session is not open",
+			quantity:      1,
+			expectedError: domain.ErrOrderSessionIsNotOpen,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.OrderSession{Status: domain.Closed}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderRepository := mock.NewMockOrderRepository(ctrl)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(orderRepository, productRepository)
+			}
+
+			_, err := service.NewOrderService(orderRepository, productRepository, mock.NewMockBundleRepository(ctrl), mock.NewMockMenuCacheRepository(ctrl), mock.NewMockMenuNotifier(ctrl), fixedClock(testNow)).
+				OrderProduct(
+					context.Background(),
+					domain.NewOrderProductDTO(product.Id, uuid.New(), tt.optionIds, tt.quantity, nil),
+				)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestOrderService_SubmitCart(t *testing.T) {
+	product := &domain.Product{Id: uuid.New(), Available: true}
+	sessionId := uuid.New()
+
+	tests := []struct {
+		name          string
+		items         []domain.OrderProductDTO
+		expectedError error
+		mockSetup     func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository)
+	}{
+		{
+			name: "success",
+			items: []domain.OrderProductDTO{
+				*domain.NewOrderProductDTO(product.Id, sessionId, nil, 1, nil),
+				*domain.NewOrderProductDTO(product.Id, sessionId, nil, 2, nil),
+			},
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				gomock.InOrder(
+					orderRepository.EXPECT().
+						GetSessionByID(gomock.AssignableToTypeOf(context.Background()), sessionId).
+						Return(&domain.OrderSession{Status: domain.Open}, nil).
+						Times(1),
+					productRepository.EXPECT().
+						GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+						Return(product, nil).
+						Times(2),
+					orderRepository.EXPECT().
+						AddOrderedProducts(gomock.AssignableToTypeOf(context.Background()), gomock.Len(2)).
+						DoAndReturn(func(_ context.Context, orderedProducts []domain.OrderedProduct) error {
+							for _, orderedProduct := range orderedProducts {
+								require.Equal(t, sessionId, orderedProduct.OrderSessionID)
+							}
+							return nil
+						}),
+				)
+			},
+		},
+		{
+			name: "failing item prevents insertion",
+			items: []domain.OrderProductDTO{
+				*domain.NewOrderProductDTO(product.Id, sessionId, nil, 1, nil),
+				*domain.NewOrderProductDTO(uuid.New(), sessionId, nil, 1, nil),
+			},
+			expectedError: domain.ErrProductNotFound,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), sessionId).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), product.Id).
+					Return(product, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), gomock.Not(product.Id)).
+					Return(nil, domain.ErrProductNotFound)
+			},
+		},
+		{
+			name:          "empty cart",
+			expectedError: domain.ErrEmptyCart,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderRepository := mock.NewMockOrderRepository(ctrl)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(orderRepository, productRepository)
+			}
+
+			_, err := service.NewOrderService(orderRepository, productRepository, mock.NewMockBundleRepository(ctrl), mock.NewMockMenuCacheRepository(ctrl), mock.NewMockMenuNotifier(ctrl), fixedClock(testNow)).
+				SubmitCart(context.Background(), sessionId, tt.items)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestOrderService_OrderBundle(t *testing.T) {
+	burgers := uuid.New()
+	burger := &domain.Product{Id: uuid.New(), Name: "Cheeseburger", Category: burgers, Price: decimal.NewFromInt(9), Available: true}
+	fries := &domain.Product{Id: uuid.New(), Name: "Fries", Category: uuid.New(), Price: decimal.NewFromInt(4), Available: true}
+	bundle := &domain.Bundle{
+		Id:        uuid.New(),
+		Name:      "Burger Menu",
+		Price:     decimal.NewFromInt(11),
+		Available: true,
+		Slots: []domain.BundleSlot{
+			*domain.NewBundleSlot(uuid.New(), "Burger", &burgers, nil),
+			*domain.NewBundleSlot(uuid.New(), "Side", nil, []uuid.UUID{fries.Id}),
+		},
+	}
+	sessionId := uuid.New()
+
+	tests := []struct {
+		name          string
+		choices       []domain.BundleChoiceDTO
+		expectedError error
+		mockSetup     func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository, bundleRepository *mock.MockBundleRepository)
+	}{
+		{
+			name: "success",
+			choices: []domain.BundleChoiceDTO{
+				*domain.NewBundleChoiceDTO(bundle.Slots[1].Id, fries.Id, nil, nil),
+				*domain.NewBundleChoiceDTO(bundle.Slots[0].Id, burger.Id, nil, nil),
+			},
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository, bundleRepository *mock.MockBundleRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), sessionId).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				bundleRepository.EXPECT().
+					GetBundleById(gomock.AssignableToTypeOf(context.Background()), bundle.Id).
+					Return(bundle, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), burger.Id).
+					Return(burger, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), fries.Id).
+					Return(fries, nil)
+				orderRepository.EXPECT().
+					AddOrderedBundle(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.OrderedBundle{})).
+					DoAndReturn(func(_ context.Context, orderedBundle *domain.OrderedBundle) error {
+						require.True(t, bundle.Price.Equal(orderedBundle.Price))
+						require.Len(t, orderedBundle.Components, 2)
+						for _, component := range orderedBundle.Components {
+							require.True(t, component.UnitPrice.IsZero())
+							require.Equal(t, &orderedBundle.Id, component.OrderedBundleId)
+							require.Equal(t, 2, component.Quantity)
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name: "missing slot choice",
+			choices: []domain.BundleChoiceDTO{
+				*domain.NewBundleChoiceDTO(bundle.Slots[0].Id, burger.Id, nil, nil),
+			},
+			expectedError: domain.ErrInvalidBundleSelection,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository, bundleRepository *mock.MockBundleRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), sessionId).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				bundleRepository.EXPECT().
+					GetBundleById(gomock.AssignableToTypeOf(context.Background()), bundle.Id).
+					Return(bundle, nil)
+			},
+		},
+		{
+			name: "product not allowed in slot",
+			choices: []domain.BundleChoiceDTO{
+				*domain.NewBundleChoiceDTO(bundle.Slots[0].Id, fries.Id, nil, nil),
+				*domain.NewBundleChoiceDTO(bundle.Slots[1].Id, fries.Id, nil, nil),
+			},
+			expectedError: domain.ErrInvalidBundleSelection,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository, bundleRepository *mock.MockBundleRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), sessionId).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				bundleRepository.EXPECT().
+					GetBundleById(gomock.AssignableToTypeOf(context.Background()), bundle.Id).
+					Return(bundle, nil)
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), fries.Id).
+					Return(fries, nil)
+			},
+		},
+		{
+			name: "unavailable bundle",
+			choices: []domain.BundleChoiceDTO{
+				*domain.NewBundleChoiceDTO(bundle.Slots[0].Id, burger.Id, nil, nil),
+				*domain.NewBundleChoiceDTO(bundle.Slots[1].Id, fries.Id, nil, nil),
+			},
+			expectedError: domain.ErrBundleUnavailable,
+			mockSetup: func(orderRepository *mock.MockOrderRepository, productRepository *mock.MockProductRepository, bundleRepository *mock.MockBundleRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), sessionId).
+					Return(&domain.OrderSession{Status: domain.Open}, nil)
+				bundleRepository.EXPECT().
+					GetBundleById(gomock.AssignableToTypeOf(context.Background()), bundle.Id).
+					Return(&domain.Bundle{Id: bundle.Id, Available: false}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderRepository := mock.NewMockOrderRepository(ctrl)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			bundleRepository := mock.NewMockBundleRepository(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(orderRepository, productRepository, bundleRepository)
+			}
+
+			_, err := service.NewOrderService(orderRepository, productRepository, bundleRepository, mock.NewMockMenuCacheRepository(ctrl), mock.NewMockMenuNotifier(ctrl), fixedClock(testNow)).
+				OrderBundle(context.Background(), domain.NewOrderBundleDTO(bundle.Id, sessionId, 2, tt.choices))
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestOrderService_UpdateOrderedProductStatus(t *testing.T) {
+	unavailableProductId := uuid.New()
+
+	tests := []struct {
+		name          string
+		status        domain.OrderedProductStatus
+		expectedError error
+		mockSetup     func(
+			orderRepository *mock.MockOrderRepository,
+			menuCache *mock.MockMenuCacheRepository,
+			menuNotifier *mock.MockMenuNotifier,
+		)
+	}{
+		{
+			name:   "preparing invalidates the menu and announces products that ran out of stock",
+			status: domain.Preparing,
+			mockSetup: func(
+				orderRepository *mock.MockOrderRepository,
+				menuCache *mock.MockMenuCacheRepository,
+				menuNotifier *mock.MockMenuNotifier,
+			) {
+				orderRepository.EXPECT().
+					UpdateOrderedProductStatus(gomock.Any(), gomock.Any(), domain.Preparing).
+					Return(&domain.OrderedProduct{Status: domain.Preparing}, []domain.AvailabilityChange{{ProductId: unavailableProductId}}, nil)
+				menuCache.EXPECT().Invalidate()
+				menuNotifier.EXPECT().NotifyAvailabilityChanged(unavailableProductId, false)
+			},
+		},
+		{
+			name:   "done keeps the menu",
+			status: domain.Done,
+			mockSetup: func(
+				orderRepository *mock.MockOrderRepository,
+				_ *mock.MockMenuCacheRepository,
+				_ *mock.MockMenuNotifier,
+			) {
+				orderRepository.EXPECT().
+					UpdateOrderedProductStatus(gomock.Any(), gomock.Any(), domain.Done).
+					Return(&domain.OrderedProduct{Status: domain.Done}, nil, nil)
+			},
+		},
+		{
+			name:          "not enough stock to prepare",
+			status:        domain.Preparing,
+			expectedError: domain.ErrInsufficientStock,
+			mockSetup: func(
+				orderRepository *mock.MockOrderRepository,
+				_ *mock.MockMenuCacheRepository,
+				_ *mock.MockMenuNotifier,
+			) {
+				orderRepository.EXPECT().
+					UpdateOrderedProductStatus(gomock.Any(), gomock.Any(), domain.Preparing).
+					Return(nil, nil, domain.ErrInsufficientStock)
+			},
+		},
+		{
+			name:          "ordered product not found",
+			status:        domain.Preparing,
+			expectedError: domain.ErrOrderedProductNotFound,
+			mockSetup: func(
+				orderRepository *mock.MockOrderRepository,
+				_ *mock.MockMenuCacheRepository,
+				_ *mock.MockMenuNotifier,
+			) {
+				orderRepository.EXPECT().
+					UpdateOrderedProductStatus(gomock.Any(), gomock.Any(), domain.Preparing).
+					Return(nil, nil, domain.ErrOrderedProductNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderRepository := mock.NewMockOrderRepository(ctrl)
+			menuCache := mock.NewMockMenuCacheRepository(ctrl)
+			menuNotifier := mock.NewMockMenuNotifier(ctrl)
+			tt.mockSetup(orderRepository, menuCache, menuNotifier)
+
+			orderedProduct, err := service.NewOrderService(orderRepository, mock.NewMockProductRepository(ctrl), mock.NewMockBundleRepository(ctrl), menuCache, menuNotifier, fixedClock(testNow)).
+				UpdateOrderedProductStatus(context.Background(), uuid.New(), tt.status)
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				require.Equal(t, tt.status, orderedProduct.Status)
+			} else {
+				require.Nil(t, orderedProduct)
+			}
+		})
+	}
+}
+
+func TestOrderService_DeleteOrderedProduct(t *testing.T) {
+	orderedProductId := uuid.New()
+
+	tests := []struct {
+		name             string
+		isPrivilegedCall bool
+		expectedError    error
+		mockSetup        func(orderRepository *mock.MockOrderRepository)
+	}{
+		{
+			name: "success guest deletes a pending product",
+			mockSetup: func(orderRepository *mock.MockOrderRepository) {
+				orderRepository.EXPECT().
+					DeletePendingOrderedProduct(gomock.AssignableToTypeOf(context.Background()), orderedProductId).
+					Return(&domain.OrderedProduct{Id: orderedProductId}, nil)
+			},
+		}, {
+			name:             "success staff deletes any product",
+			isPrivilegedCall: true,
+			mockSetup: func(orderRepository *mock.MockOrderRepository) {
+				orderRepository.EXPECT().
+					DeleteOrderedProduct(gomock.AssignableToTypeOf(context.Background()), orderedProductId).
+					Return(&domain.OrderedProduct{Id: orderedProductId}, nil)
+			},
+		}, {
+			name:          "guest can't delete a bundle component",
+			expectedError: domain.ErrOrderedProductInBundle,
+			mockSetup: func(orderRepository *mock.MockOrderRepository) {
+				orderRepository.EXPECT().
+					DeletePendingOrderedProduct(gomock.AssignableToTypeOf(context.Background()), orderedProductId).
+					Return(nil, domain.ErrOrderedProductInBundle)
+			},
+		}, {
+			name:             "staff can't delete a bundle component",
+			isPrivilegedCall: true,
+			expectedError:    domain.ErrOrderedProductInBundle,
+			mockSetup: func(orderRepository *mock.MockOrderRepository) {
+				orderRepository.EXPECT().
+					DeleteOrderedProduct(gomock.AssignableToTypeOf(context.Background()), orderedProductId).
+					Return(nil, domain.ErrOrderedProductInBundle)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderRepository := mock.NewMockOrderRepository(ctrl)
+			tt.mockSetup(orderRepository)
+
+			orderedProduct, err := service.NewOrderService(orderRepository, mock.NewMockProductRepository(ctrl), mock.NewMockBundleRepository(ctrl), mock.NewMockMenuCacheRepository(ctrl), mock.NewMockMenuNotifier(ctrl), fixedClock(testNow)).
+				DeleteOrderedProduct(context.Background(), orderedProductId, tt.isPrivilegedCall)
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				require.Equal(t, orderedProductId, orderedProduct.Id)
+			}
+		})
+	}
+}
+
+func TestOrderService_PayBill(t *testing.T) {
+	sessionId := uuid.New()
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mockSetup     func(orderRepository *mock.MockOrderRepository)
+	}{
+		{
+			name: "success moves the orders to the history",
+			mockSetup: func(orderRepository *mock.MockOrderRepository) {
+				paid := domain.Paid
+				gomock.InOrder(
+					orderRepository.EXPECT().
+						GetSessionByID(gomock.AssignableToTypeOf(context.Background()), sessionId).
+						Return(&domain.OrderSession{Id: sessionId, Status: domain.Open}, nil),
+					orderRepository.EXPECT().
+						HasIncompletedOrderedProducts(gomock.AssignableToTypeOf(context.Background()), sessionId).
+						Return(false, nil),
+					orderRepository.EXPECT().
+						MoveOrderedProductsToHistory(gomock.AssignableToTypeOf(context.Background()), sessionId).
+						Return(nil),
+					orderRepository.EXPECT().
+						UpdateSession(gomock.AssignableToTypeOf(context.Background()), domain.NewUpdateOrderSessionDTO(sessionId, nil, &paid)).
+						Return(&domain.OrderSession{Id: sessionId, Status: domain.Paid}, nil),
+				)
+			},
+		},
+		{
+			name:          "products are incomplete",
+			expectedError: domain.ErrProductsAreIncomplete,
+			mockSetup: func(orderRepository *mock.MockOrderRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), sessionId).
+					Return(&domain.OrderSession{Id: sessionId, Status: domain.Open}, nil)
+				orderRepository.EXPECT().
+					HasIncompletedOrderedProducts(gomock.AssignableToTypeOf(context.Background()), sessionId).
+					Return(true, nil)
+			},
+		},
+		{
+			name:          "session is not open",
+			expectedError: domain.ErrOrderSessionIsNotOpen,
+			mockSetup: func(orderRepository *mock.MockOrderRepository) {
+				orderRepository.EXPECT().
+					GetSessionByID(gomock.AssignableToTypeOf(context.Background()), sessionId).
+					Return(&domain.OrderSession{Id: sessionId, Status: domain.Paid}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			orderRepository := mock.NewMockOrderRepository(ctrl)
+			tt.mockSetup(orderRepository)
+
+			err := service.NewOrderService(orderRepository, mock.NewMockProductRepository(ctrl), mock.NewMockBundleRepository(ctrl), mock.NewMockMenuCacheRepository(ctrl), mock.NewMockMenuNotifier(ctrl), fixedClock(testNow)).
+				PayBill(context.Background(), sessionId)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestOrderService_PayBillKeepsRecommendationHistory(t *testing.T) {
+	burger, fries := uuid.New(), uuid.New()
+	sessions := map[uuid.UUID][]uuid.UUID{
+		uuid.New(): {burger, fries},
+		uuid.New(): {burger, fries},
+	}
+
+	ctrl := gomock.NewController(t)
+	orderRepository := mock.NewMockOrderRepository(ctrl)
+	recommendationRepository := mock.NewMockRecommendationRepository(ctrl)
+	recommendationCache := mock.NewMockRecommendationCacheRepository(ctrl)
+
+	// The history stands in for the paid sessions the repository keeps after their orders are deleted.
+	var history [][]uuid.UUID
+	orderRepository.EXPECT().
+		GetSessionByID(gomock.Any(), gomock.Any()).
+		Return(&domain.OrderSession{Status: domain.Open}, nil).
+		Times(len(sessions))
+	orderRepository.EXPECT().
+		HasIncompletedOrderedProducts(gomock.Any(), gomock.Any()).
+		Return(false, nil).
+		Times(len(sessions))
+	orderRepository.EXPECT().
+		MoveOrderedProductsToHistory(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, sessionId uuid.UUID) error {
+			history = append(history, sessions[sessionId])
+			return nil
+		}).
+		Times(len(sessions))
+	orderRepository.EXPECT().
+		UpdateSession(gomock.Any(), gomock.Any()).
+		Return(&domain.OrderSession{Status: domain.Paid}, nil).
+		Times(len(sessions))
+
+	recommendationRepository.EXPECT().
+		GetRecommendations(gomock.Any(), gomock.Any(), domain.MinRecommendationSessions, domain.MaxRecommendations).
+		DoAndReturn(func(_ context.Context, _ time.Time, minSessions, _ int) ([]domain.Recommendation, error) {
+			require.GreaterOrEqual(t, len(history), minSessions)
+			return []domain.Recommendation{
+				{ProductId: burger, CompanionId: fries, Sessions: len(history)},
+				{ProductId: fries, CompanionId: burger, Sessions: len(history)},
+			}, nil
+		})
+	recommendationCache.EXPECT().
+		Replace(map[uuid.UUID][]domain.Recommendation{
+			burger: {{ProductId: burger, CompanionId: fries, Sessions: 2}},
+			fries:  {{ProductId: fries, CompanionId: burger, Sessions: 2}},
+		})
+
+	orderService := service.NewOrderService(orderRepository, mock.NewMockProductRepository(ctrl), mock.NewMockBundleRepository(ctrl), mock.NewMockMenuCacheRepository(ctrl), mock.NewMockMenuNotifier(ctrl), fixedClock(testNow))
+	for sessionId := range sessions {
+		require.NoError(t, orderService.PayBill(context.Background(), sessionId))
+	}
+
+	products, err := service.NewRecommendationService(recommendationRepository, recommendationCache, mock.NewMockProductService(ctrl)).
+		RefreshRecommendations(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, products)
+}
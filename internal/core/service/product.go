@@ -1,12 +1,18 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"io"
 	"restaurant/internal/core/domain"
 	"restaurant/internal/core/port"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
 )
 
@@ -14,17 +20,50 @@ import (
 type ProductService struct {
 	productRepository port.ProductRepository
 	imageRepository   port.ImageRepository
+	imageProcessor    port.ImageProcessor
+	// imageOperationRepository records uploaded images until they are linked to a product,
+	// so abandoned uploads are deleted by the image cleanup.
+	imageOperationRepository port.ImageOperationRepository
+	menuNotifier             port.MenuNotifier
+	clock                    port.Clock
 }
 
 // NewProductService creates a new ProductService instance.
-func NewProductService(productRepository port.ProductRepository, imageRepository port.ImageRepository) *ProductService {
+func NewProductService(
+	productRepository port.ProductRepository,
+	imageRepository port.ImageRepository,
+	imageProcessor port.ImageProcessor,
+	imageOperationRepository port.ImageOperationRepository,
+	menuNotifier port.MenuNotifier,
+	clock port.Clock,
+) *ProductService {
 	return &ProductService{
-		productRepository: productRepository,
-		imageRepository:   imageRepository,
+		productRepository:        productRepository,
+		imageRepository:          imageRepository,
+		imageProcessor:           imageProcessor,
+		imageOperationRepository: imageOperationRepository,
+		menuNotifier:             menuNotifier,
+		clock:                    clock,
 	}
 }
 
+// checkNoMenuDraft rejects direct changes to the categories and products while a menu draft is staged,
+// because publishing the draft would overwrite them.
+func (s *ProductService) checkNoMenuDraft(ctx context.Context) error {
+	staged, err := s.productRepository.HasMenuDraft(ctx)
+	if err != nil {
+		return err
+	}
+	if staged {
+		return domain.ErrMenuDraftPending
+	}
+	return nil
+}
+
 func (s *ProductService) AddCategory(ctx context.Context, name string) (*domain.ProductCategory, error) {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return nil, err
+	}
 	category := domain.NewProductCategory(uuid.New(), name)
 	if err := s.productRepository.AddCategory(ctx, category); err != nil {
 		return nil, err
@@ -33,6 +72,9 @@ func (s *ProductService) AddCategory(ctx context.Context, name string) (*domain.
 }
 
 func (s *ProductService) UpdateCategory(ctx context.Context, dto *domain.UpdateCategoryProductDTO) error {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return err
+	}
 	if dto.Name == nil {
 		return domain.ErrNothingToUpdate
 	}
@@ -40,14 +82,42 @@ func (s *ProductService) UpdateCategory(ctx context.Context, dto *domain.UpdateC
 }
 
 func (s *ProductService) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return err
+	}
 	return s.productRepository.DeleteCategory(ctx, id)
 }
 
-func (s *ProductService) GetProductCategories(ctx context.Context) ([]domain.ProductCategory, error) {
-	return s.productRepository.GetProductCategories(ctx)
+func (s *ProductService) GetProductCategories(ctx context.Context, locales []string) ([]domain.ProductCategory, error) {
+	return s.productRepository.GetProductCategories(ctx, normalizeLocales(locales))
+}
+
+func (s *ProductService) GetArchivedCategories(ctx context.Context) ([]domain.ProductCategory, error) {
+	return s.productRepository.GetArchivedCategories(ctx)
+}
+
+func (s *ProductService) ArchiveCategory(ctx context.Context, id uuid.UUID) error {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return err
+	}
+	return s.productRepository.SetCategoryArchived(ctx, id, true)
+}
+
+func (s *ProductService) RestoreCategory(ctx context.Context, id uuid.UUID) error {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return err
+	}
+	return s.productRepository.SetCategoryArchived(ctx, id, false)
 }
 
 func (s *ProductService) AddProduct(ctx context.Context, dto *domain.AddProductDTO) (*domain.Product, error) {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return nil, err
+	}
+	if err := dto.Nutrition.Validate(); err != nil {
+		return nil, err
+	}
+
 	product := domain.NewProduct(
 		uuid.New(),
 		dto.Name,
@@ -56,7 +126,9 @@ func (s *ProductService) AddProduct(ctx context.Context, dto *domain.AddProductD
 		nil,
 		dto.Category,
 		dto.Price,
+		true,
 	)
+	product.Nutrition = dto.Nutrition
 
 	if err := s.productRepository.
 		AddProduct(
@@ -70,6 +142,9 @@ func (s *ProductService) AddProduct(ctx context.Context, dto *domain.AddProductD
 }
 
 func (s *ProductService) UpdateProduct(ctx context.Context, dto *domain.UpdateProductDTO) error {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return err
+	}
 	hasFieldToUpdate := false
 	switch {
 	case dto.Name != nil:
@@ -80,83 +155,671 @@ func (s *ProductService) UpdateProduct(ctx context.Context, dto *domain.UpdatePr
 		hasFieldToUpdate = true
 	case dto.Category != nil:
 		hasFieldToUpdate = true
+	case dto.Nutrition != nil:
+		hasFieldToUpdate = true
 	}
 
 	if !hasFieldToUpdate {
 		return domain.ErrNothingToUpdate
 	}
+	if dto.Nutrition != nil {
+		if err := dto.Nutrition.Validate(); err != nil {
+			return err
+		}
+	}
 	return s.productRepository.UpdateProduct(ctx, dto)
 }
 
-func (s *ProductService) ReplaceProductImage(ctx context.Context, productId uuid.UUID, data io.Reader) (string, error) {
-	product, err := s.productRepository.GetProductById(ctx, productId)
+func (s *ProductService) ReplaceProductImage(ctx context.Context, productId uuid.UUID, data io.Reader) (domain.ImageSet, error) {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.productRepository.GetProductById(ctx, productId); err != nil {
+		return nil, err
+	}
+
+	images, err := s.saveImage(ctx, data)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	if product.DeleteImageUrl != nil {
-		err = s.imageRepository.DeleteImage(ctx, *product.DeleteImageUrl)
-		if err != nil {
-			return "", err
+	// The repository schedules the deletion of the old image together with the update,
+	// so the product never points to a deleted image.
+	if err = s.productRepository.UpdateProductImage(ctx, productId, images); err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func (s *ProductService) AddProductImage(ctx context.Context, dto *domain.AddProductImageDTO) (*domain.ProductImage, error) {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return nil, err
+	}
+	product, err := s.productRepository.GetProductById(ctx, dto.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The repository checks the limit again, this only avoids uploading an image that can't be added.
+	if len(product.Gallery) >= domain.MaxProductImages {
+		return nil, domain.ErrProductGalleryFull
+	}
+
+	images, err := s.saveImage(ctx, dto.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	image := domain.NewProductImage(uuid.New(), dto.ProductId, dto.AltText, images)
+	if err = s.productRepository.AddProductImage(ctx, image); err != nil {
+		return nil, err
+	}
+	return image, nil
+}
+
+func (s *ProductService) ReorderProductImages(ctx context.Context, productId uuid.UUID, imageIds []uuid.UUID) error {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return err
+	}
+	seen := make(map[uuid.UUID]struct{}, len(imageIds))
+	for _, id := range imageIds {
+		if _, ok := seen[id]; ok {
+			return domain.ErrInvalidProductImageOrder
 		}
+		seen[id] = struct{}{}
 	}
+	return s.productRepository.ReorderProductImages(ctx, productId, imageIds)
+}
 
-	image, err := s.imageRepository.SaveImage(ctx, data)
+func (s *ProductService) DeleteProductImage(ctx context.Context, productId, imageId uuid.UUID) error {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return err
+	}
+	_, err := s.productRepository.DeleteProductImage(ctx, productId, imageId)
+	return err
+}
+
+// saveImage processes an uploaded image and saves all its variants. The variants are recorded
+// as pending uploads, which the repository completes when it links them to a product. Uploads
+// that are never linked, because a later step fails, are deleted after domain.PendingUploadTimeout.
+func (s *ProductService) saveImage(ctx context.Context, data io.Reader) (domain.ImageSet, error) {
+	processed, err := s.imageProcessor.ProcessImage(ctx, data)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	if err = s.productRepository.UpdateProductImage(ctx, productId, image); err != nil {
-		return "", err
+	images := make(domain.ImageSet, len(processed))
+	for _, variant := range processed {
+		image, err := s.imageRepository.SaveImage(ctx, bytes.NewReader(variant.Data))
+		if err != nil {
+			s.discardImages(ctx, images.DeleteUrls())
+			return nil, err
+		}
+		images[variant.Variant] = *image
 	}
 
-	return image.Url, nil
+	pendingUntil := time.Now().Add(domain.PendingUploadTimeout)
+	operations := domain.NewImageOperations(domain.UploadImageOperation, images.Images(), pendingUntil)
+	if err = s.imageOperationRepository.AddImageOperations(ctx, operations); err != nil {
+		s.discardImages(ctx, images.DeleteUrls())
+		return nil, err
+	}
+	return images, nil
+}
+
+// discardImages deletes images that were saved but can't be used. Failures are only logged,
+// the images are then left for the image reconciliation to find.
+func (s *ProductService) discardImages(ctx context.Context, deleteUrls []string) {
+	for _, deleteUrl := range deleteUrls {
+		if err := s.imageRepository.DeleteImage(ctx, deleteUrl); err != nil {
+			zap.L().Error("error deleting image url", zap.String("url", deleteUrl), zap.Error(err))
+		}
+	}
 }
 
 func (s *ProductService) DeleteProduct(ctx context.Context, dto *domain.DeleteProductDTO) error {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return err
+	}
+	// The repository schedules the deletion of the product images together with the products,
+	// so a failing image storage can't leave them behind.
 	switch {
 	case dto.ProductId != nil && dto.CategoryId != nil:
 		return domain.ErrMultipleDeleteCriteria
 	case dto.ProductId != nil:
-		product, err := s.productRepository.DeleteProductById(ctx, *dto.ProductId)
-		if err != nil {
-			return err
+		_, err := s.productRepository.DeleteProductById(ctx, *dto.ProductId)
+		return err
+	case dto.CategoryId != nil:
+		_, err := s.productRepository.DeleteProductsByCategory(ctx, *dto.CategoryId)
+		return err
+	default:
+		return domain.ErrNothingToDelete
+	}
+}
+
+func (s *ProductService) GetProducts(ctx context.Context, dto *domain.GetProductsDTO) (*domain.ProductPage, error) {
+	dto.IncludeTags = normalizeTagNames(dto.IncludeTags)
+	dto.ExcludeTags = normalizeTagNames(dto.ExcludeTags)
+	dto.Locales = normalizeLocales(dto.Locales)
+	dto.Search = strings.TrimSpace(dto.Search)
+	dto.At = s.clock.Now()
+
+	switch dto.Sort {
+	case "":
+		dto.Sort = domain.SortByCategory
+		if dto.Search != "" {
+			dto.Sort = domain.SortByRelevance
+		}
+	case domain.SortByName, domain.SortByPrice, domain.SortByCategory:
+	case domain.SortByRelevance:
+		if dto.Search == "" {
+			return nil, domain.ErrInvalidProductSort
 		}
+	default:
+		return nil, domain.ErrInvalidProductSort
+	}
+
+	if dto.Limit < 0 || dto.Limit > domain.MaxProductsLimit || dto.Offset < 0 {
+		return nil, domain.ErrInvalidPagination
+	}
+	if dto.MaxCalories != nil && *dto.MaxCalories < 0 {
+		return nil, domain.ErrInvalidMaxCalories
+	}
 
-		if product.DeleteImageUrl == nil {
-			return nil
+	// The current price depends on the pricing rules active at dto.At, so products sorted by price
+	// are fetched unpaginated and paginated after applying them.
+	query := dto
+	if dto.Sort == domain.SortByPrice {
+		unpaginated := *dto
+		unpaginated.Limit, unpaginated.Offset = 0, 0
+		query = &unpaginated
+	}
+
+	products, err := s.productRepository.GetProducts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.productRepository.CountProducts(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range products {
+		if products[i].ActivePricingRule, err = domain.BestPricingRule(products[i].PricingRules, products[i].Price, dto.At); err != nil {
+			return nil, err
 		}
-		if err = s.imageRepository.DeleteImage(ctx, *product.DeleteImageUrl); err != nil {
-			return err
+	}
+
+	if dto.Sort == domain.SortByPrice {
+		products = sortByCurrentPrice(products, dto.Descending, dto.Limit, dto.Offset)
+	}
+	return domain.NewProductPage(products, total), nil
+}
+
+// sortByCurrentPrice orders products by their current price and returns the requested page.
+// The sort is stable, so products with the same current price keep the order of the repository.
+func sortByCurrentPrice(products []domain.Product, descending bool, limit, offset int) []domain.Product {
+	sort.SliceStable(products, func(i, j int) bool {
+		if descending {
+			return products[i].CurrentPrice().GreaterThan(products[j].CurrentPrice())
 		}
+		return products[i].CurrentPrice().LessThan(products[j].CurrentPrice())
+	})
 
-		return nil
-	case dto.CategoryId != nil:
-		products, err := s.productRepository.DeleteProductsByCategory(ctx, *dto.CategoryId)
-		if err != nil {
-			return err
+	if offset >= len(products) {
+		return []domain.Product{}
+	}
+	products = products[offset:]
+	if limit > 0 && limit < len(products) {
+		products = products[:limit]
+	}
+	return products
+}
+
+// normalizeTagNames lower-cases and trims tag names and removes empty and duplicated names.
+func normalizeTagNames(names []string) []string {
+	seen := make(map[string]struct{}, len(names))
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
 		}
+		seen[name] = struct{}{}
+		normalized = append(normalized, name)
+	}
+	return normalized
+}
+
+func (s *ProductService) SetProductAvailability(ctx context.Context, id uuid.UUID, available bool) error {
+	if err := s.productRepository.SetProductAvailability(ctx, id, available); err != nil {
+		return err
+	}
+	s.menuNotifier.NotifyAvailabilityChanged(id, available)
+	return nil
+}
+
+func (s *ProductService) GetArchivedProducts(ctx context.Context) ([]domain.Product, error) {
+	return s.productRepository.GetArchivedProducts(ctx)
+}
+
+func (s *ProductService) ArchiveProduct(ctx context.Context, id uuid.UUID) error {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return err
+	}
+	return s.productRepository.SetProductArchived(ctx, id, true)
+}
+
+func (s *ProductService) RestoreProduct(ctx context.Context, id uuid.UUID) error {
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return err
+	}
+	return s.productRepository.SetProductArchived(ctx, id, false)
+}
+
+func (s *ProductService) AddModifierGroup(ctx context.Context, dto *domain.AddModifierGroupDTO) (*domain.ModifierGroup, error) {
+	groupId := uuid.New()
+	options := make([]domain.ModifierOption, 0, len(dto.Options))
+	for _, option := range dto.Options {
+		options = append(options, *domain.NewModifierOption(uuid.New(), groupId, option.Name, option.PriceDelta))
+	}
 
-		for _, product := range products {
-			if product.DeleteImageUrl == nil {
+	group := domain.NewModifierGroup(
+		groupId,
+		dto.ProductId,
+		dto.Name,
+		dto.MinSelected,
+		dto.MaxSelected,
+		options,
+	)
+	if err := s.productRepository.AddModifierGroup(ctx, group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *ProductService) DeleteModifierGroup(ctx context.Context, id uuid.UUID) error {
+	return s.productRepository.DeleteModifierGroup(ctx, id)
+}
+
+// normalizeLocales lower-cases locales so they match the stored translations.
+func normalizeLocales(locales []string) []string {
+	normalized := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		normalized = append(normalized, strings.ToLower(locale))
+	}
+	return normalized
+}
+
+func (s *ProductService) GetTags(ctx context.Context) ([]domain.Tag, error) {
+	return s.productRepository.GetTags(ctx)
+}
+
+func (s *ProductService) AddTag(ctx context.Context, name string) (*domain.Tag, error) {
+	tag := domain.NewTag(uuid.New(), strings.ToLower(strings.TrimSpace(name)), domain.Dietary)
+	if err := s.productRepository.AddTag(ctx, tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (s *ProductService) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	return s.productRepository.DeleteTag(ctx, id)
+}
+
+func (s *ProductService) SetProductTags(ctx context.Context, productId uuid.UUID, tagIds []uuid.UUID) error {
+	return s.productRepository.SetProductTags(ctx, productId, tagIds)
+}
+
+func (s *ProductService) GetProductTranslations(ctx context.Context, productId uuid.UUID) ([]domain.ProductTranslation, error) {
+	return s.productRepository.GetProductTranslations(ctx, productId)
+}
+
+func (s *ProductService) SetProductTranslation(ctx context.Context, translation *domain.ProductTranslation) error {
+	translation.Locale = strings.ToLower(translation.Locale)
+	return s.productRepository.SetProductTranslation(ctx, translation)
+}
+
+func (s *ProductService) DeleteProductTranslation(ctx context.Context, productId uuid.UUID, locale string) error {
+	return s.productRepository.DeleteProductTranslation(ctx, productId, strings.ToLower(locale))
+}
+
+func (s *ProductService) GetCategoryTranslations(ctx context.Context, categoryId uuid.UUID) ([]domain.CategoryTranslation, error) {
+	return s.productRepository.GetCategoryTranslations(ctx, categoryId)
+}
+
+func (s *ProductService) SetCategoryTranslation(ctx context.Context, translation *domain.CategoryTranslation) error {
+	translation.Locale = strings.ToLower(translation.Locale)
+	return s.productRepository.SetCategoryTranslation(ctx, translation)
+}
+
+func (s *ProductService) DeleteCategoryTranslation(ctx context.Context, categoryId uuid.UUID, locale string) error {
+	return s.productRepository.DeleteCategoryTranslation(ctx, categoryId, strings.ToLower(locale))
+}
+
+func (s *ProductService) GetProductSchedule(ctx context.Context, productId uuid.UUID) ([]domain.ScheduleWindow, error) {
+	return s.productRepository.GetProductSchedule(ctx, productId)
+}
+
+func (s *ProductService) SetProductSchedule(ctx context.Context, productId uuid.UUID, windows []domain.ScheduleWindow) error {
+	if err := domain.ValidateSchedule(windows); err != nil {
+		return err
+	}
+	return s.productRepository.SetProductSchedule(ctx, productId, windows)
+}
+
+func (s *ProductService) GetCategorySchedule(ctx context.Context, categoryId uuid.UUID) ([]domain.ScheduleWindow, error) {
+	return s.productRepository.GetCategorySchedule(ctx, categoryId)
+}
+
+func (s *ProductService) SetCategorySchedule(ctx context.Context, categoryId uuid.UUID, windows []domain.ScheduleWindow) error {
+	if err := domain.ValidateSchedule(windows); err != nil {
+		return err
+	}
+	return s.productRepository.SetCategorySchedule(ctx, categoryId, windows)
+}
+
+func (s *ProductService) GetPricingRules(ctx context.Context) ([]domain.PricingRule, error) {
+	return s.productRepository.GetPricingRules(ctx)
+}
+
+func (s *ProductService) AddPricingRule(ctx context.Context, dto *domain.AddPricingRuleDTO) (*domain.PricingRule, error) {
+	if (dto.ProductId == nil) == (dto.CategoryId == nil) || !dto.Value.IsPositive() {
+		return nil, domain.ErrInvalidPricingRule
+	}
+	if dto.Kind == domain.PercentageDiscount && dto.Value.GreaterThan(decimal.NewFromInt(100)) {
+		return nil, domain.ErrInvalidPricingRule
+	}
+	if err := domain.ValidateSchedule(dto.Windows); err != nil {
+		return nil, err
+	}
+
+	rule := domain.NewPricingRule(uuid.New(), dto.Name, dto.ProductId, dto.CategoryId, dto.Kind, dto.Value, dto.Windows)
+	if err := s.productRepository.AddPricingRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *ProductService) DeletePricingRule(ctx context.Context, id uuid.UUID) error {
+	return s.productRepository.DeletePricingRule(ctx, id)
+}
+
+// resolveMenu matches the rows to the current menu by id or name and assigns ids to new entries.
+// Invalid rows are left out of the returned menu and recorded in the report together with
+// the number of entries that would be created or updated.
+func resolveMenu(current *domain.Menu, rows []domain.MenuRow, report *domain.MenuImportReport) *domain.Menu {
+	existingCategories := make(map[uuid.UUID]struct{}, len(current.Categories))
+	categoryIdsByName := make(map[string]uuid.UUID, len(current.Categories))
+	for _, category := range current.Categories {
+		existingCategories[category.Id] = struct{}{}
+		categoryIdsByName[category.Name] = category.Id
+	}
+	existingProducts := make(map[uuid.UUID]struct{}, len(current.Products))
+	productIdsByName := make(map[string]uuid.UUID, len(current.Products))
+	for _, product := range current.Products {
+		existingProducts[product.Id] = struct{}{}
+		productIdsByName[product.Name] = product.Id
+	}
+
+	categories := make([]domain.ProductCategory, 0)
+	importedCategories := make(map[uuid.UUID]string)
+	importedCategoryNames := make(map[string]uuid.UUID)
+	products := make([]domain.Product, 0, len(rows))
+	importedProducts := make(map[uuid.UUID]struct{})
+	importedProductNames := make(map[string]struct{})
+
+	for i, row := range rows {
+		rowNumber := i + 1
+		if messages := row.Validate(); len(messages) > 0 {
+			report.AddError(rowNumber, messages...)
+			continue
+		}
+
+		categoryId, ok := importedCategoryNames[row.CategoryName]
+		switch {
+		case row.CategoryId != nil && ok && categoryId != *row.CategoryId:
+			report.AddError(rowNumber, "categoryName is used by another category of the import.")
+			continue
+		case row.CategoryId != nil:
+			categoryId = *row.CategoryId
+		case !ok:
+			if categoryId, ok = categoryIdsByName[row.CategoryName]; !ok {
+				categoryId = uuid.New()
+			}
+		}
+		if name, ok := importedCategories[categoryId]; ok && name != row.CategoryName {
+			report.AddError(rowNumber, "categoryId is used with another categoryName in the import.")
+			continue
+		}
+		if id, ok := categoryIdsByName[row.CategoryName]; ok && id != categoryId {
+			report.AddError(rowNumber, "categoryName is already in use.")
+			continue
+		}
+
+		if row.HasProduct() {
+			if _, ok := importedProductNames[row.ProductName]; ok {
+				report.AddError(rowNumber, "productName is used by another product of the import.")
 				continue
 			}
 
-			if err = s.imageRepository.DeleteImage(ctx, *product.DeleteImageUrl); err != nil {
-				zap.L().Error("error deleting image url", zap.Error(err))
+			productId, ok := productIdsByName[row.ProductName]
+			switch {
+			case row.ProductId != nil && ok && productId != *row.ProductId:
+				report.AddError(rowNumber, "productName is already in use.")
+				continue
+			case row.ProductId != nil:
+				productId = *row.ProductId
+			case !ok:
+				productId = uuid.New()
+			}
+			if _, ok := importedProducts[productId]; ok {
+				report.AddError(rowNumber, "productId is used by another product of the import.")
+				continue
+			}
+
+			importedProducts[productId] = struct{}{}
+			importedProductNames[row.ProductName] = struct{}{}
+			products = append(products, *domain.NewProduct(
+				productId,
+				row.ProductName,
+				row.Description,
+				row.ImageUrl,
+				nil,
+				categoryId,
+				row.Price,
+				row.Available,
+			))
+			if _, ok := existingProducts[productId]; ok {
+				report.ProductsUpdated++
+			} else {
+				report.ProductsCreated++
 			}
 		}
 
-		return nil
-	default:
-		return domain.ErrNothingToDelete
+		if _, ok := importedCategories[categoryId]; ok {
+			continue
+		}
+		importedCategories[categoryId] = row.CategoryName
+		importedCategoryNames[row.CategoryName] = categoryId
+		categories = append(categories, *domain.NewProductCategory(categoryId, row.CategoryName))
+		if _, ok := existingCategories[categoryId]; ok {
+			report.CategoriesUpdated++
+		} else {
+			report.CategoriesCreated++
+		}
+	}
+
+	return domain.NewMenu(categories, products)
+}
+
+func (s *ProductService) ExportMenu(ctx context.Context) ([]domain.MenuRow, error) {
+	menu, err := s.productRepository.GetMenu(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return menu.Rows(), nil
+}
+
+func (s *ProductService) ImportMenu(ctx context.Context, dto *domain.ImportMenuDTO) (*domain.MenuImportReport, error) {
+	if len(dto.Rows) == 0 {
+		return nil, domain.ErrNothingToUpdate
+	}
+
+	current, err := s.productRepository.GetMenu(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := domain.NewMenuImportReport(dto.DryRun)
+	menu := resolveMenu(current, dto.Rows, report)
+	if dto.DryRun || len(report.Errors) > 0 {
+		return report, nil
+	}
+	if err = s.checkNoMenuDraft(ctx); err != nil {
+		return nil, err
 	}
+
+	if err = s.productRepository.UpsertMenu(ctx, menu); err != nil {
+		return nil, err
+	}
+	report.Applied = true
+	return report, nil
 }
 
-func (s *ProductService) GetProducts(ctx context.Context, dto *domain.GetProductsDTO) ([]domain.Product, error) {
-	if dto.CategoryId != nil {
-		return s.productRepository.GetProductsByCategory(ctx, *dto.CategoryId)
+func (s *ProductService) GetMenuDraft(ctx context.Context) (*domain.MenuDraft, error) {
+	draft, err := s.productRepository.GetMenuDraft(ctx)
+	if errors.Is(err, domain.ErrMenuDraftNotFound) {
+		rows, err := s.ExportMenu(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewMenuDraft(rows), nil
+	}
+	return draft, err
+}
+
+func (s *ProductService) SaveMenuDraft(ctx context.Context, rows []domain.MenuRow) (*domain.MenuDraft, error) {
+	if len(rows) == 0 {
+		return nil, domain.ErrNothingToUpdate
 	}
 
-	return s.productRepository.GetProducts(ctx)
+	draft := domain.NewMenuDraft(rows)
+	if err := s.productRepository.SaveMenuDraft(ctx, draft); err != nil {
+		return nil, err
+	}
+	return draft, nil
+}
+
+func (s *ProductService) DiscardMenuDraft(ctx context.Context) error {
+	return s.productRepository.DeleteMenuDraft(ctx)
+}
+
+// previewMenu resolves the rows against the live menu and counts the live entries publishing them would archive.
+// Only entries created before createdBefore are archived, unless it is nil.
+func previewMenu(current *domain.Menu, rows []domain.MenuRow, createdBefore *time.Time) (*domain.Menu, *domain.MenuPreview) {
+	report := domain.NewMenuImportReport(true)
+	menu := resolveMenu(current, rows, report)
+
+	published := make(map[uuid.UUID]struct{}, len(menu.Categories)+len(menu.Products))
+	for _, category := range menu.Categories {
+		published[category.Id] = struct{}{}
+	}
+	for _, product := range menu.Products {
+		published[product.Id] = struct{}{}
+	}
+
+	archived := func(id uuid.UUID, archivedAt *time.Time, createdAt time.Time) bool {
+		_, ok := published[id]
+		return !ok && archivedAt == nil && (createdBefore == nil || !createdAt.After(*createdBefore))
+	}
+
+	var categoriesArchived, productsArchived int
+	for _, category := range current.Categories {
+		if archived(category.Id, category.ArchivedAt, category.CreatedAt) {
+			categoriesArchived++
+		}
+	}
+	for _, product := range current.Products {
+		if archived(product.Id, product.ArchivedAt, product.CreatedAt) {
+			productsArchived++
+		}
+	}
+
+	return menu, domain.NewMenuPreview(menu.Rows(), report, categoriesArchived, productsArchived)
+}
+
+func (s *ProductService) PreviewMenuDraft(ctx context.Context) (*domain.MenuPreview, error) {
+	draft, err := s.productRepository.GetMenuDraft(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.productRepository.GetMenu(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, preview := previewMenu(current, draft.Rows, &draft.CreatedAt)
+	return preview, nil
+}
+
+func (s *ProductService) PublishMenuDraft(ctx context.Context) (*domain.MenuVersion, error) {
+	draft, err := s.productRepository.GetMenuDraft(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The draft is resolved against the live menu inside the publishing transaction,
+	// so it can't have changed since the draft was checked.
+	var version *domain.MenuVersion
+	if err = s.productRepository.PublishMenu(ctx, draft, func(current *domain.Menu) (*domain.Menu, *domain.MenuVersion, error) {
+		menu, preview := previewMenu(current, draft.Rows, &draft.CreatedAt)
+		if len(preview.Report.Errors) > 0 {
+			return nil, nil, domain.ErrInvalidMenuDraft
+		}
+
+		version = domain.NewMenuVersion(preview.Rows, nil)
+		return menu, version, nil
+	}); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+func (s *ProductService) GetMenuVersions(ctx context.Context) ([]domain.MenuVersion, error) {
+	return s.productRepository.GetMenuVersions(ctx)
+}
+
+func (s *ProductService) RollbackMenu(ctx context.Context, number int) (*domain.MenuVersion, error) {
+	// Publishing a staged draft would archive the entries restored here, so a draft has to be
+	// published or discarded first.
+	if err := s.checkNoMenuDraft(ctx); err != nil {
+		return nil, err
+	}
+
+	version, err := s.productRepository.GetMenuVersion(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	var restored *domain.MenuVersion
+	if err = s.productRepository.PublishMenu(ctx, nil, func(current *domain.Menu) (*domain.Menu, *domain.MenuVersion, error) {
+		menu, preview := previewMenu(current, version.Rows, nil)
+		if len(preview.Report.Errors) > 0 {
+			return nil, nil, domain.ErrMenuVersionConflict
+		}
+
+		restored = domain.NewMenuVersion(preview.Rows, &version.Number)
+		restored.Details = version.Details
+		return menu, restored, nil
+	}); err != nil {
+		return nil, err
+	}
+	return restored, nil
 }
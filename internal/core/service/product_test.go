@@ -2,16 +2,57 @@ package service_test
 
 import (
 	"context"
+	"io"
 	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port"
 	"restaurant/internal/core/port/mock"
 	"restaurant/internal/core/service"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
+// productServiceDeps holds the dependencies of a product service under test.
+// The ones left nil are replaced by mocks without expectations.
+type productServiceDeps struct {
+	productRepository        port.ProductRepository
+	imageRepository          port.ImageRepository
+	imageProcessor           port.ImageProcessor
+	imageOperationRepository port.ImageOperationRepository
+	menuNotifier             port.MenuNotifier
+}
+
+func newProductService(ctrl *gomock.Controller, deps productServiceDeps) *service.ProductService {
+	if deps.productRepository == nil {
+		deps.productRepository = mock.NewMockProductRepository(ctrl)
+	}
+	if deps.imageRepository == nil {
+		deps.imageRepository = mock.NewMockImageRepository(ctrl)
+	}
+	if deps.imageProcessor == nil {
+		deps.imageProcessor = mock.NewMockImageProcessor(ctrl)
+	}
+	if deps.imageOperationRepository == nil {
+		deps.imageOperationRepository = mock.NewMockImageOperationRepository(ctrl)
+	}
+	if deps.menuNotifier == nil {
+		deps.menuNotifier = mock.NewMockMenuNotifier(ctrl)
+	}
+	return service.NewProductService(
+		deps.productRepository,
+		deps.imageRepository,
+		deps.imageProcessor,
+		deps.imageOperationRepository,
+		deps.menuNotifier,
+		fixedClock(testNow),
+	)
+}
+
 func TestProductService_UpdateCategory(t *testing.T) {
 	newName := "New Name"
 
@@ -45,12 +86,13 @@ func TestProductService_UpdateCategory(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			productRepository := mock.NewMockProductRepository(ctrl)
+			productRepository.EXPECT().HasMenuDraft(gomock.Any()).Return(false, nil).AnyTimes()
 			imageRepository := mock.NewMockImageRepository(ctrl)
 			if tt.mockSetup != nil {
 				tt.mockSetup(productRepository, imageRepository)
 			}
 
-			err := service.NewProductService(productRepository, imageRepository).
+			err := newProductService(ctrl, productServiceDeps{productRepository: productRepository, imageRepository: imageRepository}).
 				UpdateCategory(context.Background(), tt.dto)
 			require.ErrorIs(t, err, tt.expectedError)
 		})
@@ -59,6 +101,8 @@ func TestProductService_UpdateCategory(t *testing.T) {
 
 func TestProductService_UpdateProduct(t *testing.T) {
 	name := "New Product"
+	calories := 450
+	carbohydrates, sugar := decimal.NewFromInt(10), decimal.NewFromInt(12)
 	tests := []struct {
 		name          string
 		dto           *domain.UpdateProductDTO
@@ -79,6 +123,28 @@ func TestProductService_UpdateProduct(t *testing.T) {
 					).
 					Return(nil)
 			},
+		}, {
+			name: "success nutrition only",
+			dto: &domain.UpdateProductDTO{
+				Nutrition: &domain.Nutrition{Calories: &calories},
+			},
+			mockSetup: func(productRepository *mock.MockProductRepository, imageRepository *mock.MockImageRepository) {
+				productRepository.EXPECT().
+					UpdateProduct(
+						gomock.AssignableToTypeOf(context.Background()),
+						gomock.AssignableToTypeOf(&domain.UpdateProductDTO{}),
+					).
+					Return(nil)
+			},
+		}, {
+			name: "invalid nutrition",
+			dto: &domain.UpdateProductDTO{
+				Nutrition: &domain.Nutrition{
+					Carbohydrates: &carbohydrates,
+					Sugar:         &sugar,
+				},
+			},
+			expectedError: domain.ErrInvalidNutrition,
 		}, {
 			name:          "nothing to update",
 			dto:           &domain.UpdateProductDTO{},
@@ -90,12 +156,13 @@ func TestProductService_UpdateProduct(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			productRepository := mock.NewMockProductRepository(ctrl)
+			productRepository.EXPECT().HasMenuDraft(gomock.Any()).Return(false, nil).AnyTimes()
 			imageRepository := mock.NewMockImageRepository(ctrl)
 			if tt.mockSetup != nil {
 				tt.mockSetup(productRepository, imageRepository)
 			}
 
-			err := service.NewProductService(productRepository, imageRepository).
+			err := newProductService(ctrl, productServiceDeps{productRepository: productRepository, imageRepository: imageRepository}).
 				UpdateProduct(context.Background(), tt.dto)
 			require.ErrorIs(t, err, tt.expectedError)
 		})
@@ -116,30 +183,221 @@ func TestProductService_DeleteProduct(t *testing.T) {
 				CategoryId: nil,
 			},
 			mockSetup: func(productRepository *mock.MockProductRepository, imageRepository *mock.MockImageRepository) {
+				// The repository schedules the image deletion, so the image isn't deleted directly.
+				productRepository.EXPECT().
+					DeleteProductById(
+						gomock.AssignableToTypeOf(context.Background()),
+						gomock.AssignableToTypeOf(uuid.UUID{}),
+					).Return(
+					&domain.Product{
+						DeleteImageUrl: new(string),
+					},
+					nil,
+				)
+			},
+		}, {
+			name:          "error nothing to delete",
+			dto:           &domain.DeleteProductDTO{},
+			expectedError: domain.ErrNothingToDelete,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			productRepository.EXPECT().HasMenuDraft(gomock.Any()).Return(false, nil).AnyTimes()
+			imageRepository := mock.NewMockImageRepository(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(productRepository, imageRepository)
+			}
+
+			err := newProductService(ctrl, productServiceDeps{productRepository: productRepository, imageRepository: imageRepository}).
+				DeleteProduct(context.Background(), tt.dto)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestProductService_SetProductAvailability(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mockSetup     func(productRepository *mock.MockProductRepository, menuNotifier *mock.MockMenuNotifier)
+	}{
+		{
+			name: "success notifies clients",
+			mockSetup: func(productRepository *mock.MockProductRepository, menuNotifier *mock.MockMenuNotifier) {
+				productRepository.EXPECT().
+					SetProductAvailability(gomock.AssignableToTypeOf(context.Background()), id, false).
+					Return(nil)
+				menuNotifier.EXPECT().NotifyAvailabilityChanged(id, false)
+			},
+		}, {
+			name:          "error not found",
+			expectedError: domain.ErrProductNotFound,
+			mockSetup: func(productRepository *mock.MockProductRepository, menuNotifier *mock.MockMenuNotifier) {
+				productRepository.EXPECT().
+					SetProductAvailability(gomock.AssignableToTypeOf(context.Background()), id, false).
+					Return(domain.ErrProductNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			menuNotifier := mock.NewMockMenuNotifier(ctrl)
+			tt.mockSetup(productRepository, menuNotifier)
+
+			err := newProductService(ctrl, productServiceDeps{productRepository: productRepository, menuNotifier: menuNotifier}).
+				SetProductAvailability(context.Background(), id, false)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestProductService_ReplaceProductImage(t *testing.T) {
+	oldImages := domain.ImageSet{
+		domain.ThumbnailImageVariant: {Url: "old-thumbnail", DeleteUrl: "delete-old-thumbnail"},
+		domain.LargeImageVariant:     {Url: "old-large", DeleteUrl: "delete-old-large"},
+	}
+	processed := []domain.ProcessedImage{
+		*domain.NewProcessedImage(domain.ThumbnailImageVariant, []byte("thumbnail")),
+		*domain.NewProcessedImage(domain.MediumImageVariant, []byte("medium")),
+		*domain.NewProcessedImage(domain.LargeImageVariant, []byte("large")),
+	}
+	saveImages := func(imageRepository *mock.MockImageRepository) {
+		imageRepository.EXPECT().
+			SaveImage(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+			DoAndReturn(func(_ context.Context, data io.Reader) (*domain.Image, error) {
+				name, _ := io.ReadAll(data)
+				return &domain.Image{Url: string(name), DeleteUrl: "delete-" + string(name)}, nil
+			}).
+			Times(len(processed))
+	}
+
+	tests := []struct {
+		name           string
+		expectedImages domain.ImageSet
+		expectedError  error
+		mockSetup      func(
+			productRepository *mock.MockProductRepository,
+			imageRepository *mock.MockImageRepository,
+			imageProcessor *mock.MockImageProcessor,
+			imageOperationRepository *mock.MockImageOperationRepository,
+		)
+	}{
+		{
+			name: "success records uploads before update",
+			expectedImages: domain.ImageSet{
+				domain.ThumbnailImageVariant: {Url: "thumbnail", DeleteUrl: "delete-thumbnail"},
+				domain.MediumImageVariant:    {Url: "medium", DeleteUrl: "delete-medium"},
+				domain.LargeImageVariant:     {Url: "large", DeleteUrl: "delete-large"},
+			},
+			mockSetup: func(
+				productRepository *mock.MockProductRepository,
+				imageRepository *mock.MockImageRepository,
+				imageProcessor *mock.MockImageProcessor,
+				imageOperationRepository *mock.MockImageOperationRepository,
+			) {
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.Product{ImageVariants: oldImages}, nil)
+				imageProcessor.EXPECT().
+					ProcessImage(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+					Return(processed, nil)
+				saveImages(imageRepository)
 				gomock.InOrder(
+					imageOperationRepository.EXPECT().
+						AddImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Len(len(processed))).
+						DoAndReturn(func(_ context.Context, operations []domain.ImageOperation) error {
+							for _, operation := range operations {
+								require.Equal(t, domain.UploadImageOperation, operation.Kind)
+								require.True(t, operation.NextAttemptAt.After(time.Now()))
+							}
+							return nil
+						}),
 					productRepository.EXPECT().
-						DeleteProductById(
+						UpdateProductImage(
 							gomock.AssignableToTypeOf(context.Background()),
 							gomock.AssignableToTypeOf(uuid.UUID{}),
-						).Return(
-						&domain.Product{
-							DeleteImageUrl: new(string),
-						},
-						nil,
-					),
-
-					imageRepository.EXPECT().
-						DeleteImage(
-							gomock.AssignableToTypeOf(context.Background()),
-							gomock.AssignableToTypeOf(""),
+							gomock.Len(len(processed)),
 						).
 						Return(nil),
 				)
 			},
 		}, {
-			name:          "error nothing to delete",
-			dto:           &domain.DeleteProductDTO{},
-			expectedError: domain.ErrNothingToDelete,
+			name:          "error invalid image keeps old image",
+			expectedError: domain.ErrInvalidImageFormat,
+			mockSetup: func(
+				productRepository *mock.MockProductRepository,
+				imageRepository *mock.MockImageRepository,
+				imageProcessor *mock.MockImageProcessor,
+				imageOperationRepository *mock.MockImageOperationRepository,
+			) {
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.Product{ImageVariants: oldImages}, nil)
+				imageProcessor.EXPECT().
+					ProcessImage(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+					Return(nil, domain.ErrInvalidImageFormat)
+			},
+		}, {
+			name:          "error update leaves new variants pending",
+			expectedError: domain.ErrProductNotFound,
+			mockSetup: func(
+				productRepository *mock.MockProductRepository,
+				imageRepository *mock.MockImageRepository,
+				imageProcessor *mock.MockImageProcessor,
+				imageOperationRepository *mock.MockImageOperationRepository,
+			) {
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.Product{ImageVariants: oldImages}, nil)
+				imageProcessor.EXPECT().
+					ProcessImage(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+					Return(processed, nil)
+				saveImages(imageRepository)
+				imageOperationRepository.EXPECT().
+					AddImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Len(len(processed))).
+					Return(nil)
+				productRepository.EXPECT().
+					UpdateProductImage(
+						gomock.AssignableToTypeOf(context.Background()),
+						gomock.AssignableToTypeOf(uuid.UUID{}),
+						gomock.Any(),
+					).
+					Return(domain.ErrProductNotFound)
+			},
+		}, {
+			name:          "error recording uploads deletes new variants",
+			expectedError: domain.ErrInternal,
+			mockSetup: func(
+				productRepository *mock.MockProductRepository,
+				imageRepository *mock.MockImageRepository,
+				imageProcessor *mock.MockImageProcessor,
+				imageOperationRepository *mock.MockImageOperationRepository,
+			) {
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.Product{ImageVariants: oldImages}, nil)
+				imageProcessor.EXPECT().
+					ProcessImage(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+					Return(processed, nil)
+				saveImages(imageRepository)
+				imageOperationRepository.EXPECT().
+					AddImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+					Return(domain.ErrInternal)
+				for _, deleteUrl := range []string{"delete-thumbnail", "delete-medium", "delete-large"} {
+					imageRepository.EXPECT().
+						DeleteImage(gomock.AssignableToTypeOf(context.Background()), deleteUrl).
+						Return(nil)
+				}
+			},
 		},
 	}
 
@@ -147,13 +405,822 @@ func TestProductService_DeleteProduct(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			productRepository := mock.NewMockProductRepository(ctrl)
+			productRepository.EXPECT().HasMenuDraft(gomock.Any()).Return(false, nil).AnyTimes()
 			imageRepository := mock.NewMockImageRepository(ctrl)
+			imageProcessor := mock.NewMockImageProcessor(ctrl)
+			imageOperationRepository := mock.NewMockImageOperationRepository(ctrl)
+			tt.mockSetup(productRepository, imageRepository, imageProcessor, imageOperationRepository)
+
+			images, err := newProductService(ctrl, productServiceDeps{productRepository: productRepository, imageRepository: imageRepository, imageProcessor: imageProcessor, imageOperationRepository: imageOperationRepository}).
+				ReplaceProductImage(context.Background(), uuid.New(), strings.NewReader("image"))
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedImages, images)
+		})
+	}
+}
+
+func TestProductService_AddProductImage(t *testing.T) {
+	processed := []domain.ProcessedImage{
+		*domain.NewProcessedImage(domain.ThumbnailImageVariant, []byte("thumbnail")),
+		*domain.NewProcessedImage(domain.LargeImageVariant, []byte("large")),
+	}
+	fullGallery := make([]domain.ProductImage, domain.MaxProductImages)
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mockSetup     func(
+			productRepository *mock.MockProductRepository,
+			imageRepository *mock.MockImageRepository,
+			imageProcessor *mock.MockImageProcessor,
+			imageOperationRepository *mock.MockImageOperationRepository,
+		)
+	}{
+		{
+			name: "success",
+			mockSetup: func(
+				productRepository *mock.MockProductRepository,
+				imageRepository *mock.MockImageRepository,
+				imageProcessor *mock.MockImageProcessor,
+				imageOperationRepository *mock.MockImageOperationRepository,
+			) {
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.Product{}, nil)
+				imageProcessor.EXPECT().
+					ProcessImage(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+					Return(processed, nil)
+				imageRepository.EXPECT().
+					SaveImage(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+					Return(&domain.Image{Url: "url", DeleteUrl: "delete-url"}, nil).
+					Times(len(processed))
+				imageOperationRepository.EXPECT().
+					AddImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Len(len(processed))).
+					Return(nil)
+				productRepository.EXPECT().
+					AddProductImage(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.ProductImage{})).
+					Return(nil)
+			},
+		}, {
+			name:          "error gallery full doesn't upload",
+			expectedError: domain.ErrProductGalleryFull,
+			mockSetup: func(
+				productRepository *mock.MockProductRepository,
+				imageRepository *mock.MockImageRepository,
+				imageProcessor *mock.MockImageProcessor,
+				imageOperationRepository *mock.MockImageOperationRepository,
+			) {
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.Product{Gallery: fullGallery}, nil)
+			},
+		}, {
+			name:          "error adding leaves saved variants pending",
+			expectedError: domain.ErrProductGalleryFull,
+			mockSetup: func(
+				productRepository *mock.MockProductRepository,
+				imageRepository *mock.MockImageRepository,
+				imageProcessor *mock.MockImageProcessor,
+				imageOperationRepository *mock.MockImageOperationRepository,
+			) {
+				productRepository.EXPECT().
+					GetProductById(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{})).
+					Return(&domain.Product{}, nil)
+				imageProcessor.EXPECT().
+					ProcessImage(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+					Return(processed, nil)
+				imageRepository.EXPECT().
+					SaveImage(gomock.AssignableToTypeOf(context.Background()), gomock.Any()).
+					Return(&domain.Image{Url: "url", DeleteUrl: "delete-url"}, nil).
+					Times(len(processed))
+				imageOperationRepository.EXPECT().
+					AddImageOperations(gomock.AssignableToTypeOf(context.Background()), gomock.Len(len(processed))).
+					Return(nil)
+				productRepository.EXPECT().
+					AddProductImage(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.ProductImage{})).
+					Return(domain.ErrProductGalleryFull)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			productRepository.EXPECT().HasMenuDraft(gomock.Any()).Return(false, nil).AnyTimes()
+			imageRepository := mock.NewMockImageRepository(ctrl)
+			imageProcessor := mock.NewMockImageProcessor(ctrl)
+			imageOperationRepository := mock.NewMockImageOperationRepository(ctrl)
+			tt.mockSetup(productRepository, imageRepository, imageProcessor, imageOperationRepository)
+
+			productId := uuid.New()
+			image, err := newProductService(ctrl, productServiceDeps{productRepository: productRepository, imageRepository: imageRepository, imageProcessor: imageProcessor, imageOperationRepository: imageOperationRepository}).
+				AddProductImage(context.Background(), domain.NewAddProductImageDTO(productId, "Front view", strings.NewReader("image")))
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				require.Equal(t, productId, image.ProductId)
+				require.Equal(t, "Front view", image.AltText)
+				require.Equal(t, "url", image.Url)
+				require.Len(t, image.Variants, len(processed))
+			}
+		})
+	}
+}
+
+func TestProductService_ReorderProductImages(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name          string
+		imageIds      []uuid.UUID
+		expectedError error
+		mockSetup     func(productRepository *mock.MockProductRepository)
+	}{
+		{
+			name:     "success",
+			imageIds: []uuid.UUID{second, first},
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					ReorderProductImages(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(uuid.UUID{}), []uuid.UUID{second, first}).
+					Return(nil)
+			},
+		}, {
+			name:          "error duplicate image",
+			imageIds:      []uuid.UUID{first, second, first},
+			expectedError: domain.ErrInvalidProductImageOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			productRepository.EXPECT().HasMenuDraft(gomock.Any()).Return(false, nil).AnyTimes()
 			if tt.mockSetup != nil {
-				tt.mockSetup(productRepository, imageRepository)
+				tt.mockSetup(productRepository)
 			}
 
-			err := service.NewProductService(productRepository, imageRepository).
-				DeleteProduct(context.Background(), tt.dto)
+			err := newProductService(ctrl, productServiceDeps{productRepository: productRepository}).
+				ReorderProductImages(context.Background(), uuid.New(), tt.imageIds)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestProductService_GetProducts(t *testing.T) {
+	negativeCalories := -1
+
+	tests := []struct {
+		name                string
+		dto                 *domain.GetProductsDTO
+		expectedIncludeTags []string
+		expectedExcludeTags []string
+		expectedSort        domain.ProductSort
+		expectedLimit       int
+		expectedError       error
+	}{
+		{
+			name:                "no filters",
+			dto:                 domain.NewGetProductsDTO(nil, nil, nil, nil, "", nil, "", false, 0, 0),
+			expectedIncludeTags: []string{},
+			expectedExcludeTags: []string{},
+			expectedSort:        domain.SortByCategory,
+		},
+		{
+			name:                "normalizes tag names",
+			dto:                 domain.NewGetProductsDTO(nil, []string{" Vegan", "vegan", ""}, []string{"Gluten", "NUTS "}, nil, "", nil, domain.SortByName, true, 10, 20),
+			expectedIncludeTags: []string{"vegan"},
+			expectedExcludeTags: []string{"gluten", "nuts"},
+			expectedSort:        domain.SortByName,
+			expectedLimit:       10,
+		},
+		{
+			name:                "search sorts by relevance",
+			dto:                 domain.NewGetProductsDTO(nil, nil, nil, nil, " burger ", nil, "", false, 0, 0),
+			expectedIncludeTags: []string{},
+			expectedExcludeTags: []string{},
+			expectedSort:        domain.SortByRelevance,
+		},
+		{
+			name:          "relevance without search",
+			dto:           domain.NewGetProductsDTO(nil, nil, nil, nil, "", nil, domain.SortByRelevance, false, 0, 0),
+			expectedError: domain.ErrInvalidProductSort,
+		},
+		{
+			name:          "unknown sort",
+			dto:           domain.NewGetProductsDTO(nil, nil, nil, nil, "", nil, "rating", false, 0, 0),
+			expectedError: domain.ErrInvalidProductSort,
+		},
+		{
+			name:          "limit too large",
+			dto:           domain.NewGetProductsDTO(nil, nil, nil, nil, "", nil, "", false, domain.MaxProductsLimit+1, 0),
+			expectedError: domain.ErrInvalidPagination,
+		},
+		{
+			name:          "negative offset",
+			dto:           domain.NewGetProductsDTO(nil, nil, nil, nil, "", nil, "", false, 0, -1),
+			expectedError: domain.ErrInvalidPagination,
+		},
+		{
+			name:          "negative max calories",
+			dto:           domain.NewGetProductsDTO(nil, nil, nil, nil, "", &negativeCalories, "", false, 0, 0),
+			expectedError: domain.ErrInvalidMaxCalories,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			if tt.expectedError == nil {
+				productRepository.EXPECT().
+					GetProducts(gomock.Any(), gomock.AssignableToTypeOf(&domain.GetProductsDTO{})).
+					DoAndReturn(func(_ context.Context, dto *domain.GetProductsDTO) ([]domain.Product, error) {
+						require.Equal(t, tt.expectedIncludeTags, dto.IncludeTags)
+						require.Equal(t, tt.expectedExcludeTags, dto.ExcludeTags)
+						require.Equal(t, tt.expectedSort, dto.Sort)
+						require.Equal(t, tt.expectedLimit, dto.Limit)
+						return []domain.Product{{Id: uuid.New()}}, nil
+					})
+				productRepository.EXPECT().
+					CountProducts(gomock.Any(), gomock.AssignableToTypeOf(&domain.GetProductsDTO{})).
+					Return(1, nil)
+			}
+
+			page, err := newProductService(ctrl, productServiceDeps{productRepository: productRepository}).
+				GetProducts(context.Background(), tt.dto)
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				require.Len(t, page.Products, 1)
+				require.Equal(t, 1, page.Total)
+			}
+		})
+	}
+}
+
+func TestProductService_GetProductsSortedByPrice(t *testing.T) {
+	discount := *domain.NewPricingRule(uuid.New(), "Happy Hour", nil, nil, domain.FixedPrice, decimal.NewFromInt(5), nil)
+	cheap := domain.Product{Id: uuid.New(), Price: decimal.NewFromInt(8)}
+	discounted := domain.Product{Id: uuid.New(), Price: decimal.NewFromInt(10), PricingRules: []domain.PricingRule{discount}}
+	expensive := domain.Product{Id: uuid.New(), Price: decimal.NewFromInt(12)}
+
+	tests := []struct {
+		name       string
+		descending bool
+		limit      int
+		offset     int
+		expected   []uuid.UUID
+	}{
+		{
+			name:     "ascending by current price",
+			expected: []uuid.UUID{discounted.Id, cheap.Id, expensive.Id},
+		}, {
+			name:       "descending by current price",
+			descending: true,
+			expected:   []uuid.UUID{expensive.Id, cheap.Id, discounted.Id},
+		}, {
+			name:     "paginated after sorting",
+			limit:    1,
+			offset:   1,
+			expected: []uuid.UUID{cheap.Id},
+		}, {
+			name:     "offset past the end",
+			offset:   3,
+			expected: []uuid.UUID{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			productRepository.EXPECT().
+				GetProducts(gomock.Any(), gomock.AssignableToTypeOf(&domain.GetProductsDTO{})).
+				DoAndReturn(func(_ context.Context, dto *domain.GetProductsDTO) ([]domain.Product, error) {
+					require.Zero(t, dto.Limit)
+					require.Zero(t, dto.Offset)
+					return []domain.Product{cheap, discounted, expensive}, nil
+				})
+			productRepository.EXPECT().
+				CountProducts(gomock.Any(), gomock.AssignableToTypeOf(&domain.GetProductsDTO{})).
+				Return(3, nil)
+
+			page, err := newProductService(ctrl, productServiceDeps{productRepository: productRepository}).
+				GetProducts(context.Background(), domain.NewGetProductsDTO(nil, nil, nil, nil, "", nil, domain.SortByPrice, tt.descending, tt.limit, tt.offset))
+			require.NoError(t, err)
+			require.Equal(t, 3, page.Total)
+
+			ids := make([]uuid.UUID, 0, len(page.Products))
+			for _, product := range page.Products {
+				ids = append(ids, product.Id)
+			}
+			require.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestProductService_MenuDraftPending(t *testing.T) {
+	id := uuid.New()
+	name := "Lemonade"
+
+	tests := []struct {
+		name string
+		call func(productService *service.ProductService) error
+	}{
+		{name: "add category", call: func(productService *service.ProductService) error {
+			_, err := productService.AddCategory(context.Background(), "Drinks")
+			return err
+		}},
+		{name: "update category", call: func(productService *service.ProductService) error {
+			return productService.UpdateCategory(context.Background(), domain.NewUpdateCategoryProductDTO(id, &name))
+		}},
+		{name: "delete category", call: func(productService *service.ProductService) error {
+			return productService.DeleteCategory(context.Background(), id)
+		}},
+		{name: "archive category", call: func(productService *service.ProductService) error {
+			return productService.ArchiveCategory(context.Background(), id)
+		}},
+		{name: "restore category", call: func(productService *service.ProductService) error {
+			return productService.RestoreCategory(context.Background(), id)
+		}},
+		{name: "add product", call: func(productService *service.ProductService) error {
+			_, err := productService.AddProduct(context.Background(), domain.NewAddProductDTO(name, "Homemade with fresh lemons", id, decimal.NewFromInt(4), domain.Nutrition{}))
+			return err
+		}},
+		{name: "update product", call: func(productService *service.ProductService) error {
+			return productService.UpdateProduct(context.Background(), domain.NewUpdateProductDTO(id, &name, nil, nil, nil, nil))
+		}},
+		{name: "delete product", call: func(productService *service.ProductService) error {
+			return productService.DeleteProduct(context.Background(), domain.NewDeleteProductDTO(&id, nil))
+		}},
+		{name: "archive product", call: func(productService *service.ProductService) error {
+			return productService.ArchiveProduct(context.Background(), id)
+		}},
+		{name: "restore product", call: func(productService *service.ProductService) error {
+			return productService.RestoreProduct(context.Background(), id)
+		}},
+		{name: "replace product image", call: func(productService *service.ProductService) error {
+			_, err := productService.ReplaceProductImage(context.Background(), id, strings.NewReader("image"))
+			return err
+		}},
+		{name: "add product image", call: func(productService *service.ProductService) error {
+			_, err := productService.AddProductImage(context.Background(), domain.NewAddProductImageDTO(id, "", strings.NewReader("image")))
+			return err
+		}},
+		{name: "reorder product images", call: func(productService *service.ProductService) error {
+			return productService.ReorderProductImages(context.Background(), id, []uuid.UUID{uuid.New()})
+		}},
+		{name: "delete product image", call: func(productService *service.ProductService) error {
+			return productService.DeleteProductImage(context.Background(), id, uuid.New())
+		}},
+		{name: "rollback menu", call: func(productService *service.ProductService) error {
+			_, err := productService.RollbackMenu(context.Background(), 1)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			productRepository.EXPECT().
+				HasMenuDraft(gomock.AssignableToTypeOf(context.Background())).
+				Return(true, nil)
+
+			productService := newProductService(ctrl, productServiceDeps{productRepository: productRepository})
+			require.ErrorIs(t, tt.call(productService), domain.ErrMenuDraftPending)
+		})
+	}
+}
+
+func TestProductService_ImportMenu(t *testing.T) {
+	drinks := domain.NewProductCategory(uuid.New(), "Drinks")
+	cola := domain.NewProduct(uuid.New(), "Cola", "Cold and sparkling soda", nil, nil, drinks.Id, decimal.NewFromInt(3), true)
+	current := domain.NewMenu([]domain.ProductCategory{*drinks}, []domain.Product{*cola})
+
+	validRows := []domain.MenuRow{
+		*domain.NewMenuRow(nil, "Drinks", nil, "Cola", "Cold and sparkling soda", decimal.NewFromInt(4), true, nil),
+		*domain.NewMenuRow(nil, "Desserts", nil, "Tiramisu", "Coffee soaked ladyfingers", decimal.RequireFromString("6.50"), true, nil),
+		*domain.NewMenuRow(nil, "Specials", nil, "", "", decimal.Zero, false, nil),
+	}
+
+	tests := []struct {
+		name           string
+		dto            *domain.ImportMenuDTO
+		expectedError  error
+		expectedReport *domain.MenuImportReport
+		mockSetup      func(productRepository *mock.MockProductRepository)
+	}{
+		{
+			name: "success",
+			dto:  domain.NewImportMenuDTO(validRows, false),
+			expectedReport: &domain.MenuImportReport{
+				Applied:           true,
+				CategoriesCreated: 2,
+				CategoriesUpdated: 1,
+				ProductsCreated:   1,
+				ProductsUpdated:   1,
+			},
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					GetMenu(gomock.AssignableToTypeOf(context.Background())).
+					Return(current, nil)
+				productRepository.EXPECT().
+					UpsertMenu(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.Menu{})).
+					DoAndReturn(func(_ context.Context, menu *domain.Menu) error {
+						require.Len(t, menu.Categories, 3)
+						require.Len(t, menu.Products, 2)
+						require.Equal(t, drinks.Id, menu.Categories[0].Id)
+						require.Equal(t, cola.Id, menu.Products[0].Id)
+						require.True(t, menu.Products[0].Price.Equal(decimal.NewFromInt(4)))
+						require.Equal(t, menu.Categories[1].Id, menu.Products[1].Category)
+						return nil
+					})
+			},
+		},
+		{
+			name: "dry run",
+			dto:  domain.NewImportMenuDTO(validRows, true),
+			expectedReport: &domain.MenuImportReport{
+				DryRun:            true,
+				CategoriesCreated: 2,
+				CategoriesUpdated: 1,
+				ProductsCreated:   1,
+				ProductsUpdated:   1,
+			},
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					GetMenu(gomock.AssignableToTypeOf(context.Background())).
+					Return(current, nil)
+			},
+		},
+		{
+			name: "invalid rows",
+			dto: domain.NewImportMenuDTO([]domain.MenuRow{
+				*domain.NewMenuRow(nil, "Drinks", nil, "Lemonade", "Too short", decimal.NewFromInt(-1), true, nil),
+				*domain.NewMenuRow(nil, "Drinks", nil, "Water", "Still mineral water", decimal.NewFromInt(2), true, nil),
+				*domain.NewMenuRow(nil, "Drinks", nil, "Water", "Sparkling mineral water", decimal.NewFromInt(2), true, nil),
+				*domain.NewMenuRow(&cola.Id, "Drinks", nil, "", "", decimal.Zero, false, nil),
+			}, false),
+			expectedReport: &domain.MenuImportReport{
+				CategoriesUpdated: 1,
+				ProductsCreated:   1,
+				Errors: []domain.MenuRowError{
+					*domain.NewMenuRowError(
+						1,
+						"description must be at least 15 characters.",
+						"price must be positive, below 1000000 and have at most 2 decimal places.",
+					),
+					*domain.NewMenuRowError(3, "productName is used by another product of the import."),
+					*domain.NewMenuRowError(4, "categoryName is used by another category of the import."),
+				},
+			},
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					GetMenu(gomock.AssignableToTypeOf(context.Background())).
+					Return(current, nil)
+			},
+		},
+		{
+			name:          "error menu draft pending",
+			dto:           domain.NewImportMenuDTO(validRows, false),
+			expectedError: domain.ErrMenuDraftPending,
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					GetMenu(gomock.AssignableToTypeOf(context.Background())).
+					Return(current, nil)
+				productRepository.EXPECT().
+					HasMenuDraft(gomock.AssignableToTypeOf(context.Background())).
+					Return(true, nil)
+			},
+		},
+		{
+			name:          "error nothing to update",
+			dto:           domain.NewImportMenuDTO(nil, false),
+			expectedError: domain.ErrNothingToUpdate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(productRepository)
+			}
+			productRepository.EXPECT().HasMenuDraft(gomock.Any()).Return(false, nil).AnyTimes()
+
+			report, err := newProductService(ctrl, productServiceDeps{productRepository: productRepository}).
+				ImportMenu(context.Background(), tt.dto)
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedReport, report)
+		})
+	}
+}
+
+func TestProductService_PublishMenuDraft(t *testing.T) {
+	draftCreatedAt := testNow.Add(-time.Hour)
+	drinks := domain.NewProductCategory(uuid.New(), "Drinks")
+	cola := domain.NewProduct(uuid.New(), "Cola", "Cold and sparkling soda", nil, nil, drinks.Id, decimal.NewFromInt(3), true)
+	water := domain.NewProduct(uuid.New(), "Water", "Still mineral water", nil, nil, drinks.Id, decimal.NewFromInt(2), true)
+	current := domain.NewMenu([]domain.ProductCategory{*drinks}, []domain.Product{*cola, *water})
+
+	draft := func(rows ...domain.MenuRow) *domain.MenuDraft {
+		draft := domain.NewMenuDraft(rows)
+		draft.CreatedAt = draftCreatedAt
+		draft.UpdatedAt = draftCreatedAt
+		return draft
+	}
+
+	tests := []struct {
+		name           string
+		expectedError  error
+		expectedNumber int
+		mockSetup      func(productRepository *mock.MockProductRepository)
+	}{
+		{
+			name:           "success",
+			expectedNumber: 2,
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				staged := draft(*domain.NewMenuRow(&drinks.Id, "Drinks", &cola.Id, "Cola", "Cold and sparkling soda", decimal.NewFromInt(4), true, nil))
+				productRepository.EXPECT().
+					GetMenuDraft(gomock.AssignableToTypeOf(context.Background())).
+					Return(staged, nil)
+				productRepository.EXPECT().
+					PublishMenu(gomock.AssignableToTypeOf(context.Background()), staged, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *domain.MenuDraft, resolve port.MenuResolver) error {
+						menu, version, err := resolve(current)
+						require.NoError(t, err)
+						require.Len(t, menu.Products, 1)
+						require.Equal(t, cola.Id, menu.Products[0].Id)
+						require.True(t, menu.Products[0].Price.Equal(decimal.NewFromInt(4)))
+						require.Len(t, version.Rows, 1)
+						require.Nil(t, version.RestoredFrom)
+						version.Number = 2
+						return nil
+					})
+			},
+		},
+		{
+			name:          "invalid draft",
+			expectedError: domain.ErrInvalidMenuDraft,
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					GetMenuDraft(gomock.AssignableToTypeOf(context.Background())).
+					Return(draft(*domain.NewMenuRow(&drinks.Id, "Drinks", nil, "Cola", "Cold", decimal.NewFromInt(4), true, nil)), nil)
+				productRepository.EXPECT().
+					PublishMenu(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.MenuDraft{}), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *domain.MenuDraft, resolve port.MenuResolver) error {
+						_, _, err := resolve(current)
+						return err
+					})
+			},
+		},
+		{
+			name:          "draft changed while publishing",
+			expectedError: domain.ErrMenuDraftChanged,
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					GetMenuDraft(gomock.AssignableToTypeOf(context.Background())).
+					Return(draft(*domain.NewMenuRow(&drinks.Id, "Drinks", &cola.Id, "Cola", "Cold and sparkling soda", decimal.NewFromInt(4), true, nil)), nil)
+				productRepository.EXPECT().
+					PublishMenu(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.MenuDraft{}), gomock.Any()).
+					Return(domain.ErrMenuDraftChanged)
+			},
+		},
+		{
+			name:          "error no draft",
+			expectedError: domain.ErrMenuDraftNotFound,
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					GetMenuDraft(gomock.AssignableToTypeOf(context.Background())).
+					Return(nil, domain.ErrMenuDraftNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			tt.mockSetup(productRepository)
+
+			version, err := newProductService(ctrl, productServiceDeps{productRepository: productRepository}).
+				PublishMenuDraft(context.Background())
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				require.Equal(t, tt.expectedNumber, version.Number)
+			} else {
+				require.Nil(t, version)
+			}
+		})
+	}
+}
+
+func TestProductService_PreviewMenuDraft(t *testing.T) {
+	draftCreatedAt := testNow.Add(-time.Hour)
+	drinks := domain.NewProductCategory(uuid.New(), "Drinks")
+	drinks.CreatedAt = draftCreatedAt.Add(-time.Hour)
+	cola := domain.NewProduct(uuid.New(), "Cola", "Cold and sparkling soda", nil, nil, drinks.Id, decimal.NewFromInt(3), true)
+	cola.CreatedAt = draftCreatedAt.Add(-time.Hour)
+	water := domain.NewProduct(uuid.New(), "Water", "Still mineral water", nil, nil, drinks.Id, decimal.NewFromInt(2), true)
+	water.CreatedAt = draftCreatedAt.Add(-time.Hour)
+	// Juice was added to the live menu after the draft was started, so publishing the draft keeps it.
+	juice := domain.NewProduct(uuid.New(), "Juice", "Freshly squeezed oranges", nil, nil, drinks.Id, decimal.NewFromInt(4), true)
+	juice.CreatedAt = draftCreatedAt.Add(time.Minute)
+
+	draft := domain.NewMenuDraft([]domain.MenuRow{
+		*domain.NewMenuRow(nil, "Drinks", nil, "Cola", "Cold and sparkling soda", decimal.NewFromInt(4), true, nil),
+		*domain.NewMenuRow(nil, "Desserts", nil, "", "", decimal.Zero, false, nil),
+	})
+	draft.CreatedAt = draftCreatedAt
+
+	ctrl := gomock.NewController(t)
+	productRepository := mock.NewMockProductRepository(ctrl)
+	productRepository.EXPECT().
+		GetMenuDraft(gomock.AssignableToTypeOf(context.Background())).
+		Return(draft, nil)
+	productRepository.EXPECT().
+		GetMenu(gomock.AssignableToTypeOf(context.Background())).
+		Return(domain.NewMenu([]domain.ProductCategory{*drinks}, []domain.Product{*cola, *water, *juice}), nil)
+
+	preview, err := newProductService(ctrl, productServiceDeps{productRepository: productRepository}).
+		PreviewMenuDraft(context.Background())
+	require.NoError(t, err)
+	require.Len(t, preview.Rows, 2)
+	require.Equal(t, &cola.Id, preview.Rows[0].ProductId)
+	require.Empty(t, preview.Report.Errors)
+	require.Equal(t, 1, preview.Report.CategoriesCreated)
+	require.Equal(t, 1, preview.Report.ProductsUpdated)
+	require.Equal(t, 0, preview.CategoriesArchived)
+	require.Equal(t, 1, preview.ProductsArchived)
+}
+
+func TestProductService_RollbackMenu(t *testing.T) {
+	drinks := domain.NewProductCategory(uuid.New(), "Drinks")
+	cola := domain.NewProduct(uuid.New(), "Cola", "Cold and sparkling soda", nil, nil, drinks.Id, decimal.NewFromInt(3), true)
+	water := domain.NewProduct(uuid.New(), "Water", "Still mineral water", nil, nil, drinks.Id, decimal.NewFromInt(2), true)
+	current := domain.NewMenu([]domain.ProductCategory{*drinks}, []domain.Product{*cola, *water})
+
+	archivedAt := testNow.Add(-time.Hour)
+	lemonade := domain.NewProduct(uuid.New(), "Lemonade", "Homemade with fresh lemons", nil, nil, drinks.Id, decimal.NewFromInt(4), true)
+	lemonade.ArchivedAt = &archivedAt
+	withArchived := domain.NewMenu([]domain.ProductCategory{*drinks}, []domain.Product{*cola, *lemonade, *water})
+
+	version := &domain.MenuVersion{
+		Number: 1,
+		Rows: []domain.MenuRow{
+			*domain.NewMenuRow(&drinks.Id, "Drinks", &cola.Id, "Cola", "Cold and sparkling soda", decimal.NewFromInt(3), true, nil),
+			*domain.NewMenuRow(&drinks.Id, "Drinks", &lemonade.Id, "Lemonade", "Homemade with fresh lemons", decimal.NewFromInt(4), true, nil),
+		},
+		Details: &domain.MenuDetails{
+			Products: []domain.ProductDetails{
+				{
+					ProductId: lemonade.Id,
+					Schedule:  []domain.ScheduleWindow{*domain.NewScheduleWindow(time.Saturday, "10:00", "18:00")},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		expectedError error
+		mockSetup     func(productRepository *mock.MockProductRepository)
+	}{
+		{
+			name: "success restores archived entries",
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					GetMenuVersion(gomock.AssignableToTypeOf(context.Background()), 1).
+					Return(version, nil)
+				productRepository.EXPECT().
+					PublishMenu(gomock.AssignableToTypeOf(context.Background()), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *domain.MenuDraft, resolve port.MenuResolver) error {
+						menu, restored, err := resolve(withArchived)
+						require.NoError(t, err)
+						require.Len(t, menu.Products, 2)
+						require.Equal(t, cola.Id, menu.Products[0].Id)
+						require.Equal(t, lemonade.Id, menu.Products[1].Id)
+						require.Equal(t, version.Rows, restored.Rows)
+						require.Equal(t, version.Details, restored.Details)
+						restored.Number = 3
+						return nil
+					})
+			},
+		},
+		{
+			name:          "names now used by other entries",
+			expectedError: domain.ErrMenuVersionConflict,
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				otherCola := uuid.New()
+				productRepository.EXPECT().
+					GetMenuVersion(gomock.AssignableToTypeOf(context.Background()), 1).
+					Return(&domain.MenuVersion{
+						Number: 1,
+						Rows: []domain.MenuRow{
+							*domain.NewMenuRow(&drinks.Id, "Drinks", &otherCola, "Cola", "Cold and sparkling soda", decimal.NewFromInt(3), true, nil),
+						},
+					}, nil)
+				productRepository.EXPECT().
+					PublishMenu(gomock.AssignableToTypeOf(context.Background()), nil, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *domain.MenuDraft, resolve port.MenuResolver) error {
+						_, _, err := resolve(current)
+						return err
+					})
+			},
+		},
+		{
+			name:          "error version not found",
+			expectedError: domain.ErrMenuVersionNotFound,
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					GetMenuVersion(gomock.AssignableToTypeOf(context.Background()), 1).
+					Return(nil, domain.ErrMenuVersionNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			productRepository.EXPECT().HasMenuDraft(gomock.Any()).Return(false, nil).AnyTimes()
+			tt.mockSetup(productRepository)
+
+			restored, err := newProductService(ctrl, productServiceDeps{productRepository: productRepository}).
+				RollbackMenu(context.Background(), 1)
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				require.Equal(t, 3, restored.Number)
+				require.Equal(t, &version.Number, restored.RestoredFrom)
+			} else {
+				require.Nil(t, restored)
+			}
+		})
+	}
+}
+
+func TestProductService_GetProductsInPreferredLocales(t *testing.T) {
+	translated := domain.Product{Id: uuid.New(), Name: "Wurst", Description: "Eine Wurst mit Senf"}
+	untranslated := domain.Product{Id: uuid.New(), Name: "Lemonade", Description: "Freshly squeezed"}
+
+	ctrl := gomock.NewController(t)
+	productRepository := mock.NewMockProductRepository(ctrl)
+	productRepository.EXPECT().
+		GetProducts(gomock.Any(), gomock.AssignableToTypeOf(&domain.GetProductsDTO{})).
+		DoAndReturn(func(_ context.Context, dto *domain.GetProductsDTO) ([]domain.Product, error) {
+			require.Equal(t, []string{"de-ch", "de"}, dto.Locales)
+			return []domain.Product{translated, untranslated}, nil
+		})
+	productRepository.EXPECT().
+		CountProducts(gomock.Any(), gomock.AssignableToTypeOf(&domain.GetProductsDTO{})).
+		Return(2, nil)
+
+	page, err := newProductService(ctrl, productServiceDeps{productRepository: productRepository}).
+		GetProducts(context.Background(), domain.NewGetProductsDTO(nil, nil, nil, []string{"de-CH", "DE"}, "", nil, "", false, 0, 0))
+	require.NoError(t, err)
+	require.Len(t, page.Products, 2)
+	require.Equal(t, "Wurst", page.Products[0].Name)
+	// A product without a translation in any of the locales keeps its default text.
+	require.Equal(t, untranslated.Name, page.Products[1].Name)
+	require.Equal(t, untranslated.Description, page.Products[1].Description)
+}
+
+func TestProductService_SetProductSchedule(t *testing.T) {
+	productId := uuid.New()
+
+	tests := []struct {
+		name          string
+		windows       []domain.ScheduleWindow
+		expectedError error
+		mockSetup     func(productRepository *mock.MockProductRepository)
+	}{
+		{
+			name:    "success",
+			windows: []domain.ScheduleWindow{*domain.NewScheduleWindow(time.Friday, "18:00", "02:00")},
+			mockSetup: func(productRepository *mock.MockProductRepository) {
+				productRepository.EXPECT().
+					SetProductSchedule(gomock.AssignableToTypeOf(context.Background()), productId, gomock.Len(1)).
+					Return(nil)
+			},
+		}, {
+			name:          "error malformed time of day",
+			windows:       []domain.ScheduleWindow{*domain.NewScheduleWindow(time.Friday, "6pm", "02:00")},
+			expectedError: domain.ErrInvalidScheduleWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			productRepository := mock.NewMockProductRepository(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(productRepository)
+			}
+
+			err := newProductService(ctrl, productServiceDeps{productRepository: productRepository}).
+				SetProductSchedule(context.Background(), productId, tt.windows)
 			require.ErrorIs(t, err, tt.expectedError)
 		})
 	}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecommendationService implements port.RecommendationService. Recommendations are computed
+// from the order history in the background and served from the cache, so suggesting them
+// after every order doesn't query the history. The cache only keeps which products are
+// companions, their data is fetched from the product service when they are recommended.
+type RecommendationService struct {
+	recommendationRepository port.RecommendationRepository
+	recommendationCache      port.RecommendationCacheRepository
+	productService           port.ProductService
+}
+
+// NewRecommendationService creates a new RecommendationService instance.
+func NewRecommendationService(
+	recommendationRepository port.RecommendationRepository,
+	recommendationCache port.RecommendationCacheRepository,
+	productService port.ProductService,
+) *RecommendationService {
+	return &RecommendationService{
+		recommendationRepository: recommendationRepository,
+		recommendationCache:      recommendationCache,
+		productService:           productService,
+	}
+}
+
+func (s *RecommendationService) RefreshRecommendations(ctx context.Context) (int, error) {
+	recommendations, err := s.recommendationRepository.GetRecommendations(
+		ctx,
+		time.Now().Add(-domain.RecommendationHistory),
+		domain.MinRecommendationSessions,
+		domain.MaxRecommendations,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	grouped := domain.GroupRecommendations(recommendations)
+	s.recommendationCache.Replace(grouped)
+	return len(grouped), nil
+}
+
+func (s *RecommendationService) GetRecommendations(ctx context.Context, productId uuid.UUID, locales []string) ([]domain.RecommendedProduct, error) {
+	recommendations := s.recommendationCache.Get(productId)
+	if len(recommendations) == 0 {
+		return []domain.RecommendedProduct{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(recommendations))
+	for _, recommendation := range recommendations {
+		ids = append(ids, recommendation.CompanionId)
+	}
+
+	// Archived and unscheduled companions aren't listed, and those listed carry their current
+	// translation and pricing rule.
+	page, err := s.productService.GetProducts(ctx, domain.NewGetProductsByIdsDTO(ids, locales))
+	if err != nil {
+		return nil, err
+	}
+
+	products := make(map[uuid.UUID]domain.Product, len(page.Products))
+	for _, product := range page.Products {
+		products[product.Id] = product
+	}
+
+	recommended := make([]domain.RecommendedProduct, 0, len(recommendations))
+	for _, recommendation := range recommendations {
+		product, ok := products[recommendation.CompanionId]
+		if !ok || !product.Available {
+			continue
+		}
+		recommended = append(recommended, *domain.NewRecommendedProduct(product, recommendation.Sessions))
+	}
+	return recommended, nil
+}
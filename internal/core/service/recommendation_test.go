@@ -0,0 +1,153 @@
+package service_test
+
+import (
+	"context"
+	"restaurant/internal/core/domain"
+	"restaurant/internal/core/port/mock"
+	"restaurant/internal/core/service"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecommendationService_RefreshRecommendations(t *testing.T) {
+	burger, fries := uuid.New(), uuid.New()
+	recommendations := []domain.Recommendation{
+		{ProductId: burger, CompanionId: fries, Sessions: 12},
+		{ProductId: burger, CompanionId: uuid.New(), Sessions: 7},
+		{ProductId: fries, CompanionId: burger, Sessions: 12},
+	}
+
+	tests := []struct {
+		name             string
+		expectedProducts int
+		expectedError    error
+		mockSetup        func(recommendationRepository *mock.MockRecommendationRepository, recommendationCache *mock.MockRecommendationCacheRepository)
+	}{
+		{
+			name:             "success",
+			expectedProducts: 2,
+			mockSetup: func(recommendationRepository *mock.MockRecommendationRepository, recommendationCache *mock.MockRecommendationCacheRepository) {
+				recommendationRepository.EXPECT().
+					GetRecommendations(
+						gomock.AssignableToTypeOf(context.Background()),
+						gomock.AssignableToTypeOf(time.Time{}),
+						domain.MinRecommendationSessions,
+						domain.MaxRecommendations,
+					).
+					DoAndReturn(func(_ context.Context, since time.Time, _, _ int) ([]domain.Recommendation, error) {
+						require.WithinDuration(t, time.Now().Add(-domain.RecommendationHistory), since, time.Minute)
+						return recommendations, nil
+					})
+				recommendationCache.EXPECT().
+					Replace(map[uuid.UUID][]domain.Recommendation{
+						burger: recommendations[:2],
+						fries:  recommendations[2:],
+					})
+			},
+		}, {
+			name: "success empty history clears the cache",
+			mockSetup: func(recommendationRepository *mock.MockRecommendationRepository, recommendationCache *mock.MockRecommendationCacheRepository) {
+				recommendationRepository.EXPECT().
+					GetRecommendations(gomock.AssignableToTypeOf(context.Background()), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				recommendationCache.EXPECT().
+					Replace(map[uuid.UUID][]domain.Recommendation{})
+			},
+		}, {
+			name:          "error keeps the cached recommendations",
+			expectedError: domain.ErrInternal,
+			mockSetup: func(recommendationRepository *mock.MockRecommendationRepository, recommendationCache *mock.MockRecommendationCacheRepository) {
+				recommendationRepository.EXPECT().
+					GetRecommendations(gomock.AssignableToTypeOf(context.Background()), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			recommendationRepository := mock.NewMockRecommendationRepository(ctrl)
+			recommendationCache := mock.NewMockRecommendationCacheRepository(ctrl)
+			tt.mockSetup(recommendationRepository, recommendationCache)
+
+			products, err := service.NewRecommendationService(recommendationRepository, recommendationCache, mock.NewMockProductService(ctrl)).
+				RefreshRecommendations(context.Background())
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expectedProducts, products)
+		})
+	}
+}
+
+func TestRecommendationService_GetRecommendations(t *testing.T) {
+	productId := uuid.New()
+	fries := domain.Product{Id: uuid.New(), Name: "Pommes", Available: true}
+	cola := domain.Product{Id: uuid.New(), Name: "Cola", Available: true}
+	shake := domain.Product{Id: uuid.New(), Name: "Shake", Available: false}
+	// Salad isn't listed anymore, it was archived or is out of its schedule.
+	salad := uuid.New()
+	recommendations := []domain.Recommendation{
+		{ProductId: productId, CompanionId: fries.Id, Sessions: 12},
+		{ProductId: productId, CompanionId: cola.Id, Sessions: 7},
+		{ProductId: productId, CompanionId: shake.Id, Sessions: 5},
+		{ProductId: productId, CompanionId: salad, Sessions: 3},
+	}
+
+	tests := []struct {
+		name          string
+		expected      []domain.RecommendedProduct
+		expectedError error
+		mockSetup     func(recommendationCache *mock.MockRecommendationCacheRepository, productService *mock.MockProductService)
+	}{
+		{
+			name: "success keeps only listed and available companions",
+			expected: []domain.RecommendedProduct{
+				*domain.NewRecommendedProduct(fries, 12),
+				*domain.NewRecommendedProduct(cola, 7),
+			},
+			mockSetup: func(recommendationCache *mock.MockRecommendationCacheRepository, productService *mock.MockProductService) {
+				recommendationCache.EXPECT().Get(productId).Return(recommendations)
+				productService.EXPECT().
+					GetProducts(
+						gomock.AssignableToTypeOf(context.Background()),
+						domain.NewGetProductsByIdsDTO([]uuid.UUID{fries.Id, cola.Id, shake.Id, salad}, []string{"de"}),
+					).
+					Return(domain.NewProductPage([]domain.Product{cola, fries, shake}, 3), nil)
+			},
+		}, {
+			name:     "success without recommendations",
+			expected: []domain.RecommendedProduct{},
+			mockSetup: func(recommendationCache *mock.MockRecommendationCacheRepository, productService *mock.MockProductService) {
+				recommendationCache.EXPECT().Get(productId).Return(nil)
+			},
+		}, {
+			name:          "error getting products",
+			expectedError: domain.ErrInternal,
+			mockSetup: func(recommendationCache *mock.MockRecommendationCacheRepository, productService *mock.MockProductService) {
+				recommendationCache.EXPECT().Get(productId).Return(recommendations)
+				productService.EXPECT().
+					GetProducts(gomock.AssignableToTypeOf(context.Background()), gomock.AssignableToTypeOf(&domain.GetProductsDTO{})).
+					Return(nil, domain.ErrInternal)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			recommendationRepository := mock.NewMockRecommendationRepository(ctrl)
+			recommendationCache := mock.NewMockRecommendationCacheRepository(ctrl)
+			productService := mock.NewMockProductService(ctrl)
+			tt.mockSetup(recommendationCache, productService)
+
+			result, err := service.NewRecommendationService(recommendationRepository, recommendationCache, productService).
+				GetRecommendations(context.Background(), productId, []string{"de"})
+			require.ErrorIs(t, err, tt.expectedError)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}